@@ -0,0 +1,296 @@
+// Package batch sends large batches of messages (e.g., notifying 10k Telegram chats), spreading
+// the sends over time to respect the rate limits of the provider.
+//
+// Sends are paced to the rate of the provider and pause when the provider answers with a
+// notifier.RateLimitError. Completed items are recorded in a Checkpoint, so an interrupted batch
+// can be resumed by running it again with the same checkpoint.
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/outbox"
+)
+
+// DefaultRates are the documented send limits per second of providers, by transport key.
+var DefaultRates = map[string]float64{
+	"telegram":       30,
+	"microsoftteams": 4,
+	"slack":          1,
+}
+
+// DefaultRate is the rate of senders without a known limit.
+const DefaultRate = 1
+
+// DefaultConcurrency is the maximum number of concurrent sends.
+const DefaultConcurrency = 10
+
+// DefaultMaxRateLimitRetries is how often an item is retried after a RateLimitError.
+const DefaultMaxRateLimitRetries = 3
+
+// Item is a message of a batch.
+type Item struct {
+	// Key identifies the item in the checkpoint (e.g., the chat ID). It defaults to the index of
+	// the item in the batch, in which case the batch must be resumed with the same items in the
+	// same order.
+	Key     string
+	Message notifier.MessageInterface
+}
+
+// Progress is the state of a running batch.
+type Progress struct {
+	Total int
+	Sent  int
+	// Failed is the number of items whose send failed. They are not marked as completed and are
+	// sent again when the batch is resumed.
+	Failed int
+	// Skipped is the number of items completed by a previous run.
+	Skipped int
+	// Remaining is the estimated time until the batch is done.
+	Remaining time.Duration
+}
+
+// Done returns the number of items handled, including skipped ones.
+func (p Progress) Done() int {
+	return p.Sent + p.Failed + p.Skipped
+}
+
+// Result is the outcome of a batch.
+type Result struct {
+	Progress
+	// Errors holds the errors of the failed items by key.
+	Errors map[string]error
+}
+
+// Scheduler sends batches of messages at the rate of the provider.
+type Scheduler struct {
+	sender              outbox.Sender
+	rate                float64
+	concurrency         int
+	maxRateLimitRetries int
+	checkpoint          Checkpoint
+	onProgress          func(progress Progress)
+
+	mu       sync.Mutex
+	nextSend time.Time
+}
+
+// NewScheduler creates a scheduler sending with the sender, e.g. a transport or a
+// *notifier.Notifier. The rate defaults to the DefaultRates entry of transports.
+func NewScheduler(sender outbox.Sender) *Scheduler {
+	rate := float64(DefaultRate)
+	if transport, ok := sender.(notifier.TransportInterface); ok {
+		if r, ok := DefaultRates[notifier.TransportKey(transport)]; ok {
+			rate = r
+		}
+	}
+	return &Scheduler{
+		sender:              sender,
+		rate:                rate,
+		concurrency:         DefaultConcurrency,
+		maxRateLimitRetries: DefaultMaxRateLimitRetries,
+		checkpoint:          NewMemoryCheckpoint(),
+	}
+}
+
+// SetRate sets the maximum number of sends per second. A value of 0 disables pacing.
+func (s *Scheduler) SetRate(rate float64) *Scheduler {
+	s.rate = rate
+	return s
+}
+
+// SetConcurrency sets the maximum number of concurrent sends.
+func (s *Scheduler) SetConcurrency(concurrency int) *Scheduler {
+	s.concurrency = max(concurrency, 1)
+	return s
+}
+
+// SetMaxRateLimitRetries sets how often an item is retried after a RateLimitError.
+func (s *Scheduler) SetMaxRateLimitRetries(retries int) *Scheduler {
+	s.maxRateLimitRetries = retries
+	return s
+}
+
+// SetCheckpoint sets the checkpoint recording completed items, to resume interrupted batches.
+func (s *Scheduler) SetCheckpoint(checkpoint Checkpoint) *Scheduler {
+	s.checkpoint = checkpoint
+	return s
+}
+
+// OnProgress sets a callback called after every handled item. Calls are not concurrent.
+func (s *Scheduler) OnProgress(callback func(progress Progress)) *Scheduler {
+	s.onProgress = callback
+	return s
+}
+
+// Run sends the items, skipping those completed according to the checkpoint, and returns when
+// all items are handled. Failed sends don't stop the batch; they are returned in the result.
+// If the context is done, Run stops sending and returns the context error, the batch can then be
+// resumed with the same checkpoint.
+func (s *Scheduler) Run(ctx context.Context, items []Item) (*Result, error) {
+	completed, err := s.checkpoint.Load(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("batch: load checkpoint: %w", err)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	r := &run{
+		scheduler: s,
+		start:     time.Now(),
+		result:    &Result{Progress: Progress{Total: len(items)}, Errors: make(map[string]error)},
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, s.concurrency)
+	for i, item := range items {
+		key := item.Key
+		if key == "" {
+			key = strconv.Itoa(i)
+		}
+		if _, ok := completed[key]; ok {
+			r.skip()
+			continue
+		}
+
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			_, err := s.send(ctx, item.Message)
+			if ctx.Err() != nil && err != nil {
+				// Interrupted, the item is sent again when resuming
+				return
+			}
+			if err == nil {
+				if err := s.checkpoint.Save(ctx, key); err != nil {
+					cancel(fmt.Errorf("batch: save checkpoint: %w", err))
+					return
+				}
+			}
+			r.done(key, err)
+		}()
+	}
+	wg.Wait()
+
+	if err := context.Cause(ctx); err != nil {
+		return r.result, err
+	}
+	return r.result, nil
+}
+
+// send sends the message at the next free slot, retrying after rate limit errors.
+func (s *Scheduler) send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	for attempt := 0; ; attempt++ {
+		if err := s.wait(ctx); err != nil {
+			return nil, err
+		}
+
+		sent, err := s.sender.Send(ctx, message)
+		var rateLimitErr *notifier.RateLimitError
+		if err == nil || !errors.As(err, &rateLimitErr) || attempt >= s.maxRateLimitRetries {
+			return sent, err
+		}
+
+		// Pause all sends for the delay requested by the provider
+		s.mu.Lock()
+		if resume := time.Now().Add(rateLimitErr.RetryAfter); resume.After(s.nextSend) {
+			s.nextSend = resume
+		}
+		s.mu.Unlock()
+	}
+}
+
+// wait reserves the next send slot and waits for it.
+func (s *Scheduler) wait(ctx context.Context) error {
+	s.mu.Lock()
+	now := time.Now()
+	slot := now
+	if s.nextSend.After(now) {
+		slot = s.nextSend
+	}
+	if s.rate > 0 {
+		s.nextSend = slot.Add(time.Duration(float64(time.Second) / s.rate))
+	}
+	s.mu.Unlock()
+
+	delay := slot.Sub(now)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// run tracks the progress of a batch.
+type run struct {
+	scheduler *Scheduler
+	start     time.Time
+
+	mu     sync.Mutex
+	result *Result
+}
+
+func (r *run) skip() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.result.Skipped++
+	r.report()
+}
+
+func (r *run) done(key string, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err != nil {
+		r.result.Failed++
+		r.result.Errors[key] = err
+	} else {
+		r.result.Sent++
+	}
+	r.report()
+}
+
+// report updates the estimate and calls the progress callback, with r.mu held.
+func (r *run) report() {
+	p := &r.result.Progress
+	remaining := p.Total - p.Done()
+	p.Remaining = 0
+	if remaining > 0 {
+		// Estimate from the throughput so far, bounded by the rate
+		perItem := time.Duration(0)
+		if r.scheduler.rate > 0 {
+			perItem = time.Duration(float64(time.Second) / r.scheduler.rate)
+		}
+		if handled := p.Sent + p.Failed; handled > 0 {
+			perItem = max(perItem, time.Since(r.start)/time.Duration(handled))
+		}
+		p.Remaining = perItem * time.Duration(remaining)
+	}
+	if r.scheduler.onProgress != nil {
+		r.scheduler.onProgress(*p)
+	}
+}
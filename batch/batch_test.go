@@ -0,0 +1,165 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/notifiertest"
+	"github.com/shyim/go-notifier/transport/telegram"
+)
+
+type senderFunc func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error)
+
+func (f senderFunc) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	return f(ctx, message)
+}
+
+func newItems(n int) []Item {
+	items := make([]Item, n)
+	for i := range items {
+		chat := fmt.Sprintf("chat-%d", i)
+		items[i] = Item{Key: chat, Message: notifier.NewChatMessage("Release " + chat)}
+	}
+	return items
+}
+
+func TestRun(t *testing.T) {
+	var sent atomic.Int32
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		if message.GetSubject() == "Release chat-3" {
+			return nil, errors.New("chat not found")
+		}
+		sent.Add(1)
+		return notifier.NewSentMessage(message, "fake"), nil
+	})
+
+	var progress []Progress
+	start := time.Now()
+	result, err := NewScheduler(sender).
+		SetRate(100).
+		OnProgress(func(p Progress) { progress = append(progress, p) }).
+		Run(context.Background(), newItems(10))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// 10 sends at 100/s take at least 9 intervals
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("Expected the sends to be paced, took %s", elapsed)
+	}
+	if result.Sent != 9 || result.Failed != 1 || sent.Load() != 9 {
+		t.Errorf("Expected 9 sent and 1 failed, got %+v", result.Progress)
+	}
+	if result.Errors["chat-3"] == nil {
+		t.Errorf("Expected the error of chat-3, got %v", result.Errors)
+	}
+	if len(progress) != 10 || progress[9].Done() != 10 || progress[9].Remaining != 0 {
+		t.Errorf("Expected 10 progress reports ending with the total, got %+v", progress)
+	}
+}
+
+func TestRunRateLimited(t *testing.T) {
+	var mu sync.Mutex
+	var times []time.Time
+	limited := false
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		times = append(times, time.Now())
+		if !limited {
+			limited = true
+			return nil, &notifier.RateLimitError{Transport: "telegram", RetryAfter: 100 * time.Millisecond}
+		}
+		return notifier.NewSentMessage(message, "fake"), nil
+	})
+
+	result, err := NewScheduler(sender).SetRate(0).SetConcurrency(1).Run(context.Background(), newItems(2))
+	if err != nil || result.Sent != 2 {
+		t.Fatalf("Expected both items to be sent after the rate limit, got %+v (%v)", result, err)
+	}
+	if len(times) != 3 || times[1].Sub(times[0]) < 100*time.Millisecond {
+		t.Errorf("Expected a retry after the requested delay, got %v", times)
+	}
+}
+
+func TestRunRateLimitedTransport(t *testing.T) {
+	server := notifiertest.NewTelegramServer()
+	defer server.Close()
+
+	transport, err := notifier.NewTransportFromDSN(server.DSN("telegram://123:abc@%s?channel=42"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	server.RateLimitNext(time.Second)
+
+	start := time.Now()
+	result, err := NewScheduler(transport).SetRate(0).SetConcurrency(1).Run(context.Background(), newItems(2))
+	if err != nil || result.Sent != 2 || result.Failed != 0 {
+		t.Fatalf("Expected both items to be sent after the rate limit, got %+v (%v)", result, err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Expected the batch to pause for the retry_after delay, took %s", elapsed)
+	}
+	if len(server.Requests()) != 3 {
+		t.Errorf("Expected 3 requests, got %d", len(server.Requests()))
+	}
+}
+
+func TestRunResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "batch", "release.checkpoint")
+	checkpoint, err := OpenFileCheckpoint(path)
+	if err != nil {
+		t.Fatalf("Failed to open checkpoint: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var sent atomic.Int32
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		if sent.Add(1) == 4 {
+			cancel()
+		}
+		return notifier.NewSentMessage(message, "fake"), nil
+	})
+
+	items := newItems(10)
+	result, err := NewScheduler(sender).SetConcurrency(1).SetRate(0).SetCheckpoint(checkpoint).Run(ctx, items)
+	if !errors.Is(err, context.Canceled) || result.Sent != 4 {
+		t.Fatalf("Expected the batch to stop after 4 sends, got %+v (%v)", result, err)
+	}
+	_ = checkpoint.Close()
+
+	// Simulate a crash during a write
+	file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	_, _ = file.WriteString("chat-")
+	_ = file.Close()
+
+	checkpoint, err = OpenFileCheckpoint(path)
+	if err != nil {
+		t.Fatalf("Failed to reopen checkpoint: %v", err)
+	}
+	defer checkpoint.Close()
+
+	sent.Store(0)
+	result, err = NewScheduler(sender).SetRate(0).SetCheckpoint(checkpoint).Run(context.Background(), items)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if result.Skipped != 4 || result.Sent != 6 || sent.Load() != 6 {
+		t.Errorf("Expected 4 skipped and 6 sent, got %+v", result.Progress)
+	}
+}
+
+func TestDefaultRate(t *testing.T) {
+	scheduler := NewScheduler(telegram.NewTransport("123:abc", "42", nil))
+	if scheduler.rate != 30 {
+		t.Errorf("Expected the Telegram rate, got %v", scheduler.rate)
+	}
+}
@@ -0,0 +1,102 @@
+package batch
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/shyim/go-notifier/internal/linefile"
+)
+
+// Checkpoint records the completed items of a batch.
+type Checkpoint interface {
+	// Load returns the keys of the completed items.
+	Load(ctx context.Context) (map[string]struct{}, error)
+	// Save marks an item as completed.
+	Save(ctx context.Context, key string) error
+}
+
+// MemoryCheckpoint is a Checkpoint kept in memory, to resume a batch within the same process.
+type MemoryCheckpoint struct {
+	mu   sync.Mutex
+	keys map[string]struct{}
+}
+
+// NewMemoryCheckpoint creates a new MemoryCheckpoint.
+func NewMemoryCheckpoint() *MemoryCheckpoint {
+	return &MemoryCheckpoint{keys: make(map[string]struct{})}
+}
+
+func (c *MemoryCheckpoint) Load(ctx context.Context) (map[string]struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make(map[string]struct{}, len(c.keys))
+	for key := range c.keys {
+		keys[key] = struct{}{}
+	}
+	return keys, nil
+}
+
+func (c *MemoryCheckpoint) Save(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.keys[key] = struct{}{}
+	return nil
+}
+
+// FileCheckpoint is a Checkpoint appending the keys of completed items as lines to a file, to
+// resume a batch after a restart. Keys must not contain newlines. The file must only be used by
+// a single process.
+type FileCheckpoint struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// OpenFileCheckpoint opens the checkpoint in the file, creating it and its directory if needed.
+func OpenFileCheckpoint(path string) (*FileCheckpoint, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("batch: create directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("batch: open checkpoint: %w", err)
+	}
+	return &FileCheckpoint{file: file}, nil
+}
+
+func (c *FileCheckpoint) Load(ctx context.Context) (map[string]struct{}, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	data, err := linefile.Read(c.file)
+	if err != nil {
+		return nil, fmt.Errorf("batch: read checkpoint: %w", err)
+	}
+
+	keys := make(map[string]struct{})
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		if len(line) > 0 {
+			keys[string(line)] = struct{}{}
+		}
+	}
+	return keys, nil
+}
+
+func (c *FileCheckpoint) Save(ctx context.Context, key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := c.file.WriteString(key + "\n"); err != nil {
+		return fmt.Errorf("batch: write checkpoint: %w", err)
+	}
+	return nil
+}
+
+// Close closes the file.
+func (c *FileCheckpoint) Close() error {
+	return c.file.Close()
+}
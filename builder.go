@@ -0,0 +1,134 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// NotifierBuilder assembles a Notifier with its transports, middleware and policies.
+type NotifierBuilder struct {
+	transports     []TransportInterface
+	middleware     []Middleware
+	logger         *slog.Logger
+	recover        bool
+	onPanic        func(ctx context.Context, message MessageInterface, err *PanicError)
+	retry          *RetryPolicy
+	rateLimit      Middleware
+	defaultOptions map[string]func() MessageOptionsInterface
+	concurrency    int
+	errs           []error
+}
+
+// Builder starts building a Notifier:
+//
+//	n, err := notifier.Builder().
+//		WithDSN("slack://xoxb-token@default?channel=C123").
+//		WithRetry(notifier.DefaultRetryPolicy).
+//		WithRateLimit(1, 5).
+//		WithLogger(slog.Default()).
+//		Build()
+//
+// Whatever the call order, the middleware wraps sends as: panic recovery, custom middleware,
+// logger, retry, rate limit, so every attempt is rate limited and the logger sees the final result.
+func Builder() *NotifierBuilder {
+	return &NotifierBuilder{
+		defaultOptions: make(map[string]func() MessageOptionsInterface),
+	}
+}
+
+// WithDSN adds a transport created from the DSN. Errors are returned by Build.
+func (b *NotifierBuilder) WithDSN(dsn string) *NotifierBuilder {
+	transport, err := NewTransportFromDSN(dsn)
+	if err != nil {
+		b.errs = append(b.errs, err)
+		return b
+	}
+	b.transports = append(b.transports, transport)
+	return b
+}
+
+// WithTransport adds transports.
+func (b *NotifierBuilder) WithTransport(transports ...TransportInterface) *NotifierBuilder {
+	b.transports = append(b.transports, transports...)
+	return b
+}
+
+// WithRetry retries failed sends according to the policy.
+func (b *NotifierBuilder) WithRetry(policy RetryPolicy) *NotifierBuilder {
+	b.retry = &policy
+	return b
+}
+
+// WithRateLimit limits the sends per transport to the rate (per second), with bursts of up to burst sends.
+func (b *NotifierBuilder) WithRateLimit(rate float64, burst int) *NotifierBuilder {
+	if rate <= 0 {
+		b.errs = append(b.errs, errors.New("rate limit must be positive"))
+		return b
+	}
+	b.rateLimit = RateLimitMiddleware(rate, burst)
+	return b
+}
+
+// WithLogger logs every send.
+func (b *NotifierBuilder) WithLogger(logger *slog.Logger) *NotifierBuilder {
+	b.logger = logger
+	return b
+}
+
+// WithPanicRecovery recovers panics of transports and middleware, see RecoverMiddleware.
+// The callback is optional.
+func (b *NotifierBuilder) WithPanicRecovery(onPanic func(ctx context.Context, message MessageInterface, err *PanicError)) *NotifierBuilder {
+	b.recover = true
+	b.onPanic = onPanic
+	return b
+}
+
+// WithMiddleware adds custom middleware, wrapping the built-in middleware.
+func (b *NotifierBuilder) WithMiddleware(middleware ...Middleware) *NotifierBuilder {
+	b.middleware = append(b.middleware, middleware...)
+	return b
+}
+
+// WithDefaultOptions registers default options for a transport key, see Notifier.SetDefaultOptions.
+func (b *NotifierBuilder) WithDefaultOptions(transportKey string, defaults func() MessageOptionsInterface) *NotifierBuilder {
+	b.defaultOptions[transportKey] = defaults
+	return b
+}
+
+// WithNotifyConcurrency sets the number of concurrent sends of Notifier.Notify.
+func (b *NotifierBuilder) WithNotifyConcurrency(concurrency int) *NotifierBuilder {
+	b.concurrency = concurrency
+	return b
+}
+
+// Build creates the Notifier, or returns the errors of the configuration.
+func (b *NotifierBuilder) Build() (*Notifier, error) {
+	if len(b.transports) == 0 && len(b.errs) == 0 {
+		b.errs = append(b.errs, errors.New("no transports configured"))
+	}
+	if err := errors.Join(b.errs...); err != nil {
+		return nil, err
+	}
+
+	n := NewNotifier(b.transports...).SetNotifyConcurrency(b.concurrency)
+	for key, defaults := range b.defaultOptions {
+		n.SetDefaultOptions(key, defaults)
+	}
+
+	if b.recover {
+		n.Use(RecoverMiddleware(b.onPanic))
+	}
+	n.Use(b.middleware...)
+	if b.logger != nil {
+		n.Use(LoggerMiddleware(b.logger))
+	}
+	if b.retry != nil {
+		n.Use(RetryMiddleware(*b.retry))
+	}
+	if b.rateLimit != nil {
+		n.Use(b.rateLimit)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,129 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuilderErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		builder  *NotifierBuilder
+		expected string
+	}{
+		{
+			name:     "no transports",
+			builder:  Builder(),
+			expected: "no transports configured",
+		},
+		{
+			name:     "invalid DSN",
+			builder:  Builder().WithDSN("invalid"),
+			expected: "DSN must contain a scheme",
+		},
+		{
+			name:     "invalid rate limit",
+			builder:  Builder().WithTransport(&testTransport{key: "test"}).WithRateLimit(0, 1),
+			expected: "rate limit must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.builder.Build(); err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestBuilderMiddlewareOrder(t *testing.T) {
+	var logs bytes.Buffer
+	attempts := 0
+	transport := &testTransport{key: "test", send: func(ctx context.Context, message MessageInterface) error {
+		attempts++
+		if attempts < 3 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}}
+
+	var loggedBefore, loggedAfter int
+	custom := func(next SendFunc) SendFunc {
+		return func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+			if message.GetSubject() == "panic" {
+				panic("custom middleware")
+			}
+			loggedBefore = strings.Count(logs.String(), "\n")
+			sent, err := next(ctx, transport, message)
+			loggedAfter = strings.Count(logs.String(), "\n")
+			return sent, err
+		}
+	}
+
+	// Registered in reverse order, the chain doesn't depend on it
+	n, err := Builder().
+		WithRateLimit(20, 1).
+		WithRetry(RetryPolicy{MaxAttempts: 3}).
+		WithLogger(slog.New(slog.NewTextHandler(&logs, nil))).
+		WithMiddleware(custom).
+		WithPanicRecovery(nil).
+		WithTransport(transport).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	start := time.Now()
+	if _, err := n.Send(context.Background(), NewChatMessage("Disk full")); err != nil {
+		t.Fatalf("Expected the retry to succeed, got: %v", err)
+	}
+	elapsed := time.Since(start)
+
+	if attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", attempts)
+	}
+	// The logger runs inside the custom middleware and logs the final result once
+	if loggedBefore != 0 || loggedAfter != 1 || !strings.Contains(logs.String(), "notification sent") {
+		t.Errorf("Unexpected logs (%d before, %d after): %s", loggedBefore, loggedAfter, logs.String())
+	}
+	// Every attempt waits for the rate limit, as the rate limit runs inside the retry
+	if elapsed < 90*time.Millisecond {
+		t.Errorf("Expected the retries to be rate limited, took %s", elapsed)
+	}
+
+	// Panic recovery wraps the custom middleware
+	var panicErr *PanicError
+	if _, err := n.Send(context.Background(), NewChatMessage("panic")); !errors.As(err, &panicErr) {
+		t.Errorf("Expected PanicError, got: %v", err)
+	}
+}
+
+func TestBuilderDefaults(t *testing.T) {
+	transport := &testTransport{key: "test-merge"}
+	n, err := Builder().
+		WithTransport(transport).
+		WithDefaultOptions("test-merge", func() MessageOptionsInterface {
+			return &testMergeOptions{options: map[string]any{"username": "alerts"}}
+		}).
+		WithNotifyConcurrency(2).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+
+	if n.notifyConcurrency != 2 {
+		t.Errorf("Expected notify concurrency 2, got %d", n.notifyConcurrency)
+	}
+	if _, err := n.Send(context.Background(), NewChatMessage("Disk full")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if options := transport.sent()[0].(*ChatMessage).GetOptions("test-merge"); options == nil || options.ToMap()["username"] != "alerts" {
+		t.Errorf("Expected the default options, got: %v", options)
+	}
+}
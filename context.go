@@ -0,0 +1,40 @@
+package notifier
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultConnTimeout bounds a connection of a TCP based transport whose send context has no deadline.
+const DefaultConnTimeout = 30 * time.Second
+
+type httpClientKey struct{}
+
+// WithHTTPClient returns a context making HTTP transports send through the client instead of
+// their own, for a single send; e.g. for per-request proxies, per-tenant mTLS or tests.
+// Transport settings such as SetPathPrefix still apply.
+func WithHTTPClient(ctx context.Context, client *http.Client) context.Context {
+	return context.WithValue(ctx, httpClientKey{}, client)
+}
+
+// HTTPClientFromContext returns the client set with WithHTTPClient, or nil if there is none.
+func HTTPClientFromContext(ctx context.Context) *http.Client {
+	client, _ := ctx.Value(httpClientKey{}).(*http.Client)
+	return client
+}
+
+// BindConnContext bounds the I/O on conn by the context: the context deadline, or DefaultConnTimeout
+// without one, becomes the connection deadline, and cancelling the context unblocks pending reads
+// and writes. Call the returned function once the connection is closed to stop watching the context.
+func BindConnContext(ctx context.Context, conn net.Conn) (stop func() bool) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultConnTimeout)
+	}
+	_ = conn.SetDeadline(deadline)
+	return context.AfterFunc(ctx, func() {
+		_ = conn.SetDeadline(time.Now())
+	})
+}
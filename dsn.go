@@ -20,8 +20,15 @@ type DSN struct {
 }
 
 // NewDSN parses a DSN string and returns a DSN struct.
+//
+// Credentials don't need to be URL-encoded: tokens containing "/", "+" or "@" (e.g.,
+// "telegram://123:abc/def@default") are accepted as they are, everything up to the last "@"
+// before the query is taken as user and password. Encoded credentials are decoded as usual;
+// only "?" and "#" must always be encoded, as well as "@" in paths (e.g., "%40"). Options are
+// decoded as usual, so "+" is a space, except in unencoded phone numbers (e.g.,
+// "?from=+15551234567"); other values must encode a plus as "%2B".
 func NewDSN(dsn string) (*DSN, error) {
-	u, err := url.Parse(dsn)
+	u, err := url.Parse(escapeUserinfo(dsn))
 	if err != nil {
 		return nil, fmt.Errorf("invalid DSN: %w", err)
 	}
@@ -33,17 +40,9 @@ func NewDSN(dsn string) (*DSN, error) {
 		return nil, fmt.Errorf("DSN must contain a host (use 'default' by default)")
 	}
 
-	options := make(map[string]string)
-	if u.RawQuery != "" {
-		query, err := url.ParseQuery(u.RawQuery)
-		if err != nil {
-			return nil, fmt.Errorf("invalid query parameters: %w", err)
-		}
-		for k, v := range query {
-			if len(v) > 0 {
-				options[k] = v[0]
-			}
-		}
+	options, err := parseQuery(u.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query parameters: %w", err)
 	}
 
 	port := 0
@@ -68,6 +67,85 @@ func NewDSN(dsn string) (*DSN, error) {
 	}, nil
 }
 
+// parseQuery parses the query of a DSN like url.ParseQuery, so "+" is decoded as a space (e.g.,
+// "?title=Hello+World"). Values only made of digits, "+" and "," are taken as they are, to
+// accept unencoded E.164 phone numbers (e.g., "?from=+15551234567").
+// The first value of a repeated key wins.
+func parseQuery(query string) (map[string]string, error) {
+	options := make(map[string]string)
+	for query != "" {
+		var pair string
+		pair, query, _ = strings.Cut(query, "&")
+		if strings.Contains(pair, ";") {
+			return nil, fmt.Errorf("invalid semicolon separator in query")
+		}
+		if pair == "" {
+			continue
+		}
+		key, value, _ := strings.Cut(pair, "=")
+		key, err := url.QueryUnescape(key)
+		if err != nil {
+			return nil, err
+		}
+		if !isPhoneNumbers(value) {
+			value, err = url.QueryUnescape(value)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := options[key]; !ok {
+			options[key] = value
+		}
+	}
+	return options, nil
+}
+
+// isPhoneNumbers reports whether a raw query value is an unencoded phone number with a leading
+// "+", or a comma-separated list of them.
+func isPhoneNumbers(value string) bool {
+	if !strings.HasPrefix(value, "+") {
+		return false
+	}
+	for _, c := range value {
+		if (c < '0' || c > '9') && c != '+' && c != ',' {
+			return false
+		}
+	}
+	return true
+}
+
+// escapeUserinfo URL-encodes the user and password of a DSN, leaving already encoded
+// credentials as they are.
+func escapeUserinfo(dsn string) string {
+	scheme, rest, ok := strings.Cut(dsn, "://")
+	if !ok {
+		return dsn
+	}
+	authority := rest
+	if i := strings.IndexAny(authority, "?#"); i >= 0 {
+		authority = authority[:i]
+	}
+	at := strings.LastIndex(authority, "@")
+	if at < 0 {
+		return dsn
+	}
+
+	user, password, hasPassword := strings.Cut(rest[:at], ":")
+	userinfo := url.User(unescapeUserinfo(user))
+	if hasPassword {
+		userinfo = url.UserPassword(unescapeUserinfo(user), unescapeUserinfo(password))
+	}
+	return scheme + "://" + userinfo.String() + rest[at:]
+}
+
+// unescapeUserinfo decodes an encoded user or password, or returns it as is if it isn't encoded.
+func unescapeUserinfo(s string) string {
+	if unescaped, err := url.PathUnescape(s); err == nil {
+		return unescaped
+	}
+	return s
+}
+
 func (d *DSN) GetScheme() string {
 	return d.scheme
 }
@@ -0,0 +1,107 @@
+package notifier
+
+import (
+	"net/http"
+	"strings"
+)
+
+// SetPathPrefix prefixes the path of all requests sent to the configured host (e.g., "/proxied/api"),
+// to route them through an API gateway or a compatible mock server. Without a host set with
+// SetHost, requests go to the provider API unchanged.
+func (t *AbstractTransport) SetPathPrefix(prefix string) *AbstractTransport {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		prefix = "/" + prefix
+	}
+	t.pathPrefix = prefix
+	return t
+}
+
+// SetInsecureHTTP makes requests to the configured host use plain HTTP instead of HTTPS,
+// for local integration tests and self-hosted setups without TLS. Like SetPathPrefix, it
+// requires a host set with SetHost.
+func (t *AbstractTransport) SetInsecureHTTP(insecure bool) *AbstractTransport {
+	t.insecureHTTP = insecure
+	return t
+}
+
+// RequestSigner signs outbound requests, e.g. signing.Signer.
+type RequestSigner interface {
+	Sign(req *http.Request) error
+}
+
+// SetRequestSigner signs all requests of the transport, e.g. with an HMAC of the body for
+// receivers forwarding notifications (see the signing package).
+func (t *AbstractTransport) SetRequestSigner(signer RequestSigner) *AbstractTransport {
+	t.signer = signer
+	return t
+}
+
+// endpointClient returns the client with requests rewritten and signed according to the
+// endpoint settings, or the client itself if there is nothing to change.
+func (t *AbstractTransport) endpointClient(client *http.Client) *http.Client {
+	rewrite := (t.pathPrefix != "" || t.insecureHTTP) && t.host != ""
+	if !rewrite && t.signer == nil {
+		return client
+	}
+
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if t.signer != nil {
+		base = &signingRoundTripper{base: base, signer: t.signer}
+	}
+	if rewrite {
+		base = &endpointRoundTripper{
+			base:       base,
+			host:       t.GetEndpoint(),
+			pathPrefix: t.pathPrefix,
+			insecure:   t.insecureHTTP,
+		}
+	}
+	wrapped := *client
+	wrapped.Transport = base
+	return &wrapped
+}
+
+// signingRoundTripper signs requests before sending them.
+type signingRoundTripper struct {
+	base   http.RoundTripper
+	signer RequestSigner
+}
+
+func (rt *signingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not modify the request
+	req = req.Clone(req.Context())
+	if err := rt.signer.Sign(req); err != nil {
+		return nil, err
+	}
+	return rt.base.RoundTrip(req)
+}
+
+// endpointRoundTripper rewrites requests to a host, leaving requests to other hosts
+// (e.g., OAuth token endpoints) untouched.
+type endpointRoundTripper struct {
+	base       http.RoundTripper
+	host       string
+	pathPrefix string
+	insecure   bool
+}
+
+func (rt *endpointRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL.Host != rt.host {
+		return rt.base.RoundTrip(req)
+	}
+
+	// RoundTrippers must not modify the request
+	req = req.Clone(req.Context())
+	if rt.insecure && req.URL.Scheme == "https" {
+		req.URL.Scheme = "http"
+	}
+	req.URL.Path = rt.pathPrefix + req.URL.Path
+	if req.URL.RawPath != "" {
+		req.URL.RawPath = rt.pathPrefix + req.URL.RawPath
+	}
+	return rt.base.RoundTrip(req)
+}
@@ -0,0 +1,45 @@
+package notifier
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RateLimitError is returned when a provider rejects a request due to throttling.
+type RateLimitError struct {
+	// Transport is the name of the transport that was throttled (e.g., "microsoftteams").
+	Transport string
+	// RetryAfter is the delay suggested by the provider, zero if unknown.
+	RetryAfter time.Duration
+	// Message is the error reported by the provider.
+	Message string
+}
+
+func (e *RateLimitError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s: rate limited (retry after %s): %s", e.Transport, e.RetryAfter, e.Message)
+	}
+	return fmt.Sprintf("%s: rate limited: %s", e.Transport, e.Message)
+}
+
+// ParseRetryAfter parses a Retry-After header value given either in seconds or as an HTTP date.
+// It returns zero if the value is empty or invalid.
+func ParseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := time.Until(date); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -1,3 +1,10 @@
 module github.com/shyim/go-notifier
 
 go 1.25
+
+require (
+	github.com/mattn/go-sqlite3 v1.14.33
+	go.etcd.io/bbolt v1.4.0
+)
+
+require golang.org/x/sys v0.29.0 // indirect
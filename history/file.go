@@ -0,0 +1,77 @@
+package history
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/shyim/go-notifier/internal/linefile"
+)
+
+// FileStore is a Store appending entries as JSON lines to a file. All entries are loaded into
+// memory when the store is opened; SetMaxEntries only limits the entries kept in memory.
+// The file must only be used by a single process.
+type FileStore struct {
+	*MemoryStore
+	file *os.File
+}
+
+// OpenFileStore opens the store in the file, creating it and its directory if needed, and loads
+// the recorded entries.
+func OpenFileStore(path string) (*FileStore, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("history: create directory: %w", err)
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("history: open file: %w", err)
+	}
+
+	data, err := linefile.Read(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("history: read file: %w", err)
+	}
+
+	store := &FileStore{MemoryStore: NewMemoryStore(), file: file}
+	for n, line := range bytes.Split(data, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal(line, &entry); err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("history: decode line %d: %w", n+1, err)
+		}
+		store.add(&entry)
+	}
+
+	return store, nil
+}
+
+func (s *FileStore) Record(ctx context.Context, entry *Entry) error {
+	if entry.ID == "" {
+		entry.ID = newID(entry.SentAt)
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("history: marshal entry: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("history: write entry: %w", err)
+	}
+	s.add(entry)
+	return nil
+}
+
+// Close closes the file.
+func (s *FileStore) Close() error {
+	return s.file.Close()
+}
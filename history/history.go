@@ -0,0 +1,124 @@
+// Package history records sent notifications, so applications can show users a log of the
+// notifications that were sent to them.
+//
+// Add Middleware to a Notifier to record every send in a Store, successful or not, and query the
+// store by recipient, transport and time range.
+package history
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Entry is a recorded send.
+type Entry struct {
+	ID string `json:"id"`
+	// Transport is the key of the transport the message was sent with (e.g., "telegram").
+	Transport string `json:"transport"`
+	// Recipient is the recipient of the message, or of the options for the transport.
+	Recipient string `json:"recipient,omitempty"`
+	// Type is the message kind: "chat", "push", "email" or "sms".
+	Type      string `json:"type,omitempty"`
+	Subject   string `json:"subject"`
+	Content   string `json:"content,omitempty"`
+	MessageID string `json:"message_id,omitempty"`
+	// Error is the error of a failed send.
+	Error  string    `json:"error,omitempty"`
+	SentAt time.Time `json:"sent_at"`
+}
+
+// Failed reports whether the send failed.
+func (e *Entry) Failed() bool {
+	return e.Error != ""
+}
+
+// Query filters entries. Empty fields match all entries.
+type Query struct {
+	Recipient string
+	Transport string
+	// Since and Until limit the entries to those sent at or after Since and before Until.
+	Since time.Time
+	Until time.Time
+	// Limit is the maximum number of entries returned, 0 for all.
+	Limit int
+}
+
+// Matches reports whether the entry matches the query.
+func (q Query) Matches(entry *Entry) bool {
+	if q.Recipient != "" && entry.Recipient != q.Recipient {
+		return false
+	}
+	if q.Transport != "" && entry.Transport != q.Transport {
+		return false
+	}
+	if !q.Since.IsZero() && entry.SentAt.Before(q.Since) {
+		return false
+	}
+	if !q.Until.IsZero() && !entry.SentAt.Before(q.Until) {
+		return false
+	}
+	return true
+}
+
+// Store persists recorded sends.
+type Store interface {
+	// Record stores an entry. An empty ID is assigned by the store.
+	Record(ctx context.Context, entry *Entry) error
+	// Query returns the matching entries, newest first.
+	Query(ctx context.Context, query Query) ([]*Entry, error)
+}
+
+// Middleware records every send in the store. Failing to record an entry doesn't fail the send;
+// the error is passed to onError, which may be nil.
+func Middleware(store Store, onError func(entry *Entry, err error)) notifier.Middleware {
+	return func(next notifier.SendFunc) notifier.SendFunc {
+		return func(ctx context.Context, transport notifier.TransportInterface, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+			sent, err := next(ctx, transport, message)
+
+			entry := NewEntry(transport, message, sent, err)
+			if recordErr := store.Record(ctx, entry); recordErr != nil && onError != nil {
+				onError(entry, recordErr)
+			}
+			return sent, err
+		}
+	}
+}
+
+// NewEntry creates the entry of a send.
+func NewEntry(transport notifier.TransportInterface, message notifier.MessageInterface, sent *notifier.SentMessage, err error) *Entry {
+	key := notifier.TransportKey(transport)
+	payload := notifier.NewPayload(message)
+
+	entry := &Entry{
+		Transport: key,
+		Recipient: payload.Recipient,
+		Type:      payload.Type,
+		Subject:   payload.Subject,
+		Content:   payload.Content,
+		SentAt:    time.Now(),
+	}
+	if entry.Recipient == "" {
+		if options := message.GetOptions(key); options != nil {
+			entry.Recipient = options.GetRecipientId()
+		}
+	}
+	if sent != nil {
+		entry.MessageID = sent.GetMessageID()
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	return entry
+}
+
+// newID returns a unique ID sorting by send time.
+func newID(now time.Time) string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%020d-%s", now.UnixNano(), hex.EncodeToString(b))
+}
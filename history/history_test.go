@@ -0,0 +1,167 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/transport/telegram"
+)
+
+func TestMiddleware(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	transport := telegram.NewTransport("123:abc", "42", nil)
+
+	failing := true
+	send := Middleware(store, nil)(func(ctx context.Context, transport notifier.TransportInterface, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		if failing {
+			return nil, errors.New("telegram: chat not found")
+		}
+		sent := notifier.NewSentMessage(message, transport.String())
+		sent.SetMessageID("7")
+		return sent, nil
+	})
+
+	message := notifier.NewChatMessage("Deploy finished").Content("v1.2.3 is live")
+	if _, err := send(ctx, transport, message); err == nil {
+		t.Fatal("Expected the send error to be returned")
+	}
+	failing = false
+	if _, err := send(ctx, transport, message.WithOptions("telegram", telegram.NewOptions().ChatID("99"))); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	entries, _ := store.Query(ctx, Query{Transport: "telegram"})
+	if len(entries) != 2 {
+		t.Fatalf("Expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].MessageID != "7" || entries[0].Recipient != "99" || entries[0].Failed() {
+		t.Errorf("Expected the successful send first, got %+v", entries[0])
+	}
+	if entries[1].Error != "telegram: chat not found" || !entries[1].Failed() {
+		t.Errorf("Expected the failed send, got %+v", entries[1])
+	}
+	if entries[0].Subject != "Deploy finished" || entries[0].Content != "v1.2.3 is live" || entries[0].Type != "chat" {
+		t.Errorf("Expected the message to be recorded, got %+v", entries[0])
+	}
+}
+
+func TestQuery(t *testing.T) {
+	store := NewMemoryStore()
+	testQuery(t, store)
+
+	store.SetMaxEntries(2)
+	if store.Len() != 2 {
+		t.Errorf("Expected 2 entries after SetMaxEntries, got %d", store.Len())
+	}
+}
+
+func TestSQLiteStore(t *testing.T) {
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "history.db"))
+	if err != nil {
+		t.Fatalf("Failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	store, err := NewSQLiteStore(context.Background(), db)
+	if err != nil {
+		t.Fatalf("Failed to create store: %v", err)
+	}
+	testQuery(t, store)
+
+	// The table is only created once
+	if _, err := NewSQLiteStore(context.Background(), db); err != nil {
+		t.Fatalf("Failed to create store again: %v", err)
+	}
+	entry := &Entry{Transport: "slack", Recipient: "carol", Type: "chat", Subject: "Deploy", Content: "done", MessageID: "42", Error: "boom", SentAt: time.Now()}
+	if err := store.Record(context.Background(), entry); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+	entries, _ := store.Query(context.Background(), Query{Recipient: "carol"})
+	if len(entries) != 1 || *entries[0] != (Entry{ID: entry.ID, Transport: "slack", Recipient: "carol", Type: "chat", Subject: "Deploy", Content: "done", MessageID: "42", Error: "boom", SentAt: entries[0].SentAt}) || !entries[0].SentAt.Equal(entry.SentAt) {
+		t.Errorf("Unexpected entries: %+v", entries)
+	}
+}
+
+// testQuery records entries in the store and checks the results of queries.
+func testQuery(t *testing.T, store Store) {
+	ctx := context.Background()
+	start := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	for i, recipient := range []string{"alice", "bob", "alice", "alice"} {
+		_ = store.Record(ctx, &Entry{Transport: "slack", Recipient: recipient, SentAt: start.Add(time.Duration(i) * time.Hour)})
+	}
+	_ = store.Record(ctx, &Entry{Transport: "telegram", Recipient: "alice", SentAt: start.Add(30 * time.Minute)})
+
+	tests := []struct {
+		name     string
+		query    Query
+		expected []time.Duration
+	}{
+		{"all", Query{}, []time.Duration{3 * time.Hour, 2 * time.Hour, time.Hour, 30 * time.Minute, 0}},
+		{"recipient", Query{Recipient: "alice", Transport: "slack"}, []time.Duration{3 * time.Hour, 2 * time.Hour, 0}},
+		{"time range", Query{Since: start.Add(time.Hour), Until: start.Add(3 * time.Hour)}, []time.Duration{2 * time.Hour, time.Hour}},
+		{"limit", Query{Recipient: "alice", Limit: 2}, []time.Duration{3 * time.Hour, 2 * time.Hour}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := store.Query(ctx, tt.query)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if len(entries) != len(tt.expected) {
+				t.Fatalf("Expected %d entries, got %d", len(tt.expected), len(entries))
+			}
+			for i, entry := range entries {
+				if !entry.SentAt.Equal(start.Add(tt.expected[i])) {
+					t.Errorf("Expected entry %d sent at %s, got %s", i, start.Add(tt.expected[i]), entry.SentAt)
+				}
+			}
+		})
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "history", "sent.jsonl")
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	store, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	_ = store.Record(ctx, &Entry{Transport: "slack", Recipient: "alice", Subject: "first", SentAt: now})
+	_ = store.Record(ctx, &Entry{Transport: "slack", Recipient: "alice", Subject: "second", SentAt: now.Add(time.Minute)})
+	_ = store.Close()
+
+	// Simulate a crash during a write
+	file, _ := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	_, _ = file.WriteString(`{"id":"partial","subj`)
+	_ = file.Close()
+
+	store, err = OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	defer store.Close()
+	if err := store.Record(ctx, &Entry{Transport: "slack", Recipient: "alice", Subject: "third", SentAt: now.Add(2 * time.Minute)}); err != nil {
+		t.Fatalf("Record failed: %v", err)
+	}
+
+	reopened, err := OpenFileStore(path)
+	if err != nil {
+		t.Fatalf("Failed to reopen store: %v", err)
+	}
+	defer reopened.Close()
+	entries, _ := reopened.Query(ctx, Query{Recipient: "alice"})
+	if len(entries) != 3 || entries[0].Subject != "third" || entries[2].Subject != "first" || entries[0].ID == "" {
+		t.Errorf("Expected 3 entries newest first, got %+v", entries)
+	}
+}
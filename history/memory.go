@@ -0,0 +1,84 @@
+package history
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryStore is a Store keeping entries in memory, optionally only the most recent ones.
+type MemoryStore struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	entries []*Entry
+}
+
+// NewMemoryStore creates a new MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+// SetMaxEntries sets the number of entries kept, dropping the oldest ones. A value of 0 keeps all.
+func (s *MemoryStore) SetMaxEntries(maxEntries int) *MemoryStore {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxEntries = maxEntries
+	s.trim()
+	return s
+}
+
+// Len returns the number of stored entries.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries)
+}
+
+func (s *MemoryStore) Record(ctx context.Context, entry *Entry) error {
+	if entry.ID == "" {
+		entry.ID = newID(entry.SentAt)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.add(entry)
+	return nil
+}
+
+func (s *MemoryStore) Query(ctx context.Context, query Query) ([]*Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []*Entry
+	for i := len(s.entries) - 1; i >= 0; i-- {
+		if !query.Matches(s.entries[i]) {
+			continue
+		}
+		entry := *s.entries[i]
+		result = append(result, &entry)
+		if query.Limit > 0 && len(result) == query.Limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// add inserts the entry, keeping the entries sorted by send time.
+func (s *MemoryStore) add(entry *Entry) {
+	stored := *entry
+	i := len(s.entries)
+	for i > 0 && s.entries[i-1].SentAt.After(stored.SentAt) {
+		i--
+	}
+	s.entries = append(s.entries, nil)
+	copy(s.entries[i+1:], s.entries[i:])
+	s.entries[i] = &stored
+	s.trim()
+}
+
+func (s *MemoryStore) trim() {
+	if s.maxEntries > 0 && len(s.entries) > s.maxEntries {
+		s.entries = append([]*Entry(nil), s.entries[len(s.entries)-s.maxEntries:]...)
+	}
+}
@@ -0,0 +1,114 @@
+package history
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// sqliteSchema creates the history table with indexes for the queries by recipient and transport.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS notification_history (
+	id         TEXT PRIMARY KEY,
+	transport  TEXT NOT NULL,
+	recipient  TEXT NOT NULL DEFAULT '',
+	type       TEXT NOT NULL DEFAULT '',
+	subject    TEXT NOT NULL DEFAULT '',
+	content    TEXT NOT NULL DEFAULT '',
+	message_id TEXT NOT NULL DEFAULT '',
+	error      TEXT NOT NULL DEFAULT '',
+	sent_at    INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS notification_history_sent_at ON notification_history (sent_at);
+CREATE INDEX IF NOT EXISTS notification_history_recipient ON notification_history (recipient, sent_at);
+CREATE INDEX IF NOT EXISTS notification_history_transport ON notification_history (transport, sent_at);
+`
+
+// SQLiteStore is a Store keeping entries in the notification_history table of an SQLite
+// database. Entries are queried in the database, so they are not loaded into memory. The
+// database is opened by the application with the SQLite driver of its choice, e.g.
+// github.com/mattn/go-sqlite3 or modernc.org/sqlite, and can be shared by several processes.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore creates the store in the database, creating the table if needed.
+func NewSQLiteStore(ctx context.Context, db *sql.DB) (*SQLiteStore, error) {
+	if _, err := db.ExecContext(ctx, sqliteSchema); err != nil {
+		return nil, fmt.Errorf("history: create table: %w", err)
+	}
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) Record(ctx context.Context, entry *Entry) error {
+	if entry.ID == "" {
+		entry.ID = newID(entry.SentAt)
+	}
+
+	_, err := s.db.ExecContext(ctx,
+		`INSERT INTO notification_history (id, transport, recipient, type, subject, content, message_id, error, sent_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		entry.ID, entry.Transport, entry.Recipient, entry.Type, entry.Subject, entry.Content,
+		entry.MessageID, entry.Error, entry.SentAt.UnixNano(),
+	)
+	if err != nil {
+		return fmt.Errorf("history: insert entry: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLiteStore) Query(ctx context.Context, query Query) ([]*Entry, error) {
+	var conditions []string
+	var args []any
+	if query.Recipient != "" {
+		conditions = append(conditions, "recipient = ?")
+		args = append(args, query.Recipient)
+	}
+	if query.Transport != "" {
+		conditions = append(conditions, "transport = ?")
+		args = append(args, query.Transport)
+	}
+	if !query.Since.IsZero() {
+		conditions = append(conditions, "sent_at >= ?")
+		args = append(args, query.Since.UnixNano())
+	}
+	if !query.Until.IsZero() {
+		conditions = append(conditions, "sent_at < ?")
+		args = append(args, query.Until.UnixNano())
+	}
+
+	statement := "SELECT id, transport, recipient, type, subject, content, message_id, error, sent_at FROM notification_history"
+	if len(conditions) > 0 {
+		statement += " WHERE " + strings.Join(conditions, " AND ")
+	}
+	statement += " ORDER BY sent_at DESC, id DESC"
+	if query.Limit > 0 {
+		statement += " LIMIT ?"
+		args = append(args, query.Limit)
+	}
+
+	rows, err := s.db.QueryContext(ctx, statement, args...)
+	if err != nil {
+		return nil, fmt.Errorf("history: query entries: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+
+	var result []*Entry
+	for rows.Next() {
+		var entry Entry
+		var sentAt int64
+		err := rows.Scan(&entry.ID, &entry.Transport, &entry.Recipient, &entry.Type, &entry.Subject,
+			&entry.Content, &entry.MessageID, &entry.Error, &sentAt)
+		if err != nil {
+			return nil, fmt.Errorf("history: read entry: %w", err)
+		}
+		entry.SentAt = time.Unix(0, sentAt)
+		result = append(result, &entry)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("history: read entries: %w", err)
+	}
+	return result, nil
+}
@@ -0,0 +1,19 @@
+package notifier
+
+// Importance levels describe how urgent a message is.
+// Transports translate them into provider-specific priorities where supported.
+const (
+	ImportanceUrgent = "urgent"
+	ImportanceHigh   = "high"
+	ImportanceMedium = "medium"
+	ImportanceLow    = "low"
+)
+
+// ImportanceColors is the palette (0xRRGGBB) transports use to color messages by importance,
+// so an alert looks the same on every platform that supports colors.
+var ImportanceColors = map[string]int{
+	ImportanceUrgent: 0xD32F2F,
+	ImportanceHigh:   0xF57C00,
+	ImportanceMedium: 0xFBC02D,
+	ImportanceLow:    0x1976D2,
+}
@@ -0,0 +1,44 @@
+package awsauth
+
+import (
+	"errors"
+	"io/fs"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var testCredentials = &Credentials{AccessKeyID: "AKIDEXAMPLE", SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY"}
+
+func TestSignRequest(t *testing.T) {
+	// get-vanilla from the AWS SigV4 test suite
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/", nil)
+	SignRequest(req, nil, testCredentials, "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	expected := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=5fa00fa31553b73ebf1942676e86291e8372ff2a2260956d9b8aae1d763fbf31"
+	if req.Header.Get("Authorization") != expected {
+		t.Errorf("Unexpected signature:\n got: %s\nwant: %s", req.Header.Get("Authorization"), expected)
+	}
+}
+
+func TestSignRequestQuery(t *testing.T) {
+	// get-vanilla-query-order-key-case from the AWS SigV4 test suite
+	req, _ := http.NewRequest("GET", "https://example.amazonaws.com/?Param2=value2&Param1=value1", nil)
+	SignRequest(req, nil, testCredentials, "us-east-1", "service", time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	expected := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/service/aws4_request, SignedHeaders=host;x-amz-date, Signature=b97d918cfa904a5beff61c982a1b6f458b799221646efd99d3219ec94cdf2500"
+	if req.Header.Get("Authorization") != expected {
+		t.Errorf("Unexpected signature:\n got: %s\nwant: %s", req.Header.Get("Authorization"), expected)
+	}
+}
+
+func TestLoadDefaultCredentials(t *testing.T) {
+	t.Setenv("AWS_ACCESS_KEY_ID", "")
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(t.TempDir(), "missing"))
+
+	_, err := LoadDefaultCredentials()
+	if err == nil || !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected missing file error, got: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+// Package linefile reads files that records are appended to as lines.
+package linefile
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Read returns the complete lines of the file, which must be opened for reading and writing.
+// A crash during a write can only leave a partial last line behind, so it is truncated before
+// more lines are appended.
+func Read(file *os.File) ([]byte, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+
+	if i := bytes.LastIndexByte(data, '\n'); i < len(data)-1 {
+		data = data[:i+1]
+		if err := file.Truncate(int64(len(data))); err != nil {
+			return nil, fmt.Errorf("truncate partial line: %w", err)
+		}
+	}
+	return data, nil
+}
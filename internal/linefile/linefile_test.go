@@ -0,0 +1,32 @@
+package linefile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lines")
+	if err := os.WriteFile(path, []byte("one\ntwo\nthr"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { _ = file.Close() }()
+
+	data, err := Read(file)
+	if err != nil || string(data) != "one\ntwo\n" {
+		t.Fatalf("Unexpected lines: %q (%v)", data, err)
+	}
+
+	// Lines appended after the truncation start on a line of their own
+	if _, err := file.WriteString("three\n"); err != nil {
+		t.Fatal(err)
+	}
+	if data, _ := Read(file); string(data) != "one\ntwo\nthree\n" {
+		t.Errorf("Unexpected lines after append: %q", data)
+	}
+}
@@ -0,0 +1,20 @@
+package notifier
+
+import "encoding/json"
+
+// JSONMarshal encodes the request payloads of all transports and the payloads forwarded to
+// brokers, queues and files. Replace it during initialization to use another encoder (e.g., a
+// faster third-party one), which must produce output compatible with encoding/json and be safe
+// for concurrent use.
+var JSONMarshal = json.Marshal
+
+// StaticJSON encodes v once with JSONMarshal, for payload parts that are the same for many
+// messages (e.g., a block layout shared by all alerts). The result can be used as a value in
+// options and is copied as is when encoding payloads, instead of being encoded every time.
+func StaticJSON(v any) (json.RawMessage, error) {
+	data, err := JSONMarshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
@@ -23,9 +23,13 @@ type MessageOptionsInterface interface {
 
 // ChatMessage represents a chat message (e.g., Telegram, Slack).
 type ChatMessage struct {
-	subject   string
-	options   map[string]MessageOptionsInterface
-	transport string
+	subject    string
+	content    string
+	options    map[string]MessageOptionsInterface
+	transport  string
+	importance string
+	subjects   map[string]string
+	contents   map[string]string
 }
 
 func NewChatMessage(subject string) *ChatMessage {
@@ -77,6 +81,432 @@ func (m *ChatMessage) Subject(subject string) *ChatMessage {
 	return m
 }
 
+// Content sets an optional body. Transports with separate title and body fields use the subject
+// as title (e.g., Gotify, Microsoft Teams); others send the subject followed by the body.
+func (m *ChatMessage) Content(content string) *ChatMessage {
+	m.content = content
+	return m
+}
+
+// GetContent returns the body, or an empty string if unset.
+func (m *ChatMessage) GetContent() string {
+	return m.content
+}
+
+// GetText returns the subject followed by the body, separated by an empty line, for transports
+// sending a single text.
+func (m *ChatMessage) GetText() string {
+	if m.content == "" {
+		return m.subject
+	}
+	return m.subject + "\n\n" + m.content
+}
+
+// Importance sets the message importance (e.g., ImportanceHigh).
+func (m *ChatMessage) Importance(importance string) *ChatMessage {
+	m.importance = importance
+	return m
+}
+
+// GetImportance returns the message importance, or an empty string if unset.
+func (m *ChatMessage) GetImportance() string {
+	return m.importance
+}
+
+// SubjectFor sets an alternate subject for a transport key (e.g., a short text for "sms" and a
+// long one for "slack"). The Notifier applies it when sending through that transport; when
+// calling a transport directly, use ForTransport.
+func (m *ChatMessage) SubjectFor(transportKey, subject string) *ChatMessage {
+	if m.subjects == nil {
+		m.subjects = make(map[string]string)
+	}
+	m.subjects[transportKey] = subject
+	return m
+}
+
+// ContentFor sets an alternate body for a transport key, see SubjectFor.
+func (m *ChatMessage) ContentFor(transportKey, content string) *ChatMessage {
+	if m.contents == nil {
+		m.contents = make(map[string]string)
+	}
+	m.contents[transportKey] = content
+	return m
+}
+
+// ForTransport returns the message as sent through the transport key: a copy with the
+// overrides set by SubjectFor and ContentFor applied, or the message itself if there are none.
+func (m *ChatMessage) ForTransport(transportKey string) *ChatMessage {
+	subject, hasSubject := m.subjects[transportKey]
+	content, hasContent := m.contents[transportKey]
+	if !hasSubject && !hasContent {
+		return m
+	}
+	c := *m
+	if hasSubject {
+		c.subject = subject
+	}
+	if hasContent {
+		c.content = content
+	}
+	return &c
+}
+
+// PushMessage represents a push notification for mobile or web devices (e.g., FCM, APNs).
+type PushMessage struct {
+	subject    string
+	content    string
+	recipient  string
+	data       map[string]string
+	options    map[string]MessageOptionsInterface
+	transport  string
+	importance string
+}
+
+func NewPushMessage(subject, content string) *PushMessage {
+	return &PushMessage{
+		subject: subject,
+		content: content,
+		data:    make(map[string]string),
+		options: make(map[string]MessageOptionsInterface),
+	}
+}
+
+// GetRecipientId returns the device token, falling back to the transport options.
+func (m *PushMessage) GetRecipientId() string {
+	if m.recipient != "" {
+		return m.recipient
+	}
+	for _, opt := range m.options {
+		if id := opt.GetRecipientId(); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// GetSubject returns the notification title.
+func (m *PushMessage) GetSubject() string {
+	return m.subject
+}
+
+// GetContent returns the notification body.
+func (m *PushMessage) GetContent() string {
+	return m.content
+}
+
+// GetData returns the custom data payload.
+func (m *PushMessage) GetData() map[string]string {
+	return m.data
+}
+
+// GetOptions returns options for a specific transport key.
+func (m *PushMessage) GetOptions(transportKey string) MessageOptionsInterface {
+	return m.options[transportKey]
+}
+
+func (m *PushMessage) GetTransport() string {
+	return m.transport
+}
+
+// GetImportance returns the message importance, or an empty string if unset.
+func (m *PushMessage) GetImportance() string {
+	return m.importance
+}
+
+// Recipient sets the device token to deliver the notification to.
+func (m *PushMessage) Recipient(deviceToken string) *PushMessage {
+	m.recipient = deviceToken
+	return m
+}
+
+// Data adds a key-value pair to the custom data payload.
+func (m *PushMessage) Data(key, value string) *PushMessage {
+	m.data[key] = value
+	return m
+}
+
+// WithOptions adds transport-specific options.
+// The key should be the transport scheme (e.g., "fcm", "apns").
+func (m *PushMessage) WithOptions(transportKey string, options MessageOptionsInterface) *PushMessage {
+	m.options[transportKey] = options
+	return m
+}
+
+// Transport sets the specific transport to use.
+func (m *PushMessage) Transport(transport string) *PushMessage {
+	m.transport = transport
+	return m
+}
+
+// Subject sets the notification title.
+func (m *PushMessage) Subject(subject string) *PushMessage {
+	m.subject = subject
+	return m
+}
+
+// Content sets the notification body.
+func (m *PushMessage) Content(content string) *PushMessage {
+	m.content = content
+	return m
+}
+
+// Importance sets the message importance (e.g., ImportanceHigh).
+func (m *PushMessage) Importance(importance string) *PushMessage {
+	m.importance = importance
+	return m
+}
+
+// Attachment is a file attached to an EmailMessage.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Data        []byte
+}
+
+// EmailMessage represents an email (e.g., SMTP, SES).
+type EmailMessage struct {
+	subject     string
+	from        string
+	to          []string
+	cc          []string
+	bcc         []string
+	replyTo     string
+	text        string
+	html        string
+	attachments []Attachment
+	headers     map[string]string
+	options     map[string]MessageOptionsInterface
+	transport   string
+}
+
+func NewEmailMessage(subject string) *EmailMessage {
+	return &EmailMessage{
+		subject: subject,
+		headers: make(map[string]string),
+		options: make(map[string]MessageOptionsInterface),
+	}
+}
+
+// GetRecipientId returns the first "To" address.
+func (m *EmailMessage) GetRecipientId() string {
+	if len(m.to) > 0 {
+		return m.to[0]
+	}
+	for _, opt := range m.options {
+		if id := opt.GetRecipientId(); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func (m *EmailMessage) GetSubject() string {
+	return m.subject
+}
+
+// GetOptions returns options for a specific transport key.
+func (m *EmailMessage) GetOptions(transportKey string) MessageOptionsInterface {
+	return m.options[transportKey]
+}
+
+func (m *EmailMessage) GetTransport() string {
+	return m.transport
+}
+
+func (m *EmailMessage) GetFrom() string {
+	return m.from
+}
+
+func (m *EmailMessage) GetTo() []string {
+	return m.to
+}
+
+func (m *EmailMessage) GetCc() []string {
+	return m.cc
+}
+
+func (m *EmailMessage) GetBcc() []string {
+	return m.bcc
+}
+
+func (m *EmailMessage) GetReplyTo() string {
+	return m.replyTo
+}
+
+// GetText returns the plain text body.
+func (m *EmailMessage) GetText() string {
+	return m.text
+}
+
+// GetHTML returns the HTML body.
+func (m *EmailMessage) GetHTML() string {
+	return m.html
+}
+
+func (m *EmailMessage) GetAttachments() []Attachment {
+	return m.attachments
+}
+
+// GetHeaders returns the custom headers.
+func (m *EmailMessage) GetHeaders() map[string]string {
+	return m.headers
+}
+
+// From sets the sender address, overriding the transport default.
+func (m *EmailMessage) From(address string) *EmailMessage {
+	m.from = address
+	return m
+}
+
+// To adds "To" recipients.
+func (m *EmailMessage) To(addresses ...string) *EmailMessage {
+	m.to = append(m.to, addresses...)
+	return m
+}
+
+// Cc adds "Cc" recipients.
+func (m *EmailMessage) Cc(addresses ...string) *EmailMessage {
+	m.cc = append(m.cc, addresses...)
+	return m
+}
+
+// Bcc adds "Bcc" recipients.
+func (m *EmailMessage) Bcc(addresses ...string) *EmailMessage {
+	m.bcc = append(m.bcc, addresses...)
+	return m
+}
+
+// ReplyTo sets the "Reply-To" address.
+func (m *EmailMessage) ReplyTo(address string) *EmailMessage {
+	m.replyTo = address
+	return m
+}
+
+// Text sets the plain text body.
+func (m *EmailMessage) Text(body string) *EmailMessage {
+	m.text = body
+	return m
+}
+
+// HTML sets the HTML body. When a text body is set as well, both are sent as alternatives.
+func (m *EmailMessage) HTML(body string) *EmailMessage {
+	m.html = body
+	return m
+}
+
+// Attach adds an attachment.
+func (m *EmailMessage) Attach(filename, contentType string, data []byte) *EmailMessage {
+	m.attachments = append(m.attachments, Attachment{
+		Filename:    filename,
+		ContentType: contentType,
+		Data:        data,
+	})
+	return m
+}
+
+// Header sets a custom header.
+func (m *EmailMessage) Header(name, value string) *EmailMessage {
+	m.headers[name] = value
+	return m
+}
+
+// WithOptions adds transport-specific options.
+// The key should be the transport scheme (e.g., "smtp", "ses").
+func (m *EmailMessage) WithOptions(transportKey string, options MessageOptionsInterface) *EmailMessage {
+	m.options[transportKey] = options
+	return m
+}
+
+// Transport sets the specific transport to use.
+func (m *EmailMessage) Transport(transport string) *EmailMessage {
+	m.transport = transport
+	return m
+}
+
+// Subject sets the email subject.
+func (m *EmailMessage) Subject(subject string) *EmailMessage {
+	m.subject = subject
+	return m
+}
+
+// SmsMessage represents a text message sent to a phone number (e.g., Vonage, Twilio).
+type SmsMessage struct {
+	phone     string
+	subject   string
+	from      string
+	options   map[string]MessageOptionsInterface
+	transport string
+}
+
+func NewSmsMessage(phone, subject string) *SmsMessage {
+	return &SmsMessage{
+		phone:   phone,
+		subject: subject,
+		options: make(map[string]MessageOptionsInterface),
+	}
+}
+
+// GetRecipientId returns the phone number.
+func (m *SmsMessage) GetRecipientId() string {
+	return m.phone
+}
+
+// GetPhone returns the phone number the message is sent to.
+func (m *SmsMessage) GetPhone() string {
+	return m.phone
+}
+
+// GetSubject returns the message text.
+func (m *SmsMessage) GetSubject() string {
+	return m.subject
+}
+
+// GetFrom returns the sender (phone number or alphanumeric sender ID), or an empty string
+// to use the transport's default.
+func (m *SmsMessage) GetFrom() string {
+	return m.from
+}
+
+// GetOptions returns options for a specific transport key.
+func (m *SmsMessage) GetOptions(transportKey string) MessageOptionsInterface {
+	return m.options[transportKey]
+}
+
+func (m *SmsMessage) GetTransport() string {
+	return m.transport
+}
+
+// Phone sets the phone number to send the message to, preferably in E.164 format.
+func (m *SmsMessage) Phone(phone string) *SmsMessage {
+	m.phone = phone
+	return m
+}
+
+// Subject sets the message text.
+func (m *SmsMessage) Subject(subject string) *SmsMessage {
+	m.subject = subject
+	return m
+}
+
+// From overrides the transport's default sender.
+func (m *SmsMessage) From(from string) *SmsMessage {
+	m.from = from
+	return m
+}
+
+// WithOptions adds transport-specific options.
+// The key should be the transport scheme (e.g., "vonage").
+func (m *SmsMessage) WithOptions(transportKey string, options MessageOptionsInterface) *SmsMessage {
+	m.options[transportKey] = options
+	return m
+}
+
+// Transport sets the specific transport to use.
+func (m *SmsMessage) Transport(transport string) *SmsMessage {
+	m.transport = transport
+	return m
+}
+
 // SentMessage represents a message that has been sent.
 type SentMessage struct {
 	original  MessageInterface
@@ -0,0 +1,85 @@
+package notifier
+
+import (
+	"context"
+	"testing"
+)
+
+func TestChatMessageForTransport(t *testing.T) {
+	message := NewChatMessage("Disk full on web-1").
+		Content("92% used on /var").
+		Importance(ImportanceHigh).
+		SubjectFor("sms", "Disk full").
+		ContentFor("slack", "*92%* used on `/var`").
+		ContentFor("sms", "")
+
+	tests := []struct {
+		transportKey    string
+		expectedSubject string
+		expectedContent string
+		expectedText    string
+	}{
+		{
+			transportKey:    "telegram",
+			expectedSubject: "Disk full on web-1",
+			expectedContent: "92% used on /var",
+			expectedText:    "Disk full on web-1\n\n92% used on /var",
+		},
+		{
+			transportKey:    "slack",
+			expectedSubject: "Disk full on web-1",
+			expectedContent: "*92%* used on `/var`",
+			expectedText:    "Disk full on web-1\n\n*92%* used on `/var`",
+		},
+		{
+			transportKey:    "sms",
+			expectedSubject: "Disk full",
+			expectedText:    "Disk full",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.transportKey, func(t *testing.T) {
+			m := message.ForTransport(tt.transportKey)
+			if m.GetSubject() != tt.expectedSubject || m.GetContent() != tt.expectedContent || m.GetText() != tt.expectedText {
+				t.Errorf("Unexpected message: %q, %q, %q", m.GetSubject(), m.GetContent(), m.GetText())
+			}
+			if m.GetImportance() != ImportanceHigh {
+				t.Errorf("Expected the importance to be kept, got %q", m.GetImportance())
+			}
+		})
+	}
+
+	if message.ForTransport("telegram") != message {
+		t.Error("Expected the message itself without overrides")
+	}
+	if message.GetSubject() != "Disk full on web-1" || message.GetContent() != "92% used on /var" {
+		t.Error("Expected the message not to be modified")
+	}
+}
+
+func TestNotifierAppliesOverrides(t *testing.T) {
+	slack := &testTransport{key: "slack"}
+	sms := &testTransport{key: "sms"}
+	message := NewChatMessage("Disk full on web-1").
+		Importance(ImportanceUrgent).
+		SubjectFor("sms", "Disk full")
+
+	if _, err := NewNotifier(slack, sms).SendAll(context.Background(), message); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	tests := []struct {
+		transport *testTransport
+		expected  string
+	}{
+		{transport: slack, expected: "Disk full on web-1"},
+		{transport: sms, expected: "Disk full"},
+	}
+	for _, tt := range tests {
+		sent := tt.transport.sent()[0].(*ChatMessage)
+		if sent.GetSubject() != tt.expected || sent.GetImportance() != ImportanceUrgent {
+			t.Errorf("%s: unexpected message %q (%s)", tt.transport, sent.GetSubject(), sent.GetImportance())
+		}
+	}
+}
@@ -0,0 +1,229 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+// SendFunc sends a message through a transport.
+type SendFunc func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error)
+
+// Middleware wraps the sends of a Notifier, e.g. to retry, throttle or log them.
+type Middleware func(next SendFunc) SendFunc
+
+// Use adds middleware to the Notifier. The first middleware is the outermost one.
+func (n *Notifier) Use(middleware ...Middleware) *Notifier {
+	n.middleware = append(n.middleware, middleware...)
+	return n
+}
+
+// send sends the message through the transport and the middleware chain.
+func (n *Notifier) send(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+	next := func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+		return transport.Send(ctx, message)
+	}
+	for i := len(n.middleware) - 1; i >= 0; i-- {
+		next = n.middleware[i](next)
+	}
+	if chatMsg, ok := message.(*ChatMessage); ok {
+		message = chatMsg.ForTransport(TransportKey(transport))
+	}
+	return n.timed(ctx, next, transport, message)
+}
+
+// RetryPolicy configures RetryMiddleware.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	MaxAttempts int
+	// InitialBackoff is the delay before the second attempt, doubled for every further attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts. A RateLimitError.RetryAfter is used even if longer.
+	MaxBackoff time.Duration
+	// Retryable reports whether an error should be retried. All errors are retried if nil.
+	Retryable func(err error) bool
+}
+
+// DefaultRetryPolicy makes 3 attempts, waiting 1s and 2s in between.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Second,
+	MaxBackoff:     30 * time.Second,
+}
+
+// RetryMiddleware retries failed sends with an exponential backoff with jitter.
+// Rate limited sends wait for the delay requested by the provider.
+func RetryMiddleware(policy RetryPolicy) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+			backoff := policy.InitialBackoff
+			for attempt := 1; ; attempt++ {
+				sent, err := next(ctx, transport, message)
+				if err == nil || attempt >= policy.MaxAttempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+					return sent, err
+				}
+
+				delay := backoff
+				if delay > 0 {
+					// Up to 20% jitter so concurrent senders don't retry in lockstep
+					delay += rand.N(delay/5 + 1)
+				}
+				if policy.MaxBackoff > 0 {
+					delay = min(delay, policy.MaxBackoff)
+				}
+				var rateLimitErr *RateLimitError
+				if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > delay {
+					delay = rateLimitErr.RetryAfter
+				}
+				backoff *= 2
+
+				timer := time.NewTimer(delay)
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, errors.Join(err, ctx.Err())
+				case <-timer.C:
+				}
+			}
+		}
+	}
+}
+
+// RateLimitMiddleware limits the sends per transport to the rate (per second), allowing bursts of
+// up to burst sends. Sends wait for their turn unless the context is done first. A rate of 0 or
+// less disables the limit.
+func RateLimitMiddleware(rate float64, burst int) Middleware {
+	if rate <= 0 {
+		return func(next SendFunc) SendFunc { return next }
+	}
+
+	var mu sync.Mutex
+	buckets := make(map[string]*tokenBucket)
+
+	return func(next SendFunc) SendFunc {
+		return func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+			mu.Lock()
+			bucket, ok := buckets[transport.String()]
+			if !ok {
+				bucket = &tokenBucket{rate: rate, burst: float64(max(burst, 1)), tokens: float64(max(burst, 1)), last: time.Now()}
+				buckets[transport.String()] = bucket
+			}
+			mu.Unlock()
+
+			if err := bucket.wait(ctx); err != nil {
+				return nil, err
+			}
+			return next(ctx, transport, message)
+		}
+	}
+}
+
+// tokenBucket is a token bucket refilled at rate tokens per second.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// wait takes a token, waiting until one is available.
+func (b *tokenBucket) wait(ctx context.Context) error {
+	b.mu.Lock()
+	now := time.Now()
+	b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	// Reserve the token right away, waiting for the debt to be refilled
+	b.tokens--
+	var delay time.Duration
+	if b.tokens < 0 {
+		delay = time.Duration(-b.tokens / b.rate * float64(time.Second))
+	}
+	b.mu.Unlock()
+
+	if delay == 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// LoggerMiddleware logs every send with its transport, duration and result.
+// Message contents are not logged.
+func LoggerMiddleware(logger *slog.Logger) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+			start := time.Now()
+			sent, err := next(ctx, transport, message)
+
+			attrs := []slog.Attr{
+				slog.String("transport", transport.String()),
+				slog.String("type", messageType(message)),
+				slog.Duration("duration", time.Since(start)),
+			}
+			if err != nil {
+				logger.LogAttrs(ctx, slog.LevelError, "notification failed", append(attrs, slog.Any("error", err))...)
+			} else {
+				if sent != nil && sent.GetMessageID() != "" {
+					attrs = append(attrs, slog.String("message_id", sent.GetMessageID()))
+				}
+				logger.LogAttrs(ctx, slog.LevelInfo, "notification sent", attrs...)
+			}
+			return sent, err
+		}
+	}
+}
+
+// PanicError is returned by RecoverMiddleware when a transport or middleware panics.
+type PanicError struct {
+	// Transport is the string representation of the transport the message was sent with.
+	Transport string
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the panicking goroutine.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%s: panic: %v", e.Transport, e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// RecoverMiddleware recovers panics in transports and the middleware it wraps, returning them as
+// a *PanicError, so a misbehaving transport can't crash the application. The optional callback
+// is called for every panic, e.g. to log the stack trace or report it.
+func RecoverMiddleware(onPanic func(ctx context.Context, message MessageInterface, err *PanicError)) Middleware {
+	return func(next SendFunc) SendFunc {
+		return func(ctx context.Context, transport TransportInterface, message MessageInterface) (sent *SentMessage, err error) {
+			defer func() {
+				if value := recover(); value != nil {
+					panicErr := &PanicError{Transport: transport.String(), Value: value, Stack: debug.Stack()}
+					if onPanic != nil {
+						onPanic(ctx, message, panicErr)
+					}
+					sent, err = nil, panicErr
+				}
+			}()
+			return next(ctx, transport, message)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryMiddleware(t *testing.T) {
+	unavailable := errors.New("unavailable")
+	invalid := errors.New("invalid recipient")
+
+	tests := []struct {
+		name        string
+		policy      RetryPolicy
+		errs        []error
+		attempts    int
+		expectedErr error
+		minDuration time.Duration
+	}{
+		{
+			name:     "success",
+			policy:   RetryPolicy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond},
+			attempts: 1,
+		},
+		{
+			name:        "exponential backoff",
+			policy:      RetryPolicy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond},
+			errs:        []error{unavailable, unavailable},
+			attempts:    3,
+			minDuration: 30 * time.Millisecond,
+		},
+		{
+			name:        "max attempts",
+			policy:      RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+			errs:        []error{unavailable, unavailable, unavailable},
+			attempts:    2,
+			expectedErr: unavailable,
+		},
+		{
+			name:        "not retryable",
+			policy:      RetryPolicy{MaxAttempts: 3, Retryable: func(err error) bool { return err != invalid }},
+			errs:        []error{invalid},
+			attempts:    1,
+			expectedErr: invalid,
+		},
+		{
+			name:        "max backoff",
+			policy:      RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Hour, MaxBackoff: time.Millisecond},
+			errs:        []error{unavailable},
+			attempts:    2,
+			minDuration: time.Millisecond,
+		},
+		{
+			name:        "rate limit error",
+			policy:      RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond},
+			errs:        []error{&RateLimitError{RetryAfter: 30 * time.Millisecond}},
+			attempts:    2,
+			minDuration: 30 * time.Millisecond,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			send := RetryMiddleware(tt.policy)(func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return nil, tt.errs[attempts-1]
+				}
+				return NewSentMessage(message, transport.String()), nil
+			})
+
+			start := time.Now()
+			_, err := send(context.Background(), &testTransport{key: "test"}, NewChatMessage("Disk full"))
+			elapsed := time.Since(start)
+
+			if err != tt.expectedErr {
+				t.Errorf("Expected error %v, got: %v", tt.expectedErr, err)
+			}
+			if attempts != tt.attempts {
+				t.Errorf("Expected %d attempts, got %d", tt.attempts, attempts)
+			}
+			if elapsed < tt.minDuration || elapsed > tt.minDuration+time.Second {
+				t.Errorf("Expected a backoff of at least %s, took %s", tt.minDuration, elapsed)
+			}
+		})
+	}
+}
+
+func TestRetryMiddlewareContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	unavailable := errors.New("unavailable")
+	send := RetryMiddleware(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour})(func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+		return nil, unavailable
+	})
+
+	_, err := send(ctx, &testTransport{key: "test"}, NewChatMessage("Disk full"))
+	if !errors.Is(err, unavailable) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected the send and context errors, got: %v", err)
+	}
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	send := RateLimitMiddleware(20, 2)(func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+		return NewSentMessage(message, transport.String()), nil
+	})
+	slack := &testTransport{key: "slack"}
+	telegram := &testTransport{key: "telegram"}
+	ctx := context.Background()
+
+	// The burst is sent right away, the next sends every 50ms
+	start := time.Now()
+	for range 4 {
+		if _, err := send(ctx, slack, NewChatMessage("Disk full")); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond || elapsed > time.Second {
+		t.Errorf("Expected 2 sends to wait 50ms each, took %s", elapsed)
+	}
+
+	// Every transport has its own bucket
+	start = time.Now()
+	if _, err := send(ctx, telegram, NewChatMessage("Disk full")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+		t.Errorf("Expected no wait for another transport, took %s", elapsed)
+	}
+
+	// A cancelled wait returns the context error
+	cancelled, cancel := context.WithTimeout(ctx, 5*time.Millisecond)
+	defer cancel()
+	if _, err := send(cancelled, slack, NewChatMessage("Disk full")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context error, got: %v", err)
+	}
+}
+
+func TestRateLimitMiddlewareUnlimited(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		send := RateLimitMiddleware(rate, 1)(func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+			return NewSentMessage(message, transport.String()), nil
+		})
+		start := time.Now()
+		for range 3 {
+			if _, err := send(context.Background(), &testTransport{key: "slack"}, NewChatMessage("Disk full")); err != nil {
+				t.Fatalf("Send failed: %v", err)
+			}
+		}
+		if elapsed := time.Since(start); elapsed > 20*time.Millisecond {
+			t.Errorf("Expected no wait for rate %v, took %s", rate, elapsed)
+		}
+	}
+}
+
+func TestLoggerMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected []string
+	}{
+		{
+			name:     "sent",
+			expected: []string{"level=INFO", `msg="notification sent"`, "transport=test://default", "type=chat", "message_id=M1"},
+		},
+		{
+			name:     "failed",
+			err:      errors.New("unavailable"),
+			expected: []string{"level=ERROR", `msg="notification failed"`, "error=unavailable"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var logs bytes.Buffer
+			send := LoggerMiddleware(slog.New(slog.NewTextHandler(&logs, nil)))(func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				sent := NewSentMessage(message, transport.String())
+				sent.SetMessageID("M1")
+				return sent, nil
+			})
+
+			_, _ = send(context.Background(), &testTransport{key: "test"}, NewChatMessage("Secret subject"))
+
+			for _, expected := range tt.expected {
+				if !strings.Contains(logs.String(), expected) {
+					t.Errorf("Expected %q in logs: %s", expected, logs.String())
+				}
+			}
+			if strings.Contains(logs.String(), "Secret subject") {
+				t.Errorf("Expected the message contents not to be logged: %s", logs.String())
+			}
+		})
+	}
+}
+
+func TestRecoverMiddleware(t *testing.T) {
+	invalid := errors.New("invalid state")
+
+	tests := []struct {
+		name        string
+		panicValue  any
+		expectedErr error
+	}{
+		{name: "no panic"},
+		{name: "panic with value", panicValue: "nil map"},
+		{name: "panic with error", panicValue: invalid, expectedErr: invalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var recovered *PanicError
+			send := RecoverMiddleware(func(ctx context.Context, message MessageInterface, err *PanicError) {
+				recovered = err
+			})(func(ctx context.Context, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+				if tt.panicValue != nil {
+					panic(tt.panicValue)
+				}
+				return NewSentMessage(message, transport.String()), nil
+			})
+
+			sent, err := send(context.Background(), &testTransport{key: "test"}, NewChatMessage("Disk full"))
+			if tt.panicValue == nil {
+				if err != nil || sent == nil || recovered != nil {
+					t.Errorf("Expected the message to be sent, got: %v", err)
+				}
+				return
+			}
+
+			var panicErr *PanicError
+			if !errors.As(err, &panicErr) || sent != nil {
+				t.Fatalf("Expected PanicError, got: %v", err)
+			}
+			if panicErr.Transport != "test://default" || panicErr.Value != tt.panicValue || len(panicErr.Stack) == 0 {
+				t.Errorf("Unexpected PanicError: %+v", panicErr)
+			}
+			if recovered != panicErr {
+				t.Error("Expected the callback to be called with the PanicError")
+			}
+			if tt.expectedErr != nil && !errors.Is(err, tt.expectedErr) {
+				t.Errorf("Expected the panic error to be unwrapped, got: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package notifier
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"sort"
+	"strings"
+	"time"
+)
+
+// MIME renders the email as an RFC 5322 message ready to be handed to an MTA.
+// defaultFrom is used when the message has no sender. Bcc recipients are not
+// included in the headers; transports pass them as envelope recipients.
+func (m *EmailMessage) MIME(defaultFrom string) ([]byte, error) {
+	from := m.from
+	if from == "" {
+		from = defaultFrom
+	}
+	if from == "" {
+		return nil, fmt.Errorf("email: missing sender address")
+	}
+	if len(m.to)+len(m.cc)+len(m.bcc) == 0 {
+		return nil, fmt.Errorf("email: missing recipients")
+	}
+
+	fromAddress, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("email: invalid sender address: %w", err)
+	}
+
+	var buf bytes.Buffer
+	writeHeader := func(name, value string) {
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	writeHeader("From", fromAddress.String())
+	if len(m.to) > 0 {
+		writeHeader("To", strings.Join(m.to, ", "))
+	}
+	if len(m.cc) > 0 {
+		writeHeader("Cc", strings.Join(m.cc, ", "))
+	}
+	if m.replyTo != "" {
+		writeHeader("Reply-To", m.replyTo)
+	}
+	writeHeader("Subject", mime.QEncoding.Encode("utf-8", m.subject))
+	writeHeader("Date", time.Now().Format(time.RFC1123Z))
+	writeHeader("Message-ID", newMessageID(fromAddress.Address))
+	writeHeader("MIME-Version", "1.0")
+
+	names := make([]string, 0, len(m.headers))
+	for name := range m.headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		writeHeader(textproto.CanonicalMIMEHeaderKey(name), mime.QEncoding.Encode("utf-8", m.headers[name]))
+	}
+
+	bodyHeader, body, err := m.bodyPart()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(m.attachments) == 0 {
+		writeMIMEHeader(&buf, bodyHeader)
+		buf.WriteString("\r\n")
+		buf.Write(body)
+		return buf.Bytes(), nil
+	}
+
+	mixed := multipart.NewWriter(&buf)
+	writeHeader("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%s", mixed.Boundary()))
+	buf.WriteString("\r\n")
+
+	part, err := mixed.CreatePart(bodyHeader)
+	if err != nil {
+		return nil, fmt.Errorf("email: build body: %w", err)
+	}
+	if _, err := part.Write(body); err != nil {
+		return nil, fmt.Errorf("email: build body: %w", err)
+	}
+
+	for _, attachment := range m.attachments {
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", mime.FormatMediaType(contentType, map[string]string{"name": attachment.Filename}))
+		header.Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+		header.Set("Content-Transfer-Encoding", "base64")
+
+		part, err := mixed.CreatePart(header)
+		if err != nil {
+			return nil, fmt.Errorf("email: build attachment: %w", err)
+		}
+		if err := writeBase64Lines(part, attachment.Data); err != nil {
+			return nil, fmt.Errorf("email: build attachment: %w", err)
+		}
+	}
+
+	if err := mixed.Close(); err != nil {
+		return nil, fmt.Errorf("email: build message: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// bodyPart renders the text and/or HTML body with its MIME headers.
+func (m *EmailMessage) bodyPart() (textproto.MIMEHeader, []byte, error) {
+	var buf bytes.Buffer
+	header := textproto.MIMEHeader{}
+
+	switch {
+	case m.text != "" && m.html != "":
+		alternative := multipart.NewWriter(&buf)
+		header.Set("Content-Type", fmt.Sprintf("multipart/alternative; boundary=%s", alternative.Boundary()))
+		for _, body := range []struct{ contentType, content string }{
+			{"text/plain; charset=utf-8", m.text},
+			{"text/html; charset=utf-8", m.html},
+		} {
+			partHeader := textproto.MIMEHeader{}
+			partHeader.Set("Content-Type", body.contentType)
+			partHeader.Set("Content-Transfer-Encoding", "quoted-printable")
+			part, err := alternative.CreatePart(partHeader)
+			if err != nil {
+				return nil, nil, fmt.Errorf("email: build body: %w", err)
+			}
+			if err := writeQuotedPrintable(part, body.content); err != nil {
+				return nil, nil, fmt.Errorf("email: build body: %w", err)
+			}
+		}
+		if err := alternative.Close(); err != nil {
+			return nil, nil, fmt.Errorf("email: build body: %w", err)
+		}
+	case m.html != "":
+		header.Set("Content-Type", "text/html; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		if err := writeQuotedPrintable(&buf, m.html); err != nil {
+			return nil, nil, fmt.Errorf("email: build body: %w", err)
+		}
+	default:
+		header.Set("Content-Type", "text/plain; charset=utf-8")
+		header.Set("Content-Transfer-Encoding", "quoted-printable")
+		if err := writeQuotedPrintable(&buf, m.text); err != nil {
+			return nil, nil, fmt.Errorf("email: build body: %w", err)
+		}
+	}
+
+	return header, buf.Bytes(), nil
+}
+
+func writeMIMEHeader(buf *bytes.Buffer, header textproto.MIMEHeader) {
+	keys := make([]string, 0, len(header))
+	for key := range header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, value := range header[key] {
+			fmt.Fprintf(buf, "%s: %s\r\n", key, value)
+		}
+	}
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	qp := quotedprintable.NewWriter(w)
+	if _, err := qp.Write([]byte(content)); err != nil {
+		return err
+	}
+	return qp.Close()
+}
+
+// writeBase64Lines writes base64 encoded data wrapped at 76 characters, as required by RFC 2045.
+func writeBase64Lines(w io.Writer, data []byte) error {
+	encoded := base64.StdEncoding.EncodeToString(data)
+	for len(encoded) > 76 {
+		if _, err := w.Write([]byte(encoded[:76] + "\r\n")); err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err := w.Write([]byte(encoded + "\r\n"))
+	return err
+}
+
+func newMessageID(from string) string {
+	domain := "localhost"
+	if at := strings.LastIndex(from, "@"); at >= 0 {
+		domain = from[at+1:]
+	}
+	id := make([]byte, 16)
+	_, _ = rand.Read(id)
+	return fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain)
+}
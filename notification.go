@@ -0,0 +1,224 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+)
+
+// Channels a Notification can be delivered on.
+const (
+	ChannelChat  = "chat"
+	ChannelEmail = "email"
+	ChannelSms   = "sms"
+	ChannelPush  = "push"
+)
+
+// DefaultNotifyConcurrency is the number of concurrent sends of Notifier.Notify.
+const DefaultNotifyConcurrency = 10
+
+// RecipientInterface is a person a Notification is sent to. Empty values are skipped.
+type RecipientInterface interface {
+	// GetEmail returns the email address.
+	GetEmail() string
+	// GetPhone returns the phone number, preferably in E.164 format.
+	GetPhone() string
+	// GetDeviceToken returns the push notification device token.
+	GetDeviceToken() string
+}
+
+// Recipient is a simple RecipientInterface implementation.
+type Recipient struct {
+	Email       string
+	Phone       string
+	DeviceToken string
+}
+
+func (r *Recipient) GetEmail() string {
+	return r.Email
+}
+
+func (r *Recipient) GetPhone() string {
+	return r.Phone
+}
+
+func (r *Recipient) GetDeviceToken() string {
+	return r.DeviceToken
+}
+
+// Notification is a channel-independent notification, turned into a message per recipient and
+// channel by Notifier.Notify.
+type Notification struct {
+	subject    string
+	content    string
+	importance string
+	channels   []string
+	options    map[string]MessageOptionsInterface
+}
+
+// NewNotification creates a new notification.
+func NewNotification(subject string) *Notification {
+	return &Notification{
+		subject: subject,
+		options: make(map[string]MessageOptionsInterface),
+	}
+}
+
+// Content sets the body.
+func (n *Notification) Content(content string) *Notification {
+	n.content = content
+	return n
+}
+
+// Importance sets the importance (e.g., ImportanceHigh).
+func (n *Notification) Importance(importance string) *Notification {
+	n.importance = importance
+	return n
+}
+
+// Channels sets the channels to deliver the notification on (e.g., ChannelEmail, ChannelSms).
+// Without channels, notifications are sent by email, or on the chat channel without recipients.
+func (n *Notification) Channels(channels ...string) *Notification {
+	n.channels = channels
+	return n
+}
+
+// WithOptions adds transport-specific options to all messages created for the notification.
+func (n *Notification) WithOptions(transportKey string, options MessageOptionsInterface) *Notification {
+	n.options[transportKey] = options
+	return n
+}
+
+func (n *Notification) GetSubject() string {
+	return n.subject
+}
+
+func (n *Notification) GetContent() string {
+	return n.content
+}
+
+func (n *Notification) GetImportance() string {
+	return n.importance
+}
+
+// GetChannels returns the channels set with Channels.
+func (n *Notification) GetChannels() []string {
+	return n.channels
+}
+
+// NotifyResult is the result of sending a notification to a recipient on a channel.
+type NotifyResult struct {
+	// Recipient is nil for the chat channel, which is sent once for all recipients.
+	Recipient RecipientInterface
+	Channel   string
+	Sent      *SentMessage
+	Err       error
+}
+
+// SetNotifyConcurrency sets the number of concurrent sends of Notify.
+func (n *Notifier) SetNotifyConcurrency(concurrency int) *Notifier {
+	n.notifyConcurrency = concurrency
+	return n
+}
+
+// Notify sends the notification to every recipient on each of its channels, skipping channels
+// a recipient has no address for. Chat messages are sent once, not per recipient.
+// It returns a result per send, in order, and the joined errors of the failed sends.
+func (n *Notifier) Notify(ctx context.Context, notification *Notification, recipients ...RecipientInterface) ([]NotifyResult, error) {
+	if err := n.begin(); err != nil {
+		return nil, err
+	}
+	defer n.end()
+
+	channels := notification.channels
+	if len(channels) == 0 {
+		channels = []string{ChannelEmail}
+		if len(recipients) == 0 {
+			channels = []string{ChannelChat}
+		}
+	}
+
+	var results []NotifyResult
+	var messages []MessageInterface
+	add := func(recipient RecipientInterface, channel string, message MessageInterface) {
+		results = append(results, NotifyResult{Recipient: recipient, Channel: channel})
+		messages = append(messages, message)
+	}
+
+	for _, channel := range channels {
+		switch channel {
+		case ChannelChat:
+			message := NewChatMessage(notification.subject).Content(notification.content).Importance(notification.importance)
+			maps.Copy(message.options, notification.options)
+			add(nil, channel, message)
+		case ChannelEmail, ChannelSms, ChannelPush:
+			for _, recipient := range recipients {
+				if message := notification.messageFor(channel, recipient); message != nil {
+					add(recipient, channel, message)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported channel %q", channel)
+		}
+	}
+
+	concurrency := n.notifyConcurrency
+	if concurrency <= 0 {
+		concurrency = DefaultNotifyConcurrency
+	}
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, message := range messages {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+				results[i].Sent, results[i].Err = n.route(ctx, message)
+			case <-ctx.Done():
+				results[i].Err = ctx.Err()
+			}
+		}()
+	}
+	wg.Wait()
+
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Channel, result.Err))
+		}
+	}
+	return results, errors.Join(errs...)
+}
+
+// messageFor creates the message for a recipient on a channel, or nil if the recipient
+// has no address for the channel.
+func (n *Notification) messageFor(channel string, recipient RecipientInterface) MessageInterface {
+	switch channel {
+	case ChannelEmail:
+		if recipient.GetEmail() == "" {
+			return nil
+		}
+		message := NewEmailMessage(n.subject).To(recipient.GetEmail()).Text(n.content)
+		maps.Copy(message.options, n.options)
+		return message
+	case ChannelSms:
+		if recipient.GetPhone() == "" {
+			return nil
+		}
+		message := NewSmsMessage(recipient.GetPhone(), n.subject)
+		maps.Copy(message.options, n.options)
+		return message
+	case ChannelPush:
+		if recipient.GetDeviceToken() == "" {
+			return nil
+		}
+		message := NewPushMessage(n.subject, n.content).Recipient(recipient.GetDeviceToken()).Importance(n.importance)
+		maps.Copy(message.options, n.options)
+		return message
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNotify(t *testing.T) {
+	alice := &Recipient{Email: "alice@example.com", Phone: "+15551234567"}
+	bob := &Recipient{Email: "bob@example.com", DeviceToken: "device-bob"}
+
+	tests := []struct {
+		name         string
+		notification *Notification
+		recipients   []RecipientInterface
+		expected     []string
+	}{
+		{
+			name:         "email by default",
+			notification: NewNotification("Invoice ready"),
+			recipients:   []RecipientInterface{alice, bob},
+			expected:     []string{"email:alice@example.com", "email:bob@example.com"},
+		},
+		{
+			name:         "chat without recipients",
+			notification: NewNotification("Invoice ready"),
+			expected:     []string{"chat:"},
+		},
+		{
+			name:         "recipients without address are skipped",
+			notification: NewNotification("Invoice ready").Channels(ChannelSms, ChannelPush),
+			recipients:   []RecipientInterface{alice, bob},
+			expected:     []string{"sms:+15551234567", "push:device-bob"},
+		},
+		{
+			name:         "chat is sent once",
+			notification: NewNotification("Invoice ready").Channels(ChannelChat, ChannelEmail),
+			recipients:   []RecipientInterface{alice, bob},
+			expected:     []string{"chat:", "email:alice@example.com", "email:bob@example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &testTransport{key: "test"}
+			results, err := NewNotifier(transport).Notify(context.Background(), tt.notification, tt.recipients...)
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			var sent []string
+			for _, result := range results {
+				if result.Err != nil || result.Sent == nil {
+					t.Errorf("Unexpected result: %+v", result)
+					continue
+				}
+				sent = append(sent, result.Channel+":"+result.Sent.GetOriginalMessage().GetRecipientId())
+			}
+			if strings.Join(sent, ",") != strings.Join(tt.expected, ",") {
+				t.Errorf("Expected %v, got %v", tt.expected, sent)
+			}
+			if len(transport.sent()) != len(tt.expected) {
+				t.Errorf("Expected %d sends, got %d", len(tt.expected), len(transport.sent()))
+			}
+		})
+	}
+}
+
+func TestNotifyMessages(t *testing.T) {
+	transport := &testTransport{key: "test"}
+	notification := NewNotification("Invoice ready").
+		Content("Your invoice is attached").
+		Importance(ImportanceHigh).
+		Channels(ChannelChat, ChannelEmail, ChannelPush).
+		WithOptions("test", testOptions{"tag": "billing"})
+	recipient := &Recipient{Email: "alice@example.com", DeviceToken: "device-alice"}
+
+	if _, err := NewNotifier(transport).Notify(context.Background(), notification, recipient); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for _, message := range transport.sent() {
+		if message.GetSubject() != "Invoice ready" || message.GetOptions("test") == nil {
+			t.Errorf("Unexpected message: %#v", message)
+		}
+		switch m := message.(type) {
+		case *ChatMessage:
+			if m.GetContent() != "Your invoice is attached" || m.GetImportance() != ImportanceHigh {
+				t.Errorf("Unexpected chat message: %#v", m)
+			}
+		case *EmailMessage:
+			if m.GetText() != "Your invoice is attached" {
+				t.Errorf("Unexpected email message: %#v", m)
+			}
+		case *PushMessage:
+			if m.GetContent() != "Your invoice is attached" || m.GetImportance() != ImportanceHigh {
+				t.Errorf("Unexpected push message: %#v", m)
+			}
+		}
+	}
+}
+
+func TestNotifyErrors(t *testing.T) {
+	transport := &testTransport{key: "test", send: func(ctx context.Context, message MessageInterface) error {
+		if message.GetRecipientId() == "bob@example.com" {
+			return errors.New("mailbox full")
+		}
+		return nil
+	}}
+	n := NewNotifier(transport)
+	recipients := []RecipientInterface{
+		&Recipient{Email: "alice@example.com"},
+		&Recipient{Email: "bob@example.com"},
+	}
+
+	results, err := n.Notify(context.Background(), NewNotification("Invoice ready"), recipients...)
+	if err == nil || err.Error() != "email: mailbox full" {
+		t.Errorf("Expected the failed send error, got: %v", err)
+	}
+	if len(results) != 2 || results[0].Err != nil || results[1].Err == nil || results[1].Recipient != recipients[1] {
+		t.Errorf("Unexpected results: %+v", results)
+	}
+
+	if _, err := n.Notify(context.Background(), NewNotification("Invoice ready").Channels("fax"), recipients...); err == nil || !strings.Contains(err.Error(), `unsupported channel "fax"`) {
+		t.Errorf("Expected unsupported channel error, got: %v", err)
+	}
+	if len(transport.sent()) != 1 {
+		t.Errorf("Expected no sends for unsupported channels, got %d sends in total", len(transport.sent()))
+	}
+}
+
+func TestNotifyConcurrency(t *testing.T) {
+	tests := []struct {
+		name        string
+		concurrency int
+		expected    int32
+	}{
+		{name: "limited", concurrency: 2, expected: 2},
+		{name: "default", concurrency: 0, expected: 6},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var running, peak atomic.Int32
+			transport := &testTransport{key: "test", send: func(ctx context.Context, message MessageInterface) error {
+				current := running.Add(1)
+				defer running.Add(-1)
+				for {
+					previous := peak.Load()
+					if current <= previous || peak.CompareAndSwap(previous, current) {
+						break
+					}
+				}
+				time.Sleep(20 * time.Millisecond)
+				return nil
+			}}
+
+			var recipients []RecipientInterface
+			for range 6 {
+				recipients = append(recipients, &Recipient{Email: "alice@example.com"})
+			}
+
+			n := NewNotifier(transport).SetNotifyConcurrency(tt.concurrency)
+			if _, err := n.Notify(context.Background(), NewNotification("Invoice ready"), recipients...); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if peak.Load() != tt.expected {
+				t.Errorf("Expected %d concurrent sends, got %d", tt.expected, peak.Load())
+			}
+		})
+	}
+}
@@ -2,12 +2,29 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"maps"
+	"sync"
 )
 
+// ErrNotifierClosed is returned when sending through a closed Notifier.
+var ErrNotifierClosed = errors.New("notifier is closed")
+
 // Notifier sends messages through transports.
 type Notifier struct {
-	transports []TransportInterface
+	transports     []TransportInterface
+	defaultOptions map[string]func() MessageOptionsInterface
+	middleware     []Middleware
+
+	notifyConcurrency int
+
+	stats stats
+
+	mu       sync.Mutex
+	closed   bool
+	inflight int
+	idle     []chan struct{}
 }
 
 // NewNotifier creates a new Notifier with the given transports.
@@ -17,26 +34,67 @@ func NewNotifier(transports ...TransportInterface) *Notifier {
 	}
 }
 
-// Send sends a message using the first transport that supports it.
+// SetDefaultOptions registers default options for a transport key (e.g., "slack"), merged with
+// the options of every message at send time; options set on the message take precedence.
+// Merging rebuilds the options with the decoder registered through RegisterOptionsDecoder; for
+// keys without one, the defaults only apply to messages without options for the key.
+// The function must return new options on every call, as transports may modify them:
+//
+//	n.SetDefaultOptions("slack", func() notifier.MessageOptionsInterface {
+//		return slack.NewOptions().Username("alerts")
+//	})
+func (n *Notifier) SetDefaultOptions(transportKey string, defaults func() MessageOptionsInterface) *Notifier {
+	if n.defaultOptions == nil {
+		n.defaultOptions = make(map[string]func() MessageOptionsInterface)
+	}
+	n.defaultOptions[transportKey] = defaults
+	return n
+}
+
+// Send sends a message using the first transport that supports it. A message with options for
+// a single transport key (e.g., only "telegram") prefers the transports with that key.
 func (n *Notifier) Send(ctx context.Context, message MessageInterface) (*SentMessage, error) {
+	if err := n.begin(); err != nil {
+		return nil, err
+	}
+	defer n.end()
+
+	return n.route(ctx, message)
+}
+
+// route sends a message using the first transport that supports it.
+func (n *Notifier) route(ctx context.Context, message MessageInterface) (*SentMessage, error) {
 	if len(n.transports) == 0 {
 		return nil, fmt.Errorf("no transports configured")
 	}
 
+	// Determined before the default options add keys of their own
+	preferredKey := optionsKey(message)
+	message = n.applyDefaultOptions(message)
+
 	// If message specifies a transport, find it
 	if transportName := message.GetTransport(); transportName != "" {
 		for _, transport := range n.transports {
 			if transport.String() == transportName && transport.Supports(message) {
-				return transport.Send(ctx, message)
+				return n.send(ctx, transport, message)
 			}
 		}
 		return nil, fmt.Errorf("transport %q not found or does not support message", transportName)
 	}
 
+	// Prefer the transport the message has options for
+	if preferredKey != "" {
+		for _, transport := range n.transports {
+			if TransportKey(transport) == preferredKey && transport.Supports(message) {
+				return n.send(ctx, transport, message)
+			}
+		}
+	}
+
 	// Otherwise, use the first transport that supports the message
 	for _, transport := range n.transports {
 		if transport.Supports(message) {
-			return transport.Send(ctx, message)
+			return n.send(ctx, transport, message)
 		}
 	}
 
@@ -45,14 +103,21 @@ func (n *Notifier) Send(ctx context.Context, message MessageInterface) (*SentMes
 
 // SendAll sends a message to all transports that support it.
 func (n *Notifier) SendAll(ctx context.Context, message MessageInterface) ([]*SentMessage, error) {
+	if err := n.begin(); err != nil {
+		return nil, err
+	}
+	defer n.end()
+
 	if len(n.transports) == 0 {
 		return nil, fmt.Errorf("no transports configured")
 	}
 
+	message = n.applyDefaultOptions(message)
+
 	var results []*SentMessage
 	for _, transport := range n.transports {
 		if transport.Supports(message) {
-			sent, err := transport.Send(ctx, message)
+			sent, err := n.send(ctx, transport, message)
 			if err != nil {
 				return results, err
 			}
@@ -66,3 +131,151 @@ func (n *Notifier) SendAll(ctx context.Context, message MessageInterface) ([]*Se
 
 	return results, nil
 }
+
+// optionsKey returns the transport key of the message options if the message has options for
+// exactly one transport key, or an empty string otherwise.
+func optionsKey(message MessageInterface) string {
+	var options map[string]MessageOptionsInterface
+	switch m := message.(type) {
+	case *ChatMessage:
+		options = m.options
+	case *PushMessage:
+		options = m.options
+	case *EmailMessage:
+		options = m.options
+	case *SmsMessage:
+		options = m.options
+	}
+
+	if len(options) != 1 {
+		return ""
+	}
+	for key := range options {
+		return key
+	}
+	return ""
+}
+
+// applyDefaultOptions returns a copy of the message with the default options merged into its
+// options. The message passed by the caller is not modified.
+func (n *Notifier) applyDefaultOptions(message MessageInterface) MessageInterface {
+	if len(n.defaultOptions) == 0 {
+		return message
+	}
+
+	var options *map[string]MessageOptionsInterface
+	switch m := message.(type) {
+	case *ChatMessage:
+		c := *m
+		message, options = &c, &c.options
+	case *PushMessage:
+		c := *m
+		message, options = &c, &c.options
+	case *EmailMessage:
+		c := *m
+		message, options = &c, &c.options
+	case *SmsMessage:
+		c := *m
+		message, options = &c, &c.options
+	default:
+		return message
+	}
+
+	merged := maps.Clone(*options)
+	if merged == nil {
+		merged = make(map[string]MessageOptionsInterface)
+	}
+	for key, newDefaults := range n.defaultOptions {
+		defaults := newDefaults()
+		if defaults == nil {
+			continue
+		}
+		opts := merged[key]
+		if opts == nil {
+			merged[key] = defaults
+			continue
+		}
+
+		// Rebuild the options from both maps, as ToMap includes the state kept outside the map
+		// (e.g., embeds). The message options are kept as they are if they can't be rebuilt.
+		values := maps.Clone(defaults.ToMap())
+		if values == nil {
+			continue
+		}
+		maps.Copy(values, opts.ToMap())
+		if rebuilt, err := DecodeOptions(key, values); err == nil {
+			merged[key] = rebuilt
+		}
+	}
+	*options = merged
+
+	return message
+}
+
+// Flush waits until all in-flight sends are done, or the context is done.
+func (n *Notifier) Flush(ctx context.Context) error {
+	n.mu.Lock()
+	if n.inflight == 0 {
+		n.mu.Unlock()
+		return nil
+	}
+	idle := make(chan struct{})
+	n.idle = append(n.idle, idle)
+	n.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting new messages, waits for in-flight sends like Flush and then closes
+// the transports that have a Close method (e.g., the file transport).
+// Sends started after Close return ErrNotifierClosed.
+func (n *Notifier) Close(ctx context.Context) error {
+	n.mu.Lock()
+	n.closed = true
+	n.mu.Unlock()
+
+	if err := n.Flush(ctx); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, transport := range n.transports {
+		if closer, ok := transport.(interface{ Close() error }); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, fmt.Errorf("close %s: %w", transport, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// begin registers an in-flight send, unless the Notifier is closed.
+func (n *Notifier) begin() error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.closed {
+		return ErrNotifierClosed
+	}
+	n.inflight++
+	return nil
+}
+
+// end marks an in-flight send as done, waking up Flush once none is left.
+func (n *Notifier) end() {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.inflight--
+	if n.inflight == 0 {
+		for _, idle := range n.idle {
+			close(idle)
+		}
+		n.idle = nil
+	}
+}
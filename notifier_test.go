@@ -0,0 +1,274 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testTransport records the messages it sends. The key is used as scheme of its string representation.
+type testTransport struct {
+	key      string
+	supports func(message MessageInterface) bool
+	send     func(ctx context.Context, message MessageInterface) error
+
+	mu       sync.Mutex
+	messages []MessageInterface
+	closed   bool
+}
+
+func (t *testTransport) Send(ctx context.Context, message MessageInterface) (*SentMessage, error) {
+	if t.send != nil {
+		if err := t.send(ctx, message); err != nil {
+			return nil, err
+		}
+	}
+	t.mu.Lock()
+	t.messages = append(t.messages, message)
+	t.mu.Unlock()
+	return NewSentMessage(message, t.String()), nil
+}
+
+func (t *testTransport) Supports(message MessageInterface) bool {
+	return t.supports == nil || t.supports(message)
+}
+
+func (t *testTransport) String() string {
+	return t.key + "://default"
+}
+
+func (t *testTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	return nil
+}
+
+func (t *testTransport) sent() []MessageInterface {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]MessageInterface(nil), t.messages...)
+}
+
+// testMergeOptions keeps part of its state outside the map, like the embeds of Discord options.
+type testMergeOptions struct {
+	options map[string]any
+	embeds  []string
+}
+
+func (o *testMergeOptions) ToMap() map[string]any {
+	if len(o.embeds) > 0 {
+		o.options["embeds"] = o.embeds
+	}
+	return o.options
+}
+
+func (o *testMergeOptions) GetRecipientId() string { return "" }
+
+func init() {
+	RegisterOptionsDecoder("test-merge", func(values map[string]any) (MessageOptionsInterface, error) {
+		return &testMergeOptions{options: values}, nil
+	})
+}
+
+func TestApplyDefaultOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		key      string
+		options  MessageOptionsInterface
+		expected map[string]any
+	}{
+		{
+			name:     "message without options",
+			key:      "test-merge",
+			expected: map[string]any{"username": "alerts", "icon": "bell"},
+		},
+		{
+			name:     "message options win",
+			key:      "test-merge",
+			options:  &testMergeOptions{options: map[string]any{"username": "deploy"}},
+			expected: map[string]any{"username": "deploy", "icon": "bell"},
+		},
+		{
+			name:     "state outside the map",
+			key:      "test-merge",
+			options:  &testMergeOptions{options: map[string]any{}, embeds: []string{"Disk full"}},
+			expected: map[string]any{"username": "alerts", "icon": "bell", "embeds": []string{"Disk full"}},
+		},
+		{
+			name:     "no decoder registered",
+			key:      "test-nodecoder",
+			options:  &testMergeOptions{options: map[string]any{"username": "deploy"}},
+			expected: map[string]any{"username": "deploy"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &testTransport{key: tt.key}
+			n := NewNotifier(transport).SetDefaultOptions(tt.key, func() MessageOptionsInterface {
+				return &testMergeOptions{options: map[string]any{"username": "alerts", "icon": "bell"}}
+			})
+
+			message := NewChatMessage("Disk full")
+			if tt.options != nil {
+				message.WithOptions(tt.key, tt.options)
+			}
+			if _, err := n.Send(context.Background(), message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			sent := transport.sent()[0].(*ChatMessage)
+			if options := sent.GetOptions(tt.key).ToMap(); !reflect.DeepEqual(options, tt.expected) {
+				t.Errorf("Unexpected options: %#v", options)
+			}
+			if tt.options == nil && message.GetOptions(tt.key) != nil {
+				t.Error("Expected the message not to be modified")
+			}
+			if tt.options != nil && message.GetOptions(tt.key) != tt.options {
+				t.Error("Expected the message options not to be replaced")
+			}
+		})
+	}
+}
+
+func TestFlushAndClose(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	transport := &testTransport{key: "test", send: func(ctx context.Context, message MessageInterface) error {
+		close(started)
+		<-release
+		return nil
+	}}
+	n := NewNotifier(transport)
+	ctx := context.Background()
+
+	if err := n.Flush(ctx); err != nil {
+		t.Fatalf("Expected Flush without sends to return, got: %v", err)
+	}
+
+	sent := make(chan error, 1)
+	go func() {
+		_, err := n.Send(ctx, NewChatMessage("Disk full"))
+		sent <- err
+	}()
+	<-started
+
+	// Flush waits for the in-flight send, unless the context is done first
+	timeout, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	if err := n.Flush(timeout); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context error, got: %v", err)
+	}
+
+	closed := make(chan error, 1)
+	go func() { closed <- n.Close(ctx) }()
+
+	select {
+	case err := <-closed:
+		t.Fatalf("Expected Close to wait for the in-flight send, got: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	if _, err := n.Send(ctx, NewChatMessage("Too late")); !errors.Is(err, ErrNotifierClosed) {
+		t.Errorf("Expected ErrNotifierClosed, got: %v", err)
+	}
+	if _, err := n.Notify(ctx, NewNotification("Too late")); !errors.Is(err, ErrNotifierClosed) {
+		t.Errorf("Expected ErrNotifierClosed, got: %v", err)
+	}
+
+	close(release)
+	if err := <-sent; err != nil {
+		t.Errorf("Expected the in-flight send to succeed, got: %v", err)
+	}
+	if err := <-closed; err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+	if !transport.closed {
+		t.Error("Expected the transport to be closed")
+	}
+}
+
+func TestRoutePrefersOptionsKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  func() *ChatMessage
+		defaults bool
+		expected string
+	}{
+		{
+			name:     "without options",
+			message:  func() *ChatMessage { return NewChatMessage("Disk full") },
+			expected: "slack",
+		},
+		{
+			name: "options for one transport",
+			message: func() *ChatMessage {
+				return NewChatMessage("Disk full").WithOptions("telegram", testOptions{"parse_mode": "HTML"})
+			},
+			expected: "telegram",
+		},
+		{
+			name: "options for several transports",
+			message: func() *ChatMessage {
+				return NewChatMessage("Disk full").
+					WithOptions("telegram", testOptions{"parse_mode": "HTML"}).
+					WithOptions("discord", testOptions{"tts": true})
+			},
+			expected: "slack",
+		},
+		{
+			name: "options for an unsupporting transport",
+			message: func() *ChatMessage {
+				return NewChatMessage("Disk full").WithOptions("discord", testOptions{"tts": true})
+			},
+			expected: "slack",
+		},
+		{
+			name: "default options don't change the preference",
+			message: func() *ChatMessage {
+				return NewChatMessage("Disk full").WithOptions("telegram", testOptions{"parse_mode": "HTML"})
+			},
+			defaults: true,
+			expected: "telegram",
+		},
+		{
+			name: "explicit transport",
+			message: func() *ChatMessage {
+				return NewChatMessage("Disk full").
+					WithOptions("telegram", testOptions{"parse_mode": "HTML"}).
+					Transport("discord://default")
+			},
+			expected: "discord",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transports := map[string]*testTransport{
+				"slack":    {key: "slack"},
+				"telegram": {key: "telegram"},
+				// Only supports messages sent to it explicitly
+				"discord": {key: "discord", supports: func(message MessageInterface) bool {
+					return message.GetTransport() == "discord://default"
+				}},
+			}
+			n := NewNotifier(transports["slack"], transports["telegram"], transports["discord"])
+			if tt.defaults {
+				n.SetDefaultOptions("slack", func() MessageOptionsInterface {
+					return testOptions{"username": "alerts"}
+				})
+			}
+
+			sent, err := n.Send(context.Background(), tt.message())
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if sent.GetTransport() != tt.expected+"://default" {
+				t.Errorf("Expected %s, got %s", tt.expected, sent.GetTransport())
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package notifiertest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// NewTelegramServer starts a fake Telegram Bot API. Use it with a DSN like
+// server.DSN("telegram://123:abc@%s?channel=42").
+func NewTelegramServer() *Server {
+	return newServer(telegramProvider{})
+}
+
+type telegramProvider struct{}
+
+func (telegramProvider) success(w http.ResponseWriter, r Request, id int) {
+	chat := r.JSON()["chat_id"]
+	writeJSON(w, http.StatusOK, map[string]any{
+		"ok": true,
+		"result": map[string]any{
+			"message_id": id,
+			"date":       time.Now().Unix(),
+			"chat":       map[string]any{"id": chat},
+		},
+	})
+}
+
+func (telegramProvider) failure(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"ok":          false,
+		"error_code":  status,
+		"description": message,
+	})
+}
+
+func (telegramProvider) rateLimit(w http.ResponseWriter, retryAfter time.Duration) {
+	seconds := retryAfterSeconds(retryAfter)
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	writeJSON(w, http.StatusTooManyRequests, map[string]any{
+		"ok":          false,
+		"error_code":  http.StatusTooManyRequests,
+		"description": fmt.Sprintf("Too Many Requests: retry after %d", seconds),
+		"parameters":  map[string]any{"retry_after": seconds},
+	})
+}
+
+// NewSlackServer starts a fake Slack Web API. Use it with a DSN like
+// server.DSN("slack://xoxb-token@%s?channel=C123").
+func NewSlackServer() *Server {
+	return newServer(slackProvider{})
+}
+
+type slackProvider struct{}
+
+func (slackProvider) success(w http.ResponseWriter, r Request, id int) {
+	body := r.JSON()
+	result := map[string]any{
+		"ok":      true,
+		"channel": body["channel"],
+		"ts":      fmt.Sprintf("%d.%06d", time.Now().Unix(), id),
+	}
+	if r.Path == "/api/chat.scheduleMessage" {
+		result["scheduled_message_id"] = fmt.Sprintf("Q%08d", id)
+		result["post_at"] = body["post_at"]
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// failure answers like the Web API, which reports errors such as "channel_not_found" with
+// status 200; pass the error code as message.
+func (slackProvider) failure(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"ok":    false,
+		"error": message,
+	})
+}
+
+func (slackProvider) rateLimit(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+	writeJSON(w, http.StatusTooManyRequests, map[string]any{
+		"ok":    false,
+		"error": "ratelimited",
+	})
+}
+
+// NewDiscordServer starts a fake Discord webhook API. Use it with a DSN like
+// server.DSN("discord://token@%s?webhook_id=123").
+func NewDiscordServer() *Server {
+	return newServer(discordProvider{})
+}
+
+type discordProvider struct{}
+
+func (discordProvider) success(w http.ResponseWriter, r Request, id int) {
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (discordProvider) failure(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"code":    0,
+		"message": message,
+	})
+}
+
+func (discordProvider) rateLimit(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+	writeJSON(w, http.StatusTooManyRequests, map[string]any{
+		"message":     "You are being rate limited.",
+		"retry_after": retryAfter.Seconds(),
+		"global":      false,
+	})
+}
+
+// NewTeamsServer starts a fake Microsoft Teams incoming webhook. The webhook URL isn't part
+// of the DSN, create the transport with microsoftteams.NewTransport(server.WebhookURL(), nil).
+func NewTeamsServer() *Server {
+	return newServer(teamsProvider{})
+}
+
+// WebhookURL returns an incoming webhook URL on the server, for Teams servers.
+func (s *Server) WebhookURL() string {
+	return s.URL + "/webhook/notifiertest/IncomingWebhook/notifiertest"
+}
+
+type teamsProvider struct{}
+
+func (teamsProvider) success(w http.ResponseWriter, r Request, id int) {
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("1"))
+}
+
+func (teamsProvider) failure(w http.ResponseWriter, status int, message string) {
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(message))
+}
+
+func (teamsProvider) rateLimit(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+	w.WriteHeader(http.StatusTooManyRequests)
+	_, _ = w.Write([]byte("Microsoft Teams endpoint returned HTTP error 429"))
+}
+
+// NewGotifyServer starts a fake Gotify server. Use it with a DSN like
+// server.DSN("gotify+http://token@%s").
+func NewGotifyServer() *Server {
+	return newServer(gotifyProvider{})
+}
+
+type gotifyProvider struct{}
+
+func (gotifyProvider) success(w http.ResponseWriter, r Request, id int) {
+	body := r.JSON()
+	writeJSON(w, http.StatusOK, map[string]any{
+		"id":       id,
+		"appid":    1,
+		"title":    body["title"],
+		"message":  body["message"],
+		"priority": body["priority"],
+		"date":     time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+func (gotifyProvider) failure(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]any{
+		"error":            http.StatusText(status),
+		"errorCode":        status,
+		"errorDescription": message,
+	})
+}
+
+func (gotifyProvider) rateLimit(w http.ResponseWriter, retryAfter time.Duration) {
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+	writeJSON(w, http.StatusTooManyRequests, map[string]any{
+		"error":            http.StatusText(http.StatusTooManyRequests),
+		"errorCode":        http.StatusTooManyRequests,
+		"errorDescription": "rate limit exceeded",
+	})
+}
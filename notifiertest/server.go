@@ -0,0 +1,173 @@
+// Package notifiertest provides fake provider APIs for end-to-end tests of code sending
+// notifications. The servers record the requests they receive and answer like the real
+// provider, with canned success, error and rate limit responses:
+//
+//	server := notifiertest.NewSlackServer()
+//	defer server.Close()
+//
+//	transport, _ := notifier.NewTransportFromDSN(server.DSN("slack://xoxb-token@%s?channel=C123"))
+//	// ... code under test sends through transport ...
+//
+//	if len(server.Requests()) != 1 { ... }
+package notifiertest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Request is a request received by a Server.
+type Request struct {
+	Method string
+	Path   string
+	Query  string
+	Header http.Header
+	Body   []byte
+}
+
+// JSON decodes the request body into a map, or returns nil if it isn't a JSON object.
+func (r Request) JSON() map[string]any {
+	var body map[string]any
+	if json.Unmarshal(r.Body, &body) != nil {
+		return nil
+	}
+	return body
+}
+
+// response is a canned response queued for the next request.
+type response struct {
+	status     int
+	message    string
+	retryAfter time.Duration
+}
+
+// provider answers requests like the emulated API.
+type provider interface {
+	// success writes the response of a successful request; id counts the requests from 1.
+	success(w http.ResponseWriter, r Request, id int)
+	// failure writes an error response with the status code and message.
+	failure(w http.ResponseWriter, status int, message string)
+	// rateLimit writes a rate limit response asking to retry after the delay.
+	rateLimit(w http.ResponseWriter, retryAfter time.Duration)
+}
+
+// Server is a fake provider API on a local plain HTTP server.
+type Server struct {
+	*httptest.Server
+	provider provider
+
+	mu        sync.Mutex
+	requests  []Request
+	responses []response
+}
+
+func newServer(p provider) *Server {
+	s := &Server{provider: p}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	request := Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Query:  r.URL.RawQuery,
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+
+	s.mu.Lock()
+	s.requests = append(s.requests, request)
+	id := len(s.requests)
+	var next *response
+	if len(s.responses) > 0 {
+		next = &s.responses[0]
+		s.responses = s.responses[1:]
+	}
+	s.mu.Unlock()
+
+	switch {
+	case next == nil:
+		s.provider.success(w, request, id)
+	case next.status == http.StatusTooManyRequests:
+		s.provider.rateLimit(w, next.retryAfter)
+	default:
+		s.provider.failure(w, next.status, next.message)
+	}
+}
+
+// Host returns the host and port of the server, for DSNs and SetHost.
+func (s *Server) Host() string {
+	return strings.TrimPrefix(s.URL, "http://")
+}
+
+// DSN formats the DSN with the host of the server and enables insecure_http, as the server
+// doesn't use TLS (e.g., "slack://xoxb-token@%s?channel=C123").
+func (s *Server) DSN(format string) string {
+	dsn := fmt.Sprintf(format, s.Host())
+	if strings.Contains(dsn, "?") {
+		return dsn + "&insecure_http=1"
+	}
+	return dsn + "?insecure_http=1"
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// LastRequest returns the most recent request, and false if none was received.
+func (s *Server) LastRequest() (Request, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.requests) == 0 {
+		return Request{}, false
+	}
+	return s.requests[len(s.requests)-1], true
+}
+
+// FailNext makes the next request fail with the status code and message, in the error format
+// of the provider. Calls queue up, one response per request.
+func (s *Server) FailNext(status int, message string) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = append(s.responses, response{status: status, message: message})
+	return s
+}
+
+// RateLimitNext makes the next request fail with a rate limit response asking to retry after
+// the delay. Calls queue up, one response per request.
+func (s *Server) RateLimitNext(retryAfter time.Duration) *Server {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.responses = append(s.responses, response{status: http.StatusTooManyRequests, retryAfter: retryAfter})
+	return s
+}
+
+// Reset forgets the received requests and the queued responses.
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.responses = nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// retryAfterSeconds returns the delay in whole seconds, rounded up, as used by Retry-After headers.
+func retryAfterSeconds(retryAfter time.Duration) int {
+	return int((retryAfter + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,147 @@
+package notifiertest_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/notifiertest"
+	"github.com/shyim/go-notifier/transport/microsoftteams"
+
+	_ "github.com/shyim/go-notifier/transport/discord"
+	_ "github.com/shyim/go-notifier/transport/gotify"
+	_ "github.com/shyim/go-notifier/transport/slack"
+	_ "github.com/shyim/go-notifier/transport/telegram"
+)
+
+func newTransport(t *testing.T, dsn string) notifier.TransportInterface {
+	t.Helper()
+	transport, err := notifier.NewTransportFromDSN(dsn)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	return transport
+}
+
+func TestServers(t *testing.T) {
+	tests := []struct {
+		name      string
+		server    *notifiertest.Server
+		dsn       string
+		path      string
+		messageID string
+	}{
+		{"telegram", notifiertest.NewTelegramServer(), "telegram://123:abc@%s?channel=42", "/bot123:abc/sendMessage", "1"},
+		{"slack", notifiertest.NewSlackServer(), "slack://xoxb-token@%s?channel=C123", "/api/chat.postMessage", ""},
+		{"discord", notifiertest.NewDiscordServer(), "discord://token@%s?webhook_id=123", "/api/webhooks/123/token", ""},
+		{"gotify", notifiertest.NewGotifyServer(), "gotify+http://token@%s", "/message", "1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer tt.server.Close()
+
+			transport := newTransport(t, tt.server.DSN(tt.dsn))
+			sent, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if tt.messageID != "" && sent.GetMessageID() != tt.messageID {
+				t.Errorf("Expected message ID %s, got: %s", tt.messageID, sent.GetMessageID())
+			}
+
+			request, ok := tt.server.LastRequest()
+			if !ok {
+				t.Fatal("Expected a recorded request")
+			}
+			if request.Method != "POST" || request.Path != tt.path {
+				t.Errorf("Expected POST %s, got: %s %s", tt.path, request.Method, request.Path)
+			}
+			if !strings.Contains(string(request.Body), "Hello") {
+				t.Errorf("Expected body to contain the subject, got: %s", request.Body)
+			}
+		})
+	}
+}
+
+func TestTeamsServer(t *testing.T) {
+	server := notifiertest.NewTeamsServer()
+	defer server.Close()
+
+	transport := microsoftteams.NewTransport(server.WebhookURL(), nil).SetRequestsPerSecond(0)
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	request, _ := server.LastRequest()
+	if request.JSON()["text"] != "Hello" {
+		t.Errorf("Expected text 'Hello', got: %v", request.JSON()["text"])
+	}
+
+	server.FailNext(http.StatusBadRequest, "Webhook message delivery failed")
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "status 400") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestFailNext(t *testing.T) {
+	server := notifiertest.NewSlackServer()
+	defer server.Close()
+
+	transport := newTransport(t, server.DSN("slack://xoxb-token@%s?channel=C123"))
+	server.FailNext(http.StatusOK, "channel_not_found")
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "channel_not_found") {
+		t.Errorf("Expected channel_not_found error, got: %v", err)
+	}
+
+	// Only the next request fails
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello")); err != nil {
+		t.Errorf("Expected no error, got: %v", err)
+	}
+	if len(server.Requests()) != 2 {
+		t.Errorf("Expected 2 requests, got: %d", len(server.Requests()))
+	}
+}
+
+func TestRateLimitNext(t *testing.T) {
+	server := notifiertest.NewGotifyServer()
+	defer server.Close()
+
+	transport := newTransport(t, server.DSN("gotify+http://token@%s"))
+	server.RateLimitNext(30 * time.Second)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 30*time.Second {
+		t.Errorf("Expected rate limit error, got: %v", err)
+	}
+
+	server.Reset()
+	if _, ok := server.LastRequest(); ok {
+		t.Error("Expected no requests after Reset")
+	}
+}
+
+func TestRateLimitNextRetryAfter(t *testing.T) {
+	server := notifiertest.NewTeamsServer()
+	defer server.Close()
+
+	transport := microsoftteams.NewTransport(server.WebhookURL(), nil)
+	server.RateLimitNext(2 * time.Second)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+	if rateLimitErr.RetryAfter != 2*time.Second {
+		t.Errorf("Expected RetryAfter 2s, got: %s", rateLimitErr.RetryAfter)
+	}
+}
@@ -0,0 +1,86 @@
+package notifier
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"sync"
+)
+
+// OptionsDecoder rebuilds the options of a transport from their ToMap values, e.g. after a
+// message was stored as Payload and decoded from JSON.
+type OptionsDecoder func(values map[string]any) (MessageOptionsInterface, error)
+
+var (
+	optionsDecoders   = make(map[string]OptionsDecoder)
+	optionsDecodersMu sync.RWMutex
+)
+
+// RegisterOptionsDecoder registers the options decoder for a transport key (e.g., "slack").
+// This is typically called from init() in transport packages.
+func RegisterOptionsDecoder(transportKey string, decoder OptionsDecoder) {
+	optionsDecodersMu.Lock()
+	defer optionsDecodersMu.Unlock()
+	optionsDecoders[transportKey] = decoder
+}
+
+// MapOptionsDecoder returns an OptionsDecoder for options keeping their values in a map: the values
+// get the Go types of types back with RestoreOptionTypes and newOptions wraps them in the options type.
+func MapOptionsDecoder(newOptions func(values map[string]any) MessageOptionsInterface, types map[string]any) OptionsDecoder {
+	return func(values map[string]any) (MessageOptionsInterface, error) {
+		values, err := RestoreOptionTypes(values, types)
+		if err != nil {
+			return nil, err
+		}
+		return newOptions(values), nil
+	}
+}
+
+// DecodeOptions rebuilds the options for a transport key with its registered decoder.
+// The transport package must be imported for its decoder to be registered.
+func DecodeOptions(transportKey string, values map[string]any) (MessageOptionsInterface, error) {
+	optionsDecodersMu.RLock()
+	decoder, ok := optionsDecoders[transportKey]
+	optionsDecodersMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no options decoder registered for %q", transportKey)
+	}
+	options, err := decoder(values)
+	if err != nil {
+		return nil, fmt.Errorf("decode %s options: %w", transportKey, err)
+	}
+	return options, nil
+}
+
+// RestoreOptionTypes returns a copy of the values with the values of the keys in types converted
+// to the Go type of the zero value given for the key (e.g., "tags": []string(nil)). JSON decodes
+// numbers as float64, lists as []any and objects as map[string]any, while transports read the
+// types set by their options.
+func RestoreOptionTypes(values map[string]any, types map[string]any) (map[string]any, error) {
+	restored := maps.Clone(values)
+	if restored == nil {
+		restored = make(map[string]any)
+	}
+
+	for key, zero := range types {
+		value, ok := restored[key]
+		target := reflect.TypeOf(zero)
+		if !ok || value == nil || reflect.TypeOf(value) == target {
+			continue
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("restore option %s: %w", key, err)
+		}
+		converted := reflect.New(target)
+		if err := json.Unmarshal(encoded, converted.Interface()); err != nil {
+			return nil, fmt.Errorf("restore option %s: %w", key, err)
+		}
+		restored[key] = converted.Elem().Interface()
+	}
+
+	return restored, nil
+}
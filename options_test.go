@@ -0,0 +1,85 @@
+package notifier
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRestoreOptionTypes(t *testing.T) {
+	types := map[string]any{
+		"tags":     []string(nil),
+		"priority": 0,
+		"delay":    time.Duration(0),
+		"headers":  map[string]string(nil),
+	}
+	values := map[string]any{
+		"tags":     []any{"disk", "web-1"},
+		"priority": float64(4),
+		"delay":    time.Second,
+		"headers":  map[string]any{"X-Env": "prod"},
+		"text":     "Disk full",
+	}
+
+	restored, err := RestoreOptionTypes(values, types)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := map[string]any{
+		"tags":     []string{"disk", "web-1"},
+		"priority": 4,
+		"delay":    time.Second,
+		"headers":  map[string]string{"X-Env": "prod"},
+		"text":     "Disk full",
+	}
+	if !reflect.DeepEqual(restored, expected) {
+		t.Errorf("Unexpected values: %#v", restored)
+	}
+	if _, ok := values["tags"].([]any); !ok {
+		t.Error("Expected the values to be copied")
+	}
+
+	if _, err := RestoreOptionTypes(map[string]any{"priority": "high"}, types); err == nil || !strings.Contains(err.Error(), "restore option priority") {
+		t.Errorf("Expected conversion error, got: %v", err)
+	}
+}
+
+type testOptions map[string]any
+
+func (o testOptions) ToMap() map[string]any  { return o }
+func (o testOptions) GetRecipientId() string { return "" }
+
+func TestDecodeOptions(t *testing.T) {
+	RegisterOptionsDecoder("test-decode", func(values map[string]any) (MessageOptionsInterface, error) {
+		return testOptions(values), nil
+	})
+
+	options, err := DecodeOptions("test-decode", map[string]any{"key": "value"})
+	if err != nil || options.ToMap()["key"] != "value" {
+		t.Errorf("Unexpected options: %v (%v)", options, err)
+	}
+
+	if _, err := DecodeOptions("test-unregistered", nil); err == nil {
+		t.Error("Expected error for unregistered transport key")
+	}
+}
+
+func TestMapOptionsDecoder(t *testing.T) {
+	decoder := MapOptionsDecoder(func(values map[string]any) MessageOptionsInterface {
+		return testOptions(values)
+	}, map[string]any{"priority": 0})
+
+	options, err := decoder(map[string]any{"priority": float64(5), "key": "value"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if options.ToMap()["priority"] != 5 || options.ToMap()["key"] != "value" {
+		t.Errorf("Unexpected options: %v", options.ToMap())
+	}
+
+	if _, err := decoder(map[string]any{"priority": "high"}); err == nil {
+		t.Error("Expected conversion error")
+	}
+}
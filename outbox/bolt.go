@@ -0,0 +1,198 @@
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+
+	"github.com/shyim/go-notifier"
+)
+
+var (
+	// entriesBucket maps entry IDs to the JSON encoded entries.
+	entriesBucket = []byte("entries")
+	// visibleBucket indexes the entries by the time they become visible, so Receive only
+	// looks at the first key instead of scanning all entries.
+	visibleBucket = []byte("visible")
+)
+
+// BoltStore is a Store keeping the entries in a bbolt database file. Every change is committed
+// in a synced transaction, so entries survive crashes. The file is locked while the store is
+// open, so it can only be used by one process at a time.
+type BoltStore struct {
+	db  *bolt.DB
+	now func() time.Time
+}
+
+// OpenBoltStore opens the database at path, creating it if needed. The store must be closed
+// to release the file lock.
+func OpenBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("outbox: open database: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(entriesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(visibleBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf("outbox: create buckets: %w", err)
+	}
+
+	return &BoltStore{db: db, now: time.Now}, nil
+}
+
+// Close closes the database.
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+// Len returns the number of stored entries, including invisible ones.
+func (s *BoltStore) Len() int {
+	var n int
+	_ = s.db.View(func(tx *bolt.Tx) error {
+		n = tx.Bucket(entriesBucket).Stats().KeyN
+		return nil
+	})
+	return n
+}
+
+func (s *BoltStore) Enqueue(ctx context.Context, payload *notifier.Payload) (string, error) {
+	now := s.now()
+	entry := &Entry{
+		ID:         newID(now),
+		Payload:    payload,
+		EnqueuedAt: now,
+		VisibleAt:  now,
+	}
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		return put(tx, entry)
+	})
+	if err != nil {
+		return "", fmt.Errorf("outbox: enqueue: %w", err)
+	}
+	return entry.ID, nil
+}
+
+func (s *BoltStore) Receive(ctx context.Context, visibilityTimeout time.Duration) (*Entry, error) {
+	var received *Entry
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		now := s.now()
+		key, id := tx.Bucket(visibleBucket).Cursor().First()
+		if key == nil || visibleAt(key).After(now) {
+			return nil
+		}
+
+		entry, err := get(tx, string(id))
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(visibleBucket).Delete(key); err != nil {
+			return err
+		}
+		entry.Attempts++
+		entry.VisibleAt = now.Add(visibilityTimeout)
+		if err := put(tx, entry); err != nil {
+			return err
+		}
+		received = entry
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("outbox: receive: %w", err)
+	}
+	return received, nil
+}
+
+func (s *BoltStore) Ack(ctx context.Context, id string) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		entry, err := get(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(visibleBucket).Delete(visibleKey(entry.VisibleAt, id)); err != nil {
+			return fmt.Errorf("outbox: ack: %w", err)
+		}
+		if err := tx.Bucket(entriesBucket).Delete([]byte(id)); err != nil {
+			return fmt.Errorf("outbox: ack: %w", err)
+		}
+		return nil
+	})
+}
+
+func (s *BoltStore) Retry(ctx context.Context, id string, delay time.Duration, cause error) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		entry, err := get(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Bucket(visibleBucket).Delete(visibleKey(entry.VisibleAt, id)); err != nil {
+			return fmt.Errorf("outbox: retry: %w", err)
+		}
+		entry.VisibleAt = s.now().Add(delay)
+		if cause != nil {
+			entry.LastError = cause.Error()
+		}
+		if err := put(tx, entry); err != nil {
+			return fmt.Errorf("outbox: retry: %w", err)
+		}
+		return nil
+	})
+}
+
+// get reads an entry in the transaction.
+func get(tx *bolt.Tx, id string) (*Entry, error) {
+	data := tx.Bucket(entriesBucket).Get([]byte(id))
+	if data == nil {
+		return nil, fmt.Errorf("outbox: entry %q not found", id)
+	}
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("outbox: decode entry %s: %w", id, err)
+	}
+	return &entry, nil
+}
+
+// put writes an entry and its visibility index key in the transaction.
+func put(tx *bolt.Tx, entry *Entry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal entry: %w", err)
+	}
+	if err := tx.Bucket(entriesBucket).Put([]byte(entry.ID), data); err != nil {
+		return err
+	}
+	return tx.Bucket(visibleBucket).Put(visibleKey(entry.VisibleAt, entry.ID), []byte(entry.ID))
+}
+
+// visibleKey returns the index key of an entry: the visibility time as big endian nanoseconds,
+// so keys sort by time, followed by the ID to keep the keys unique.
+func visibleKey(at time.Time, id string) []byte {
+	key := make([]byte, 8, 8+len(id))
+	binary.BigEndian.PutUint64(key, uint64(at.UnixNano()))
+	return append(key, id...)
+}
+
+// visibleAt returns the visibility time of an index key.
+func visibleAt(key []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[:8])))
+}
+
+// newID returns a unique ID sorting by enqueue time.
+func newID(now time.Time) string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%020d-%s", now.UnixNano(), hex.EncodeToString(b))
+}
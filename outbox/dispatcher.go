@@ -0,0 +1,216 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultVisibilityTimeout is how long a received entry stays hidden while it is being sent.
+const DefaultVisibilityTimeout = 30 * time.Second
+
+// DefaultPollInterval is how often the store is polled when it is empty.
+const DefaultPollInterval = time.Second
+
+// DefaultMaxAttempts is the number of attempts after which an entry is dropped.
+const DefaultMaxAttempts = 10
+
+// Sender sends messages, e.g. a *notifier.Notifier or a transport.
+type Sender interface {
+	Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error)
+}
+
+// DefaultBackoff waits 1s, 2s, 4s, ... between attempts, up to 5 minutes.
+func DefaultBackoff(attempt int) time.Duration {
+	delay := time.Second << min(attempt-1, 9)
+	return min(delay, 5*time.Minute)
+}
+
+// Dispatcher sends the messages of a store in the background.
+type Dispatcher struct {
+	store             Store
+	sender            Sender
+	visibilityTimeout time.Duration
+	pollInterval      time.Duration
+	maxAttempts       int
+	backoff           func(attempt int) time.Duration
+	decode            func(payload *notifier.Payload) (notifier.MessageInterface, error)
+	onError           func(entry *Entry, err error, dropped bool)
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDispatcher creates a dispatcher sending the messages of the store with the sender.
+func NewDispatcher(store Store, sender Sender) *Dispatcher {
+	return &Dispatcher{
+		store:             store,
+		sender:            sender,
+		visibilityTimeout: DefaultVisibilityTimeout,
+		pollInterval:      DefaultPollInterval,
+		maxAttempts:       DefaultMaxAttempts,
+		backoff:           DefaultBackoff,
+		decode:            DecodeMessage,
+	}
+}
+
+// SetVisibilityTimeout sets how long a received entry stays hidden. It must be longer than a send.
+func (d *Dispatcher) SetVisibilityTimeout(timeout time.Duration) *Dispatcher {
+	d.visibilityTimeout = timeout
+	return d
+}
+
+// SetPollInterval sets how often the store is polled when it is empty.
+func (d *Dispatcher) SetPollInterval(interval time.Duration) *Dispatcher {
+	d.pollInterval = interval
+	return d
+}
+
+// SetMaxAttempts sets the number of attempts after which an entry is dropped. Zero retries forever.
+func (d *Dispatcher) SetMaxAttempts(attempts int) *Dispatcher {
+	d.maxAttempts = attempts
+	return d
+}
+
+// SetBackoff sets the delay before the next attempt. A longer delay requested by a
+// notifier.RateLimitError takes precedence.
+func (d *Dispatcher) SetBackoff(backoff func(attempt int) time.Duration) *Dispatcher {
+	d.backoff = backoff
+	return d
+}
+
+// SetDecoder sets the function rebuilding messages from their payload, e.g. to restore options
+// of custom types. DecodeMessage is used by default.
+func (d *Dispatcher) SetDecoder(decode func(payload *notifier.Payload) (notifier.MessageInterface, error)) *Dispatcher {
+	d.decode = decode
+	return d
+}
+
+// OnError sets a callback for failed attempts; dropped is true if the entry will not be retried.
+func (d *Dispatcher) OnError(callback func(entry *Entry, err error, dropped bool)) *Dispatcher {
+	d.onError = callback
+	return d
+}
+
+// Start runs the dispatcher in a background goroutine until Stop is called or the context is done.
+func (d *Dispatcher) Start(ctx context.Context) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.done != nil {
+		return
+	}
+	ctx, d.cancel = context.WithCancel(ctx)
+	d.done = make(chan struct{})
+	go func(done chan struct{}) {
+		defer close(done)
+		_ = d.Run(ctx)
+	}(d.done)
+}
+
+// Stop stops the background goroutine and waits for the current send to finish.
+func (d *Dispatcher) Stop() {
+	_ = d.Close(context.Background())
+}
+
+// Close stops the background goroutine and waits for the current send to finish, or until the
+// context is done. Entries that are not sent stay in the store.
+func (d *Dispatcher) Close(ctx context.Context) error {
+	d.mu.Lock()
+	cancel, done := d.cancel, d.done
+	d.cancel, d.done = nil, nil
+	d.mu.Unlock()
+
+	if cancel == nil {
+		return nil
+	}
+	cancel()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush sends all visible entries until the store has none left, or until the context is done.
+// Entries failing again are left for later attempts.
+func (d *Dispatcher) Flush(ctx context.Context) error {
+	for {
+		processed, err := d.ProcessNext(ctx)
+		if err != nil {
+			return err
+		}
+		if !processed {
+			return ctx.Err()
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// Run dispatches messages until the context is done, then returns the context error.
+func (d *Dispatcher) Run(ctx context.Context) error {
+	for {
+		processed, err := d.ProcessNext(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if processed && err == nil {
+			continue
+		}
+
+		timer := time.NewTimer(d.pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// ProcessNext sends the next visible entry, if any, and reports whether an entry was processed.
+// Send errors are handled by retrying the entry; the returned error is a store error.
+func (d *Dispatcher) ProcessNext(ctx context.Context) (bool, error) {
+	entry, err := d.store.Receive(ctx, d.visibilityTimeout)
+	if err != nil || entry == nil {
+		return false, err
+	}
+
+	message, err := d.decode(entry.Payload)
+	if err == nil {
+		_, err = d.sender.Send(ctx, message)
+	}
+	if err == nil {
+		return true, d.store.Ack(ctx, entry.ID)
+	}
+	if ctx.Err() != nil {
+		// Shutting down, the entry becomes visible again after the visibility timeout
+		return true, nil
+	}
+
+	dropped := d.maxAttempts > 0 && entry.Attempts >= d.maxAttempts
+	if d.onError != nil {
+		d.onError(entry, err, dropped)
+	}
+	if dropped {
+		return true, d.store.Ack(ctx, entry.ID)
+	}
+
+	delay := d.backoff(entry.Attempts)
+	var rateLimitErr *notifier.RateLimitError
+	if errors.As(err, &rateLimitErr) && rateLimitErr.RetryAfter > delay {
+		delay = rateLimitErr.RetryAfter
+	}
+	if retryErr := d.store.Retry(ctx, entry.ID, delay, err); retryErr != nil {
+		return true, fmt.Errorf("outbox: retry %s: %w", entry.ID, retryErr)
+	}
+	return true, nil
+}
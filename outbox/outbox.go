@@ -0,0 +1,115 @@
+// Package outbox provides a durable queue for notifications with at-least-once delivery.
+//
+// Messages are enqueued in a Store and sent by a Dispatcher running in the background, which
+// retries failed sends with a backoff. A received message stays invisible to other receivers for
+// the visibility timeout and becomes visible again unless it is acknowledged, so messages survive
+// crashes and restarts but may be sent more than once.
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Entry is a message stored in the outbox.
+type Entry struct {
+	ID         string            `json:"id"`
+	Payload    *notifier.Payload `json:"payload"`
+	Attempts   int               `json:"attempts"`
+	EnqueuedAt time.Time         `json:"enqueued_at"`
+	// VisibleAt is the time from which the entry can be received (again).
+	VisibleAt time.Time `json:"visible_at"`
+	// LastError is the error of the last failed attempt.
+	LastError string `json:"last_error,omitempty"`
+}
+
+// Store persists queued messages.
+type Store interface {
+	// Enqueue stores a message and returns its ID.
+	Enqueue(ctx context.Context, payload *notifier.Payload) (string, error)
+	// Receive returns the oldest visible entry and hides it for the visibility timeout,
+	// incrementing its attempts. It returns nil if no entry is visible.
+	Receive(ctx context.Context, visibilityTimeout time.Duration) (*Entry, error)
+	// Ack removes an entry after it has been handled.
+	Ack(ctx context.Context, id string) error
+	// Retry makes an entry visible again after the delay, recording the error of the attempt.
+	Retry(ctx context.Context, id string, delay time.Duration, cause error) error
+}
+
+// Enqueue stores a message in the store. Email attachments and headers are not stored.
+func Enqueue(ctx context.Context, store Store, message notifier.MessageInterface) (string, error) {
+	return store.Enqueue(ctx, notifier.NewPayload(message))
+}
+
+// DecodeMessage rebuilds a message from its payload. The options are restored with the
+// decoders registered by the transport packages (see notifier.RegisterOptionsDecoder), so the
+// packages of the transports the options are for must be imported. Options without a
+// registered decoder are an error, as transports ignore options of other types.
+func DecodeMessage(payload *notifier.Payload) (notifier.MessageInterface, error) {
+	options, err := decodeOptions(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	switch payload.Type {
+	case "chat":
+		message := notifier.NewChatMessage(payload.Subject).
+			Content(payload.Content).
+			Importance(payload.Importance).
+			Transport(payload.Transport)
+		for key, opts := range options {
+			message.WithOptions(key, opts)
+		}
+		return message, nil
+	case "push":
+		message := notifier.NewPushMessage(payload.Subject, payload.Content).
+			Recipient(payload.Recipient).
+			Importance(payload.Importance).
+			Transport(payload.Transport)
+		for key, value := range payload.Data {
+			message.Data(key, value)
+		}
+		for key, opts := range options {
+			message.WithOptions(key, opts)
+		}
+		return message, nil
+	case "email":
+		message := notifier.NewEmailMessage(payload.Subject).
+			From(payload.From).
+			To(payload.To...).
+			Cc(payload.Cc...).
+			Bcc(payload.Bcc...).
+			ReplyTo(payload.ReplyTo).
+			Text(payload.Content).
+			HTML(payload.HTML).
+			Transport(payload.Transport)
+		for key, opts := range options {
+			message.WithOptions(key, opts)
+		}
+		return message, nil
+	case "sms":
+		message := notifier.NewSmsMessage(payload.Recipient, payload.Subject).From(payload.From).Transport(payload.Transport)
+		for key, opts := range options {
+			message.WithOptions(key, opts)
+		}
+		return message, nil
+	default:
+		return nil, fmt.Errorf("outbox: unsupported message type %q", payload.Type)
+	}
+}
+
+// decodeOptions restores the typed options of the payload.
+func decodeOptions(payload *notifier.Payload) (map[string]notifier.MessageOptionsInterface, error) {
+	options := make(map[string]notifier.MessageOptionsInterface, len(payload.Options))
+	for key, values := range payload.Options {
+		opts, err := notifier.DecodeOptions(key, values)
+		if err != nil {
+			return nil, fmt.Errorf("outbox: %w", err)
+		}
+		options[key] = opts
+	}
+	return options, nil
+}
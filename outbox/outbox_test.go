@@ -0,0 +1,344 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/transport/sendgrid"
+	"github.com/shyim/go-notifier/transport/slack"
+)
+
+type senderFunc func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error)
+
+func (f senderFunc) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	return f(ctx, message)
+}
+
+func openStore(t *testing.T, path string) *BoltStore {
+	t.Helper()
+	store, err := OpenBoltStore(path)
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	t.Cleanup(func() { _ = store.Close() })
+	return store
+}
+
+func newStore(t *testing.T, path string, now *time.Time) *BoltStore {
+	t.Helper()
+	store := openStore(t, path)
+	store.now = func() time.Time { return *now }
+	return store
+}
+
+func TestBoltStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "outbox.db")
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := newStore(t, path, &now)
+
+	first, _ := Enqueue(ctx, store, notifier.NewChatMessage("first"))
+	now = now.Add(time.Millisecond)
+	second, _ := Enqueue(ctx, store, notifier.NewChatMessage("second"))
+
+	entry, err := store.Receive(ctx, time.Minute)
+	if err != nil || entry == nil || entry.ID != first || entry.Attempts != 1 {
+		t.Fatalf("Expected first entry, got %+v (%v)", entry, err)
+	}
+	entry, _ = store.Receive(ctx, time.Minute)
+	if entry == nil || entry.ID != second {
+		t.Fatalf("Expected second entry, got %+v", entry)
+	}
+	if entry, _ := store.Receive(ctx, time.Minute); entry != nil {
+		t.Fatalf("Expected no visible entry, got %+v", entry)
+	}
+
+	if err := store.Ack(ctx, second); err != nil {
+		t.Fatalf("Ack failed: %v", err)
+	}
+
+	// Unacknowledged entries survive a restart and become visible after the timeout
+	now = now.Add(2 * time.Minute)
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	store = newStore(t, path, &now)
+	if store.Len() != 1 {
+		t.Fatalf("Expected 1 entry after reopening, got %d", store.Len())
+	}
+	entry, _ = store.Receive(ctx, time.Minute)
+	if entry == nil || entry.ID != first || entry.Attempts != 2 || entry.Payload.Subject != "first" {
+		t.Fatalf("Expected first entry again, got %+v", entry)
+	}
+
+	if err := store.Retry(ctx, first, time.Hour, errors.New("boom")); err != nil {
+		t.Fatalf("Retry failed: %v", err)
+	}
+	now = now.Add(2 * time.Minute)
+	if entry, _ := store.Receive(ctx, time.Minute); entry != nil {
+		t.Fatalf("Expected entry to be delayed, got %+v", entry)
+	}
+	now = now.Add(time.Hour)
+	entry, _ = store.Receive(ctx, time.Minute)
+	if entry == nil || entry.LastError != "boom" {
+		t.Fatalf("Expected retried entry, got %+v", entry)
+	}
+
+	if err := store.Ack(ctx, "missing"); err == nil {
+		t.Error("Expected error for unknown entry")
+	}
+}
+
+func TestDispatcher(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := newStore(t, filepath.Join(t.TempDir(), "outbox.db"), &now)
+
+	_, _ = Enqueue(ctx, store, notifier.NewSmsMessage("+15551234567", "Your code is 1234").From("Acme"))
+
+	var sent []notifier.MessageInterface
+	var failures int
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		if failures > 0 {
+			failures--
+			return nil, &notifier.RateLimitError{Transport: "test", RetryAfter: time.Hour}
+		}
+		sent = append(sent, message)
+		return notifier.NewSentMessage(message, "test"), nil
+	})
+
+	var errs []error
+	dispatcher := NewDispatcher(store, sender).OnError(func(entry *Entry, err error, dropped bool) {
+		errs = append(errs, err)
+	})
+
+	failures = 1
+	if processed, err := dispatcher.ProcessNext(ctx); !processed || err != nil {
+		t.Fatalf("Expected processed entry, got %v (%v)", processed, err)
+	}
+	if len(errs) != 1 || store.Len() != 1 {
+		t.Fatalf("Expected the entry to be retried, got %v", errs)
+	}
+
+	// The rate limit delay is longer than the backoff
+	now = now.Add(time.Minute)
+	if processed, _ := dispatcher.ProcessNext(ctx); processed {
+		t.Fatal("Expected the entry to be delayed")
+	}
+
+	now = now.Add(time.Hour)
+	if processed, err := dispatcher.ProcessNext(ctx); !processed || err != nil {
+		t.Fatalf("Expected processed entry, got %v (%v)", processed, err)
+	}
+	if len(sent) != 1 || store.Len() != 0 {
+		t.Fatalf("Expected message to be sent and acknowledged, sent %d, stored %d", len(sent), store.Len())
+	}
+	sms, ok := sent[0].(*notifier.SmsMessage)
+	if !ok || sms.GetPhone() != "+15551234567" || sms.GetSubject() != "Your code is 1234" || sms.GetFrom() != "Acme" {
+		t.Errorf("Unexpected message: %+v", sent[0])
+	}
+}
+
+func TestDispatcherDropsAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	store := newStore(t, filepath.Join(t.TempDir(), "outbox.db"), &now)
+
+	_, _ = Enqueue(ctx, store, notifier.NewChatMessage("Hello"))
+
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		return nil, errors.New("unavailable")
+	})
+	var dropped bool
+	dispatcher := NewDispatcher(store, sender).SetMaxAttempts(2).OnError(func(entry *Entry, err error, d bool) {
+		dropped = d
+	})
+
+	for range 2 {
+		if processed, _ := dispatcher.ProcessNext(ctx); !processed {
+			t.Fatal("Expected processed entry")
+		}
+		now = now.Add(time.Hour)
+	}
+	if !dropped || store.Len() != 0 {
+		t.Errorf("Expected entry to be dropped, stored %d", store.Len())
+	}
+}
+
+func TestDispatcherStartStop(t *testing.T) {
+	store := openStore(t, filepath.Join(t.TempDir(), "outbox.db"))
+	_, _ = Enqueue(context.Background(), store, notifier.NewChatMessage("Hello"))
+
+	sent := make(chan notifier.MessageInterface, 1)
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		sent <- message
+		return notifier.NewSentMessage(message, "test"), nil
+	})
+
+	dispatcher := NewDispatcher(store, sender).SetPollInterval(10 * time.Millisecond)
+	dispatcher.Start(context.Background())
+	defer dispatcher.Stop()
+
+	select {
+	case message := <-sent:
+		if message.GetSubject() != "Hello" {
+			t.Errorf("Unexpected message: %s", message.GetSubject())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Message was not dispatched")
+	}
+}
+
+func TestDecodeMessage(t *testing.T) {
+	email := notifier.NewEmailMessage("Welcome").
+		From("app@example.com").
+		To("user@example.com").
+		Text("Hello").
+		HTML("<p>Hello</p>").
+		Transport("sendgrid").
+		WithOptions("sendgrid", sendgrid.NewOptions().Category("welcome", "onboarding").SendAt(time.Unix(1700000000, 0)))
+
+	payload := roundTrip(t, notifier.NewPayload(email))
+	message, err := DecodeMessage(payload)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	decoded, ok := message.(*notifier.EmailMessage)
+	if !ok || decoded.GetTo()[0] != "user@example.com" || decoded.GetHTML() != "<p>Hello</p>" || decoded.GetTransport() != "sendgrid" {
+		t.Errorf("Unexpected message: %+v", message)
+	}
+	opts, ok := decoded.GetOptions("sendgrid").(*sendgrid.Options)
+	if !ok {
+		t.Fatalf("Expected typed options, got %T", decoded.GetOptions("sendgrid"))
+	}
+	if categories, _ := opts.ToMap()["categories"].([]string); len(categories) != 2 || categories[1] != "onboarding" {
+		t.Errorf("Unexpected categories: %#v", opts.ToMap()["categories"])
+	}
+	if sendAt, _ := opts.ToMap()["send_at"].(int64); sendAt != 1700000000 {
+		t.Errorf("Unexpected send_at: %#v", opts.ToMap()["send_at"])
+	}
+
+	push := notifier.NewPushMessage("Title", "Body").Recipient("token").Data("id", "42").Importance(notifier.ImportanceHigh)
+	message, _ = DecodeMessage(notifier.NewPayload(push))
+	if decoded, ok := message.(*notifier.PushMessage); !ok || decoded.GetData()["id"] != "42" || decoded.GetImportance() != notifier.ImportanceHigh {
+		t.Errorf("Unexpected message: %+v", message)
+	}
+
+	if _, err := DecodeMessage(&notifier.Payload{Type: "fax"}); err == nil {
+		t.Error("Expected error for unknown type")
+	}
+
+	unknown := &notifier.Payload{Type: "chat", Subject: "Hi", Options: map[string]map[string]any{"carrier-pigeon": {"recipient_id": "loft"}}}
+	if _, err := DecodeMessage(unknown); err == nil || !strings.Contains(err.Error(), `no options decoder registered for "carrier-pigeon"`) {
+		t.Errorf("Expected error for options without decoder, got: %v", err)
+	}
+}
+
+func TestDispatcherTypedOptions(t *testing.T) {
+	ctx := context.Background()
+
+	newMessage := func() *notifier.ChatMessage {
+		return notifier.NewChatMessage("Deploy finished").
+			Content("Version 1.2.3 is live").
+			WithOptions("slack", slack.NewOptions().
+				Recipient("C0123456789").
+				Username("deploy-bot").
+				ThreadTs("1700000000.000100").
+				PostAt(time.Unix(1900000000, 0)).
+				Block(slack.NewHeaderBlock("Deploy finished")).
+				Block(slack.NewSectionBlock().Text("*api* 1.2.3", true)))
+	}
+
+	var bodies []string
+	client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":true,"ts":"1700000001.000200"}`)),
+			Header:     make(http.Header),
+		}, nil
+	})}
+	transport := slack.NewTransport("xoxb-token", "", client)
+
+	if _, err := transport.Send(ctx, newMessage()); err != nil {
+		t.Fatalf("Direct send failed: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "outbox.db")
+	store := openStore(t, path)
+	if _, err := Enqueue(ctx, store, newMessage()); err != nil {
+		t.Fatalf("Enqueue failed: %v", err)
+	}
+
+	// Reopened, so the payload is read back from JSON
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	store = openStore(t, path)
+	if err := NewDispatcher(store, transport).Flush(ctx); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+
+	if len(bodies) != 2 {
+		t.Fatalf("Expected 2 requests, got %d", len(bodies))
+	}
+	if bodies[1] != bodies[0] {
+		t.Errorf("Queued message differs from direct send:\n%s\n%s", bodies[1], bodies[0])
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// roundTrip returns the payload as read back from JSON.
+func roundTrip(t *testing.T, payload *notifier.Payload) *notifier.Payload {
+	t.Helper()
+	data, err := json.Marshal(payload)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	var decoded notifier.Payload
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	return &decoded
+}
+
+func TestDispatcherFlushAndClose(t *testing.T) {
+	ctx := context.Background()
+	store := openStore(t, filepath.Join(t.TempDir(), "outbox.db"))
+	for _, subject := range []string{"one", "two", "three"} {
+		_, _ = Enqueue(ctx, store, notifier.NewChatMessage(subject))
+	}
+
+	var sent []string
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		sent = append(sent, message.GetSubject())
+		return notifier.NewSentMessage(message, "test"), nil
+	})
+
+	dispatcher := NewDispatcher(store, sender)
+	if err := dispatcher.Flush(ctx); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if len(sent) != 3 || store.Len() != 0 {
+		t.Errorf("Expected all entries to be sent, sent %v, stored %d", sent, store.Len())
+	}
+
+	dispatcher.Start(ctx)
+	if err := dispatcher.Close(ctx); err != nil {
+		t.Errorf("Close failed: %v", err)
+	}
+}
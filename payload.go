@@ -0,0 +1,89 @@
+package notifier
+
+// Payload is the JSON representation of a message used by transports that forward
+// messages to brokers, queues or files instead of a provider API.
+// Email attachments are not included.
+type Payload struct {
+	// Type is the message kind: "chat", "push", "email" or "sms".
+	Type       string                    `json:"type"`
+	Subject    string                    `json:"subject"`
+	Content    string                    `json:"content,omitempty"`
+	HTML       string                    `json:"html,omitempty"`
+	Recipient  string                    `json:"recipient,omitempty"`
+	From       string                    `json:"from,omitempty"`
+	To         []string                  `json:"to,omitempty"`
+	Cc         []string                  `json:"cc,omitempty"`
+	Bcc        []string                  `json:"bcc,omitempty"`
+	ReplyTo    string                    `json:"reply_to,omitempty"`
+	Data       map[string]string         `json:"data,omitempty"`
+	Importance string                    `json:"importance,omitempty"`
+	Transport  string                    `json:"transport,omitempty"`
+	Options    map[string]map[string]any `json:"options,omitempty"`
+	Metadata   map[string]string         `json:"metadata,omitempty"`
+}
+
+// NewPayload converts a message into its JSON representation.
+// The options of all transport keys are included; Metadata is left for the caller to fill.
+func NewPayload(message MessageInterface) *Payload {
+	payload := &Payload{
+		Type:      messageType(message),
+		Subject:   message.GetSubject(),
+		Recipient: message.GetRecipientId(),
+		Transport: message.GetTransport(),
+	}
+
+	var options map[string]MessageOptionsInterface
+	switch m := message.(type) {
+	case *ChatMessage:
+		payload.Content = m.content
+		payload.Importance = m.importance
+		options = m.options
+	case *PushMessage:
+		payload.Content = m.content
+		payload.Importance = m.importance
+		if len(m.data) > 0 {
+			payload.Data = m.data
+		}
+		options = m.options
+	case *EmailMessage:
+		payload.Content = m.text
+		payload.HTML = m.html
+		payload.From = m.from
+		payload.To = m.to
+		payload.Cc = m.cc
+		payload.Bcc = m.bcc
+		payload.ReplyTo = m.replyTo
+		options = m.options
+	case *SmsMessage:
+		payload.From = m.from
+		options = m.options
+	}
+
+	for key, opts := range options {
+		if opts == nil {
+			continue
+		}
+		if payload.Options == nil {
+			payload.Options = make(map[string]map[string]any)
+		}
+		payload.Options[key] = opts.ToMap()
+	}
+
+	return payload
+}
+
+// messageType returns the kind of the message: "chat", "push", "email", "sms" or "" if unknown.
+func messageType(message MessageInterface) string {
+	switch message.(type) {
+	case *ChatMessage:
+		return "chat"
+	case *PushMessage:
+		return "push"
+	case *EmailMessage:
+		return "email"
+	case *SmsMessage:
+		return "sms"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,88 @@
+package schedule
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// FileStore is a Store keeping each scheduled message as a JSON file in a directory.
+// The directory must only be used by a single process.
+type FileStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// OpenFileStore opens the store in the directory, creating it if needed.
+func OpenFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("schedule: create directory: %w", err)
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+func (s *FileStore) Save(ctx context.Context, scheduled *Scheduled) error {
+	data, err := json.Marshal(scheduled)
+	if err != nil {
+		return fmt.Errorf("schedule: marshal message: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Write to a temporary file first so a crash never leaves a partial file behind
+	path := s.path(scheduled.ID)
+	if err := os.WriteFile(path+".tmp", data, 0o600); err != nil {
+		return fmt.Errorf("schedule: write message: %w", err)
+	}
+	if err := os.Rename(path+".tmp", path); err != nil {
+		_ = os.Remove(path + ".tmp")
+		return fmt.Errorf("schedule: write message: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.path(id)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("schedule: delete message: %w", err)
+	}
+	return nil
+}
+
+func (s *FileStore) List(ctx context.Context) ([]*Scheduled, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("schedule: read directory: %w", err)
+	}
+
+	var messages []*Scheduled
+	for _, file := range files {
+		if !strings.HasSuffix(file.Name(), ".json") {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(s.dir, file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("schedule: read message: %w", err)
+		}
+		var scheduled Scheduled
+		if err := json.Unmarshal(data, &scheduled); err != nil {
+			return nil, fmt.Errorf("schedule: decode message %s: %w", file.Name(), err)
+		}
+		messages = append(messages, &scheduled)
+	}
+	return messages, nil
+}
+
+func (s *FileStore) path(id string) string {
+	return filepath.Join(s.dir, id+".json")
+}
@@ -0,0 +1,48 @@
+// Package schedule sends messages at a later time and keeps track of scheduled messages across
+// restarts.
+//
+// Messages are either scheduled by the Scheduler itself, which persists them in a Store and sends
+// them with a timer, or natively by the provider (e.g., Slack PostAt), in which case the Scheduler
+// only records them so they can be cancelled by ID.
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Scheduled is a message scheduled for a later time.
+type Scheduled struct {
+	ID      string            `json:"id"`
+	Payload *notifier.Payload `json:"payload,omitempty"`
+	SendAt  time.Time         `json:"send_at"`
+	// Transport, MessageID and Info identify a message scheduled natively by the provider.
+	// They are empty for messages sent by the Scheduler.
+	Transport string         `json:"transport,omitempty"`
+	MessageID string         `json:"message_id,omitempty"`
+	Info      map[string]any `json:"info,omitempty"`
+}
+
+// IsNative reports whether the message was scheduled by the provider.
+func (s *Scheduled) IsNative() bool {
+	return s.Transport != ""
+}
+
+// Store persists scheduled messages.
+type Store interface {
+	// Save stores or replaces a scheduled message.
+	Save(ctx context.Context, scheduled *Scheduled) error
+	// Delete removes a scheduled message. Deleting an unknown ID is not an error.
+	Delete(ctx context.Context, id string) error
+	// List returns all scheduled messages.
+	List(ctx context.Context) ([]*Scheduled, error)
+}
+
+// Canceler is implemented by transports that schedule messages natively and can cancel them
+// (e.g., the Slack transport).
+type Canceler interface {
+	// CancelScheduled cancels a message using the message ID and info of its SentMessage.
+	CancelScheduled(ctx context.Context, messageID string, info map[string]any) error
+}
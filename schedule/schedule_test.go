@@ -0,0 +1,307 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/transport/slack"
+)
+
+type senderFunc func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error)
+
+func (f senderFunc) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	return f(ctx, message)
+}
+
+type nativeTransport struct {
+	cancelled []string
+}
+
+func (t *nativeTransport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	sent := notifier.NewSentMessage(message, t.String())
+	sent.SetMessageID("Q123")
+	sent.SetInfo("channel_id", "C123")
+	return sent, nil
+}
+
+func (t *nativeTransport) Supports(message notifier.MessageInterface) bool {
+	return true
+}
+
+func (t *nativeTransport) String() string {
+	return "native://default"
+}
+
+func (t *nativeTransport) CancelScheduled(ctx context.Context, messageID string, info map[string]any) error {
+	t.cancelled = append(t.cancelled, messageID+"@"+info["channel_id"].(string))
+	return nil
+}
+
+func newStore(t *testing.T) *FileStore {
+	t.Helper()
+	store, err := OpenFileStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	return store
+}
+
+func TestSchedulerSend(t *testing.T) {
+	store := newStore(t)
+	sent := make(chan notifier.MessageInterface, 1)
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		sent <- message
+		return notifier.NewSentMessage(message, "test"), nil
+	})
+
+	scheduler := NewScheduler(store, sender)
+	defer scheduler.Stop()
+
+	if _, err := scheduler.Schedule(context.Background(), notifier.NewChatMessage("Reminder"), time.Now().Add(10*time.Millisecond)); err != nil {
+		t.Fatalf("Schedule failed: %v", err)
+	}
+
+	select {
+	case message := <-sent:
+		if message.GetSubject() != "Reminder" {
+			t.Errorf("Unexpected message: %s", message.GetSubject())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Message was not sent")
+	}
+
+	// The message is removed from the store once sent
+	for range 100 {
+		if messages, _ := store.List(context.Background()); len(messages) == 0 {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Error("Expected message to be removed from the store")
+}
+
+func TestSchedulerCancel(t *testing.T) {
+	store := newStore(t)
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		t.Error("Cancelled message must not be sent")
+		return nil, errors.New("unexpected")
+	})
+
+	scheduler := NewScheduler(store, sender)
+	defer scheduler.Stop()
+
+	id, _ := scheduler.Schedule(context.Background(), notifier.NewChatMessage("Reminder"), time.Now().Add(20*time.Millisecond))
+	if err := scheduler.Cancel(context.Background(), id); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	if messages, _ := store.List(context.Background()); len(messages) != 0 {
+		t.Errorf("Expected empty store, got %d messages", len(messages))
+	}
+	if err := scheduler.Cancel(context.Background(), id); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestSchedulerNative(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+	transport := &nativeTransport{}
+
+	scheduler := NewScheduler(store, transport)
+	sent, _ := transport.Send(ctx, notifier.NewChatMessage("Standup"))
+	id, err := scheduler.Track(ctx, sent, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Track failed: %v", err)
+	}
+	scheduler.Stop()
+
+	// After a restart, the tracked message is reloaded and can be cancelled
+	restarted := NewScheduler(store, transport).SetTransports(transport)
+	defer restarted.Stop()
+	if err := restarted.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(restarted.List()) != 1 {
+		t.Fatalf("Expected 1 scheduled message, got %d", len(restarted.List()))
+	}
+	if err := restarted.Cancel(ctx, id); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	if len(transport.cancelled) != 1 || transport.cancelled[0] != "Q123@C123" {
+		t.Errorf("Unexpected cancellations: %v", transport.cancelled)
+	}
+}
+
+func TestSchedulerReconcile(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+	past := time.Now().Add(-time.Minute)
+
+	_ = store.Save(ctx, &Scheduled{ID: "missed", Payload: notifier.NewPayload(notifier.NewChatMessage("Missed")), SendAt: past})
+	_ = store.Save(ctx, &Scheduled{ID: "posted", SendAt: past, Transport: "native://default", MessageID: "Q1"})
+
+	sent := make(chan notifier.MessageInterface, 1)
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		sent <- message
+		return notifier.NewSentMessage(message, "test"), nil
+	})
+
+	scheduler := NewScheduler(store, sender)
+	defer scheduler.Stop()
+	if err := scheduler.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	select {
+	case message := <-sent:
+		if message.GetSubject() != "Missed" {
+			t.Errorf("Unexpected message: %s", message.GetSubject())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Missed message was not sent")
+	}
+
+	for _, scheduled := range scheduler.List() {
+		if scheduled.ID == "posted" {
+			t.Error("Expected the natively scheduled message in the past to be removed")
+		}
+	}
+}
+
+func TestSchedulerReconcileInFlight(t *testing.T) {
+	ctx := context.Background()
+	store := newStore(t)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var sends atomic.Int32
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		if sends.Add(1) == 1 {
+			close(started)
+			<-release
+		}
+		return notifier.NewSentMessage(message, "test"), nil
+	})
+
+	scheduler := NewScheduler(store, sender)
+	defer scheduler.Stop()
+	_, _ = scheduler.Schedule(ctx, notifier.NewChatMessage("Now"), time.Now())
+	<-started
+
+	// The message is still in the store while it is being sent
+	if err := scheduler.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	close(release)
+	time.Sleep(50 * time.Millisecond)
+
+	if n := sends.Load(); n != 1 {
+		t.Errorf("Expected the message to be sent once, got %d sends", n)
+	}
+	if messages, _ := store.List(ctx); len(messages) != 0 {
+		t.Errorf("Expected the sent message to be removed, got %d", len(messages))
+	}
+}
+
+func TestSchedulerClose(t *testing.T) {
+	store := newStore(t)
+	sender := senderFunc(func(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+		t.Error("Message must not be sent after Close")
+		return nil, errors.New("unexpected")
+	})
+
+	scheduler := NewScheduler(store, sender)
+	_, _ = scheduler.Schedule(context.Background(), notifier.NewChatMessage("Later"), time.Now().Add(20*time.Millisecond))
+	if err := scheduler.Close(context.Background()); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	time.Sleep(40 * time.Millisecond)
+
+	if _, err := scheduler.Schedule(context.Background(), notifier.NewChatMessage("Too late"), time.Now()); !errors.Is(err, ErrClosed) {
+		t.Errorf("Expected ErrClosed, got %v", err)
+	}
+	if messages, _ := store.List(context.Background()); len(messages) != 1 {
+		t.Errorf("Expected the pending message to stay in the store, got %d", len(messages))
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSchedulerTypedOptions(t *testing.T) {
+	ctx := context.Background()
+
+	newMessage := func() *notifier.ChatMessage {
+		return notifier.NewChatMessage("Maintenance tonight").
+			Content("The API is down from 22:00 to 23:00").
+			WithOptions("slack", slack.NewOptions().
+				Recipient("C0123456789").
+				Username("ops-bot").
+				UnfurlLinks(false).
+				Block(slack.NewHeaderBlock("Maintenance tonight")).
+				Block(slack.NewSectionBlock().Text("*22:00* to *23:00*", true)))
+	}
+
+	bodies := make(chan string, 2)
+	client := &http.Client{Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		bodies <- string(body)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":true,"ts":"1700000001.000200"}`)),
+			Header:     make(http.Header),
+		}, nil
+	})}
+	transport := slack.NewTransport("xoxb-token", "", client)
+
+	if _, err := transport.Send(ctx, newMessage()); err != nil {
+		t.Fatalf("Direct send failed: %v", err)
+	}
+	direct := <-bodies
+
+	store := newStore(t)
+	scheduler := NewScheduler(store, transport)
+	if _, err := scheduler.Schedule(ctx, newMessage(), time.Now().Add(time.Hour)); err != nil {
+		t.Fatalf("Schedule failed: %v", err)
+	}
+	scheduler.Stop()
+
+	// Due after the restart, so the payload is read back from the store
+	messages, err := store.List(ctx)
+	if err != nil || len(messages) != 1 {
+		t.Fatalf("Expected 1 stored message, got %d (%v)", len(messages), err)
+	}
+	messages[0].SendAt = time.Now().Add(-time.Minute)
+	if err := store.Save(ctx, messages[0]); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	restarted := NewScheduler(store, transport).OnError(func(scheduled *Scheduled, err error) {
+		t.Errorf("Send failed: %v", err)
+	})
+	defer restarted.Stop()
+	if err := restarted.Reconcile(ctx); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	select {
+	case scheduled := <-bodies:
+		if scheduled != direct {
+			t.Errorf("Scheduled request differs from direct send:\n%s\n%s", scheduled, direct)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Scheduled message was not sent")
+	}
+}
@@ -0,0 +1,321 @@
+package schedule
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/outbox"
+)
+
+// ErrNotFound is returned when cancelling an unknown scheduled message.
+var ErrNotFound = errors.New("schedule: message not found")
+
+// ErrClosed is returned when scheduling messages after Close.
+var ErrClosed = errors.New("schedule: scheduler is closed")
+
+// Scheduler sends scheduled messages and keeps track of natively scheduled ones.
+// Call Reconcile after creating it to reload the messages of the store.
+type Scheduler struct {
+	store     Store
+	sender    outbox.Sender
+	cancelers map[string]Canceler
+	decode    func(payload *notifier.Payload) (notifier.MessageInterface, error)
+	onError   func(scheduled *Scheduled, err error)
+	now       func() time.Time
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu        sync.Mutex
+	closed    bool
+	scheduled map[string]*Scheduled
+	timers    map[string]*time.Timer
+	// inFlight holds the IDs of the messages being sent, which Reconcile must not arm again.
+	inFlight map[string]struct{}
+}
+
+// NewScheduler creates a scheduler storing messages in the store and sending them with the sender.
+func NewScheduler(store Store, sender outbox.Sender) *Scheduler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Scheduler{
+		store:     store,
+		sender:    sender,
+		cancelers: make(map[string]Canceler),
+		decode:    outbox.DecodeMessage,
+		now:       time.Now,
+		ctx:       ctx,
+		cancel:    cancel,
+		scheduled: make(map[string]*Scheduled),
+		timers:    make(map[string]*time.Timer),
+		inFlight:  make(map[string]struct{}),
+	}
+}
+
+// SetTransports registers the transports able to cancel natively scheduled messages,
+// matched by their String() representation.
+func (s *Scheduler) SetTransports(transports ...notifier.TransportInterface) *Scheduler {
+	for _, transport := range transports {
+		name := transport.String()
+		// Look through wrappers like notifier.ConcurrencyLimitedTransport
+		for {
+			if canceler, ok := transport.(Canceler); ok {
+				s.cancelers[name] = canceler
+				break
+			}
+			wrapper, ok := transport.(interface {
+				Unwrap() notifier.TransportInterface
+			})
+			if !ok {
+				break
+			}
+			transport = wrapper.Unwrap()
+		}
+	}
+	return s
+}
+
+// SetDecoder sets the function rebuilding messages from their payload, e.g. to restore options
+// of custom types. outbox.DecodeMessage is used by default.
+func (s *Scheduler) SetDecoder(decode func(payload *notifier.Payload) (notifier.MessageInterface, error)) *Scheduler {
+	s.decode = decode
+	return s
+}
+
+// OnError sets a callback for failed sends. Failed messages stay in the store and are sent again
+// by the next Reconcile.
+func (s *Scheduler) OnError(callback func(scheduled *Scheduled, err error)) *Scheduler {
+	s.onError = callback
+	return s
+}
+
+// Schedule stores the message and sends it at the given time. It returns the ID to cancel it.
+// Email attachments and headers are not stored.
+func (s *Scheduler) Schedule(ctx context.Context, message notifier.MessageInterface, sendAt time.Time) (string, error) {
+	if s.isClosed() {
+		return "", ErrClosed
+	}
+
+	scheduled := &Scheduled{
+		ID:      newID(),
+		Payload: notifier.NewPayload(message),
+		SendAt:  sendAt,
+	}
+	if err := s.store.Save(ctx, scheduled); err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(scheduled)
+	return scheduled.ID, nil
+}
+
+// Track records a message scheduled natively by the provider (e.g., sent with Slack PostAt)
+// so it can be cancelled by ID, also after a restart.
+func (s *Scheduler) Track(ctx context.Context, sent *notifier.SentMessage, sendAt time.Time) (string, error) {
+	if s.isClosed() {
+		return "", ErrClosed
+	}
+
+	info, _ := sent.GetInfo().(map[string]any)
+	scheduled := &Scheduled{
+		ID:        newID(),
+		SendAt:    sendAt,
+		Transport: sent.GetTransport(),
+		MessageID: sent.GetMessageID(),
+		Info:      info,
+	}
+	if err := s.store.Save(ctx, scheduled); err != nil {
+		return "", err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.add(scheduled)
+	return scheduled.ID, nil
+}
+
+// Cancel cancels a scheduled message. Natively scheduled messages are cancelled at the provider,
+// which requires the transport to be registered with SetTransports.
+func (s *Scheduler) Cancel(ctx context.Context, id string) error {
+	s.mu.Lock()
+	scheduled, ok := s.scheduled[id]
+	s.mu.Unlock()
+	if !ok {
+		return ErrNotFound
+	}
+
+	if scheduled.IsNative() {
+		canceler, ok := s.cancelers[scheduled.Transport]
+		if !ok {
+			return fmt.Errorf("schedule: transport %q can't cancel scheduled messages", scheduled.Transport)
+		}
+		if err := canceler.CancelScheduled(ctx, scheduled.MessageID, scheduled.Info); err != nil {
+			return err
+		}
+	}
+
+	s.mu.Lock()
+	if timer, ok := s.timers[id]; ok {
+		timer.Stop()
+		delete(s.timers, id)
+	}
+	delete(s.scheduled, id)
+	s.mu.Unlock()
+
+	return s.store.Delete(ctx, id)
+}
+
+// List returns the pending scheduled messages.
+func (s *Scheduler) List() []*Scheduled {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	messages := make([]*Scheduled, 0, len(s.scheduled))
+	for _, scheduled := range s.scheduled {
+		messages = append(messages, scheduled)
+	}
+	return messages
+}
+
+// Reconcile reloads the messages of the store, typically after a restart. Messages whose time has
+// passed are sent immediately; natively scheduled messages whose time has passed were sent by the
+// provider and are removed.
+func (s *Scheduler) Reconcile(ctx context.Context) error {
+	messages, err := s.store.List(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := s.now()
+	var errs []error
+	for _, scheduled := range messages {
+		if scheduled.IsNative() && !scheduled.SendAt.After(now) {
+			s.mu.Lock()
+			delete(s.scheduled, scheduled.ID)
+			s.mu.Unlock()
+			if err := s.store.Delete(ctx, scheduled.ID); err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		s.mu.Lock()
+		if _, ok := s.inFlight[scheduled.ID]; ok {
+			// Still being sent by its timer, which removes it from the store when done
+			s.mu.Unlock()
+			continue
+		}
+		if timer, ok := s.timers[scheduled.ID]; ok {
+			timer.Stop()
+		}
+		s.add(scheduled)
+		s.mu.Unlock()
+	}
+	return errors.Join(errs...)
+}
+
+// Stop stops all timers and waits for in-flight sends. Pending messages stay in the store.
+func (s *Scheduler) Stop() {
+	_ = s.Close(context.Background())
+}
+
+// Close stops all timers and waits for in-flight sends, or until the context is done.
+// Pending messages stay in the store and are reloaded by Reconcile; scheduling new messages
+// returns ErrClosed.
+func (s *Scheduler) Close(ctx context.Context) error {
+	s.mu.Lock()
+	s.closed = true
+	for id, timer := range s.timers {
+		timer.Stop()
+		delete(s.timers, id)
+	}
+	s.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.cancel()
+		return nil
+	case <-ctx.Done():
+		// Abort the in-flight sends
+		s.cancel()
+		return ctx.Err()
+	}
+}
+
+func (s *Scheduler) isClosed() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.closed
+}
+
+// add registers a scheduled message and arms its timer; s.mu must be held.
+func (s *Scheduler) add(scheduled *Scheduled) {
+	s.scheduled[scheduled.ID] = scheduled
+	if scheduled.IsNative() || s.closed {
+		return
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(max(scheduled.SendAt.Sub(s.now()), 0), func() {
+		s.mu.Lock()
+		if s.timers[scheduled.ID] != timer {
+			// Cancelled, stopped or replaced by Reconcile after firing
+			s.mu.Unlock()
+			return
+		}
+		delete(s.timers, scheduled.ID)
+		s.inFlight[scheduled.ID] = struct{}{}
+		s.wg.Add(1)
+		s.mu.Unlock()
+
+		defer s.wg.Done()
+		s.send(scheduled)
+	})
+	s.timers[scheduled.ID] = timer
+}
+
+func (s *Scheduler) send(scheduled *Scheduled) {
+	defer func() {
+		s.mu.Lock()
+		delete(s.inFlight, scheduled.ID)
+		s.mu.Unlock()
+	}()
+
+	message, err := s.decode(scheduled.Payload)
+	if err == nil {
+		_, err = s.sender.Send(s.ctx, message)
+	}
+	if err != nil {
+		if s.onError != nil {
+			s.onError(scheduled, err)
+		}
+		return
+	}
+
+	s.mu.Lock()
+	delete(s.scheduled, scheduled.ID)
+	s.mu.Unlock()
+	if err := s.store.Delete(s.ctx, scheduled.ID); err != nil && s.onError != nil {
+		s.onError(scheduled, err)
+	}
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,149 @@
+package signing_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/signing"
+
+	_ "github.com/shyim/go-notifier/transport/gotify"
+)
+
+func newSignedRequest(t *testing.T, signer *signing.Signer, body string) *http.Request {
+	t.Helper()
+	req := httptest.NewRequest("POST", "/hook", strings.NewReader(body))
+	if err := signer.Sign(req); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	return req
+}
+
+func TestSignAndVerify(t *testing.T) {
+	signer := signing.NewSigner("s3cr3t")
+	req := newSignedRequest(t, signer, `{"subject":"Hello"}`)
+
+	if !strings.HasPrefix(req.Header.Get(signing.DefaultSignatureHeader), "sha256=") {
+		t.Errorf("Expected sha256 signature, got: %s", req.Header.Get(signing.DefaultSignatureHeader))
+	}
+	if req.Header.Get(signing.DefaultTimestampHeader) == "" {
+		t.Error("Expected timestamp header")
+	}
+
+	if err := signer.Verify(req); err != nil {
+		t.Fatalf("Expected valid signature, got: %v", err)
+	}
+
+	// The body is still readable after signing and verifying
+	body, _ := io.ReadAll(req.Body)
+	if string(body) != `{"subject":"Hello"}` {
+		t.Errorf("Expected body to be preserved, got: %s", body)
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	signer := signing.NewSigner("s3cr3t")
+
+	tampered := newSignedRequest(t, signer, `{"subject":"Hello"}`)
+	tampered.Body = io.NopCloser(strings.NewReader(`{"subject":"Bye"}`))
+
+	wrongSecret := newSignedRequest(t, signing.NewSigner("other"), `{"subject":"Hello"}`)
+
+	expired := newSignedRequest(t, signer, `{"subject":"Hello"}`)
+	expired.Header.Set(signing.DefaultTimestampHeader, "1700000000")
+
+	tests := []struct {
+		name     string
+		req      *http.Request
+		expected error
+	}{
+		{"missing", httptest.NewRequest("POST", "/hook", strings.NewReader("{}")), signing.ErrMissingSignature},
+		{"tampered body", tampered, signing.ErrInvalidSignature},
+		{"wrong secret", wrongSecret, signing.ErrInvalidSignature},
+		{"expired", expired, signing.ErrExpiredSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := signer.Verify(tt.req); !errors.Is(err, tt.expected) {
+				t.Errorf("Expected %v, got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestCustomHeaders(t *testing.T) {
+	signer := signing.NewSigner("s3cr3t").
+		SetSignatureHeader("X-Signature").
+		SetTimestampHeader("X-Signature-Timestamp").
+		SetTolerance(time.Minute)
+	req := newSignedRequest(t, signer, "payload")
+
+	if req.Header.Get("X-Signature") == "" || req.Header.Get("X-Signature-Timestamp") == "" {
+		t.Errorf("Expected custom headers, got: %v", req.Header)
+	}
+	if err := signer.Verify(req); err != nil {
+		t.Errorf("Expected valid signature, got: %v", err)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	signer := signing.NewSigner("s3cr3t")
+	handler := signer.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, newSignedRequest(t, signer, "payload"))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected status 204, got: %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("POST", "/hook", strings.NewReader("payload")))
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("Expected status 401, got: %d", rec.Code)
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	signer := signing.NewSigner("s3cr3t")
+	server := httptest.NewServer(signer.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})))
+	defer server.Close()
+
+	client := &http.Client{Transport: signer.RoundTripper(nil)}
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status 204, got: %d", resp.StatusCode)
+	}
+}
+
+func TestTransportDSN(t *testing.T) {
+	signer := signing.NewSigner("s3cr3t").SetSignatureHeader("X-Signature")
+	server := httptest.NewServer(signer.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id":1}`))
+	})))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	transport, err := notifier.NewTransportFromDSN("gotify+http://token@" + host + "?signing_secret=s3cr3t&signing_header=X-Signature")
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello")); err != nil {
+		t.Errorf("Expected signed request to be accepted, got: %v", err)
+	}
+}
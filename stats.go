@@ -0,0 +1,85 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TransportStats holds the delivery statistics of a transport, as collected by a Notifier.
+type TransportStats struct {
+	Transport string
+	Successes int64
+	Failures  int64
+	// LastError is the error of the most recent failed send, LastErrorAt its time.
+	LastError   error
+	LastErrorAt time.Time
+	// AverageLatency is the mean duration of all sends, including retries done by middleware.
+	AverageLatency time.Duration
+}
+
+// stats collects TransportStats per transport, keyed by the String() representation like the
+// rate limits of RateLimitMiddleware, as transport values may not be comparable.
+type stats struct {
+	mu         sync.Mutex
+	transports map[string]*transportStats
+}
+
+type transportStats struct {
+	TransportStats
+	totalLatency time.Duration
+}
+
+func (s *stats) record(transport TransportInterface, latency time.Duration, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.transports == nil {
+		s.transports = make(map[string]*transportStats)
+	}
+	ts, ok := s.transports[transport.String()]
+	if !ok {
+		ts = &transportStats{}
+		s.transports[transport.String()] = ts
+	}
+
+	if err != nil {
+		ts.Failures++
+		ts.LastError = err
+		ts.LastErrorAt = time.Now()
+	} else {
+		ts.Successes++
+	}
+	ts.totalLatency += latency
+	ts.AverageLatency = ts.totalLatency / time.Duration(ts.Successes+ts.Failures)
+}
+
+func (s *stats) get(transport TransportInterface) TransportStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result TransportStats
+	if ts, ok := s.transports[transport.String()]; ok {
+		result = ts.TransportStats
+	}
+	result.Transport = transport.String()
+	return result
+}
+
+// Stats returns the delivery statistics of every transport of the Notifier, in the order the
+// transports were configured, e.g. to show notification health on an admin page.
+func (n *Notifier) Stats() []TransportStats {
+	result := make([]TransportStats, 0, len(n.transports))
+	for _, transport := range n.transports {
+		result = append(result, n.stats.get(transport))
+	}
+	return result
+}
+
+// timed sends the message and records the outcome in the statistics.
+func (n *Notifier) timed(ctx context.Context, send SendFunc, transport TransportInterface, message MessageInterface) (*SentMessage, error) {
+	start := time.Now()
+	sent, err := send(ctx, transport, message)
+	n.stats.record(transport, time.Since(start), err)
+	return sent, err
+}
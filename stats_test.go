@@ -0,0 +1,113 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	failing := errors.New("unavailable")
+
+	tests := []struct {
+		name              string
+		errs              []error
+		expectedSuccesses int64
+		expectedFailures  int64
+		expectedLastError error
+	}{
+		{name: "no sends"},
+		{name: "successes", errs: []error{nil, nil}, expectedSuccesses: 2},
+		{name: "failures", errs: []error{nil, failing, nil}, expectedSuccesses: 2, expectedFailures: 1, expectedLastError: failing},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sends := 0
+			primary := &testTransport{key: "primary", send: func(ctx context.Context, message MessageInterface) error {
+				err := tt.errs[sends]
+				sends++
+				time.Sleep(time.Millisecond)
+				return err
+			}}
+			fallback := &testTransport{key: "fallback"}
+			n := NewNotifier(primary, fallback)
+
+			start := time.Now()
+			for range tt.errs {
+				_, _ = n.Send(context.Background(), NewChatMessage("Disk full"))
+			}
+
+			stats := n.Stats()
+			if len(stats) != 2 || stats[0].Transport != "primary://default" || stats[1].Transport != "fallback://default" {
+				t.Fatalf("Expected the stats of every transport in order, got: %+v", stats)
+			}
+			if stats[1] != (TransportStats{Transport: "fallback://default"}) {
+				t.Errorf("Expected no sends on the fallback, got: %+v", stats[1])
+			}
+
+			primaryStats := stats[0]
+			if primaryStats.Successes != tt.expectedSuccesses || primaryStats.Failures != tt.expectedFailures {
+				t.Errorf("Expected %d successes and %d failures, got: %+v", tt.expectedSuccesses, tt.expectedFailures, primaryStats)
+			}
+			if primaryStats.LastError != tt.expectedLastError {
+				t.Errorf("Expected last error %v, got: %v", tt.expectedLastError, primaryStats.LastError)
+			}
+			if tt.expectedLastError != nil && (primaryStats.LastErrorAt.Before(start) || primaryStats.LastErrorAt.After(time.Now())) {
+				t.Errorf("Unexpected last error time: %s", primaryStats.LastErrorAt)
+			}
+			if len(tt.errs) > 0 && primaryStats.AverageLatency < time.Millisecond {
+				t.Errorf("Expected an average latency of at least 1ms, got: %s", primaryStats.AverageLatency)
+			}
+		})
+	}
+}
+
+func TestStatsIncludeMiddleware(t *testing.T) {
+	attempts := 0
+	transport := &testTransport{key: "test", send: func(ctx context.Context, message MessageInterface) error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}}
+	n := NewNotifier(transport).Use(RetryMiddleware(RetryPolicy{MaxAttempts: 2, InitialBackoff: 10 * time.Millisecond}))
+
+	if _, err := n.Send(context.Background(), NewChatMessage("Disk full")); err != nil {
+		t.Fatalf("Expected the retry to succeed, got: %v", err)
+	}
+
+	// A send retried by middleware is recorded once, with the time of all attempts
+	stats := n.Stats()[0]
+	if stats.Successes != 1 || stats.Failures != 0 || stats.AverageLatency < 10*time.Millisecond {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
+
+// uncomparableTransport is a transport value that can't be used as a map key.
+type uncomparableTransport struct {
+	tags []string
+}
+
+func (t uncomparableTransport) Send(ctx context.Context, message MessageInterface) (*SentMessage, error) {
+	return NewSentMessage(message, t.String()), nil
+}
+
+func (t uncomparableTransport) Supports(message MessageInterface) bool { return true }
+
+func (t uncomparableTransport) String() string { return "uncomparable://default" }
+
+func TestStatsUncomparableTransport(t *testing.T) {
+	n := NewNotifier(uncomparableTransport{tags: []string{"alerts"}})
+
+	if _, err := n.Send(context.Background(), NewChatMessage("Disk full")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	stats := n.Stats()
+	if len(stats) != 1 || stats[0].Transport != "uncomparable://default" || stats[0].Successes != 1 {
+		t.Errorf("Unexpected stats: %+v", stats)
+	}
+}
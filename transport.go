@@ -2,9 +2,15 @@ package notifier
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 	"sync"
+
+	"github.com/shyim/go-notifier/signing"
 )
 
 // Global transport factory registry
@@ -22,24 +28,135 @@ func RegisterTransportFactory(factory TransportFactoryInterface) {
 }
 
 // NewTransportFromDSN creates a transport from a DSN string using registered factories.
+//
+// The max_concurrency option, supported by all transports, limits the number of concurrent
+// sends (e.g., ?max_concurrency=4); the transport is then wrapped in a ConcurrencyLimitedTransport.
+// The raw_response option enables SetCaptureRawResponse on HTTP transports (e.g., ?raw_response=1),
+// path_prefix sets their SetPathPrefix (e.g., ?path_prefix=/proxied/api) and insecure_http
+// their SetInsecureHTTP (e.g., ?insecure_http=1); both are rejected with the default host. signing_secret signs their requests with an
+// HMAC-SHA256 of the body, see the signing package (e.g., ?signing_secret=s3cr3t&signing_header=X-Signature).
 func NewTransportFromDSN(dsnString string) (TransportInterface, error) {
 	dsn, err := NewDSN(dsnString)
 	if err != nil {
 		return nil, err
 	}
 
+	// The default host is the provider API, which is never behind a gateway or served without TLS
+	if dsn.GetHost() == "default" {
+		if dsn.GetOption("path_prefix") != "" {
+			return nil, fmt.Errorf("invalid DSN: path_prefix requires a host, it doesn't apply to the default host. DSN: %s", dsn.GetOriginalDSN())
+		}
+		if dsn.GetBooleanOption("insecure_http") {
+			return nil, fmt.Errorf("invalid DSN: insecure_http requires a host, it doesn't apply to the default host. DSN: %s", dsn.GetOriginalDSN())
+		}
+	}
+
+	maxConcurrency := 0
+	if value := dsn.GetOption("max_concurrency"); value != "" {
+		maxConcurrency, err = strconv.Atoi(value)
+		if err != nil || maxConcurrency < 1 {
+			return nil, fmt.Errorf("invalid DSN: Invalid max_concurrency \"%s\". DSN: %s", value, dsn.GetOriginalDSN())
+		}
+	}
+
 	transportFactoriesMu.RLock()
 	defer transportFactoriesMu.RUnlock()
 
 	for _, factory := range transportFactories {
 		if factory.Supports(dsn) {
-			return factory.Create(dsn)
+			transport, err := factory.Create(dsn)
+			if err != nil {
+				return nil, err
+			}
+			if dsn.GetBooleanOption("raw_response") {
+				if capturer, ok := transport.(interface {
+					SetCaptureRawResponse(capture bool) *AbstractTransport
+				}); ok {
+					capturer.SetCaptureRawResponse(true)
+				}
+			}
+			if prefix := dsn.GetOption("path_prefix"); prefix != "" {
+				if prefixer, ok := transport.(interface {
+					SetPathPrefix(prefix string) *AbstractTransport
+				}); ok {
+					prefixer.SetPathPrefix(prefix)
+				}
+			}
+			if dsn.GetBooleanOption("insecure_http") {
+				if downgrader, ok := transport.(interface {
+					SetInsecureHTTP(insecure bool) *AbstractTransport
+				}); ok {
+					downgrader.SetInsecureHTTP(true)
+				}
+			}
+			if secret := dsn.GetOption("signing_secret"); secret != "" {
+				if signable, ok := transport.(interface {
+					SetRequestSigner(signer RequestSigner) *AbstractTransport
+				}); ok {
+					signer := signing.NewSigner(secret)
+					if header := dsn.GetOption("signing_header"); header != "" {
+						signer.SetSignatureHeader(header)
+					}
+					signable.SetRequestSigner(signer)
+				}
+			}
+			if maxConcurrency == 0 {
+				return transport, err
+			}
+			return NewConcurrencyLimitedTransport(transport, maxConcurrency), nil
 		}
 	}
 
 	return nil, fmt.Errorf("no registered transport factory supports scheme: %s", dsn.GetScheme())
 }
 
+// ConcurrencyLimitedTransport limits the number of concurrent sends of a transport, so bursts
+// don't open hundreds of connections to one provider. Sends wait for a free slot.
+type ConcurrencyLimitedTransport struct {
+	transport TransportInterface
+	semaphore chan struct{}
+}
+
+// NewConcurrencyLimitedTransport wraps the transport, allowing up to limit concurrent sends.
+func NewConcurrencyLimitedTransport(transport TransportInterface, limit int) *ConcurrencyLimitedTransport {
+	return &ConcurrencyLimitedTransport{
+		transport: transport,
+		semaphore: make(chan struct{}, max(limit, 1)),
+	}
+}
+
+func (t *ConcurrencyLimitedTransport) Send(ctx context.Context, message MessageInterface) (*SentMessage, error) {
+	select {
+	case t.semaphore <- struct{}{}:
+		defer func() { <-t.semaphore }()
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	return t.transport.Send(ctx, message)
+}
+
+func (t *ConcurrencyLimitedTransport) Supports(message MessageInterface) bool {
+	return t.transport.Supports(message)
+}
+
+// String returns the string representation of the wrapped transport.
+func (t *ConcurrencyLimitedTransport) String() string {
+	return t.transport.String()
+}
+
+// Close closes the wrapped transport if it has a Close method.
+func (t *ConcurrencyLimitedTransport) Close() error {
+	if closer, ok := t.transport.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Unwrap returns the wrapped transport.
+func (t *ConcurrencyLimitedTransport) Unwrap() TransportInterface {
+	return t.transport
+}
+
 // TransportInterface represents a transport that can send messages.
 type TransportInterface interface {
 	// Send sends a message and returns the sent message with transport info.
@@ -50,6 +167,14 @@ type TransportInterface interface {
 	String() string
 }
 
+// TransportKey returns the key of the transport used for message options and overrides:
+// the scheme of its string representation without suffixes (e.g., "gotify" for "gotify+http://...").
+func TransportKey(transport TransportInterface) string {
+	scheme, _, _ := strings.Cut(transport.String(), "://")
+	key, _, _ := strings.Cut(scheme, "+")
+	return key
+}
+
 // TransportFactoryInterface creates transports from DSN.
 type TransportFactoryInterface interface {
 	// Create creates a transport from the given DSN.
@@ -58,11 +183,19 @@ type TransportFactoryInterface interface {
 	Supports(dsn *DSN) bool
 }
 
+// RawResponseInfo is the SentMessage info key holding the decoded provider response,
+// if enabled with SetCaptureRawResponse.
+const RawResponseInfo = "raw_response"
+
 // AbstractTransport provides common transport functionality.
 type AbstractTransport struct {
-	client *http.Client
-	host   string
-	port   int
+	client             *http.Client
+	host               string
+	port               int
+	captureRawResponse bool
+	pathPrefix         string
+	insecureHTTP       bool
+	signer             RequestSigner
 }
 
 func NewAbstractTransport(client *http.Client) *AbstractTransport {
@@ -100,7 +233,59 @@ func (t *AbstractTransport) GetDefaultHost() string {
 }
 
 func (t *AbstractTransport) GetClient() *http.Client {
-	return t.client
+	return t.endpointClient(t.client)
+}
+
+// Do sends the request through the client of the transport, or the client set on the request
+// context with WithHTTPClient.
+func (t *AbstractTransport) Do(req *http.Request) (*http.Response, error) {
+	client := t.client
+	if override := HTTPClientFromContext(req.Context()); override != nil {
+		client = override
+	}
+	return t.endpointClient(client).Do(req)
+}
+
+// SetCaptureRawResponse makes the transport keep the decoded API response in the
+// RawResponseInfo info of sent messages, for metadata not exposed otherwise
+// (e.g., Slack permalinks or the Gotify app ID).
+func (t *AbstractTransport) SetCaptureRawResponse(capture bool) *AbstractTransport {
+	t.captureRawResponse = capture
+	return t
+}
+
+// CapturesRawResponse reports whether raw responses are captured, see SetCaptureRawResponse.
+func (t *AbstractTransport) CapturesRawResponse() bool {
+	return t.captureRawResponse
+}
+
+// DecodeResponse decodes a JSON response body into result. If raw responses are captured,
+// it also returns the body decoded into generic values (maps, slices, ...), nil otherwise.
+func (t *AbstractTransport) DecodeResponse(body io.Reader, result any) (any, error) {
+	if !t.captureRawResponse {
+		return nil, json.NewDecoder(body).Decode(result)
+	}
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+	var raw any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// SetRawResponse stores a raw response returned by DecodeResponse in the sent message.
+// Nothing is stored if raw is nil.
+func (t *AbstractTransport) SetRawResponse(sentMessage *SentMessage, raw any) {
+	if raw != nil {
+		sentMessage.SetInfo(RawResponseInfo, raw)
+	}
 }
 
 // AbstractTransportFactory provides common factory functionality.
@@ -0,0 +1,382 @@
+package amqp
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"time"
+)
+
+// AMQP 0-9-1 frame types.
+const (
+	frameMethod    = 1
+	frameHeader    = 2
+	frameBody      = 3
+	frameHeartbeat = 8
+	frameEnd       = 0xce
+)
+
+// AMQP 0-9-1 class and method identifiers used by the client.
+const (
+	classConnection = 10
+	classChannel    = 20
+	classBasic      = 60
+	classConfirm    = 85
+
+	methodConnectionStart   = 10
+	methodConnectionStartOk = 11
+	methodConnectionTune    = 30
+	methodConnectionTuneOk  = 31
+	methodConnectionOpen    = 40
+	methodConnectionOpenOk  = 41
+	methodConnectionClose   = 50
+	methodConnectionCloseOk = 51
+
+	methodChannelOpen   = 10
+	methodChannelOpenOk = 11
+	methodChannelClose  = 40
+
+	methodBasicPublish = 40
+	methodBasicReturn  = 50
+	methodBasicAck     = 80
+	methodBasicNack    = 120
+
+	methodConfirmSelect   = 10
+	methodConfirmSelectOk = 11
+)
+
+// protocolHeader starts an AMQP 0-9-1 connection.
+var protocolHeader = []byte{'A', 'M', 'Q', 'P', 0, 0, 9, 1}
+
+// properties are the basic content properties sent with a message.
+type properties struct {
+	contentType   string
+	headers       map[string]string
+	deliveryMode  byte
+	priority      byte
+	correlationID string
+	expiration    string
+	messageID     string
+	timestamp     time.Time
+	messageType   string
+	appID         string
+}
+
+// frame is a decoded AMQP frame.
+type frame struct {
+	kind    byte
+	channel uint16
+	payload []byte
+}
+
+// method returns the class and method identifiers of a method frame.
+func (f frame) method() (uint16, uint16) {
+	if f.kind != frameMethod || len(f.payload) < 4 {
+		return 0, 0
+	}
+	return binary.BigEndian.Uint16(f.payload), binary.BigEndian.Uint16(f.payload[2:])
+}
+
+// conn is a minimal AMQP 0-9-1 client able to open a channel and publish messages.
+type conn struct {
+	netConn  net.Conn
+	reader   *bufio.Reader
+	frameMax uint32
+}
+
+// open performs the connection handshake with PLAIN authentication and opens channel 1.
+func (c *conn) open(username, password, vhost string) error {
+	if _, err := c.netConn.Write(protocolHeader); err != nil {
+		return err
+	}
+
+	if _, err := c.expect(classConnection, methodConnectionStart); err != nil {
+		return err
+	}
+
+	args := appendTable(nil, map[string]string{"product": "go-notifier"})
+	args = appendShortString(args, "PLAIN")
+	args = appendLongString(args, "\x00"+username+"\x00"+password)
+	args = appendShortString(args, "en_US")
+	if err := c.writeMethod(0, classConnection, methodConnectionStartOk, args); err != nil {
+		return err
+	}
+
+	tune, err := c.expect(classConnection, methodConnectionTune)
+	if err != nil {
+		return fmt.Errorf("authenticate: %w", err)
+	}
+	if len(tune) < 8 {
+		return errors.New("malformed connection.tune")
+	}
+	channelMax := binary.BigEndian.Uint16(tune)
+	c.frameMax = binary.BigEndian.Uint32(tune[2:])
+	if c.frameMax == 0 {
+		c.frameMax = 131072
+	}
+
+	args = binary.BigEndian.AppendUint16(nil, channelMax)
+	args = binary.BigEndian.AppendUint32(args, c.frameMax)
+	// Heartbeats are disabled, connections are short-lived
+	args = binary.BigEndian.AppendUint16(args, 0)
+	if err := c.writeMethod(0, classConnection, methodConnectionTuneOk, args); err != nil {
+		return err
+	}
+
+	args = appendShortString(nil, vhost)
+	args = appendShortString(args, "")
+	args = append(args, 0)
+	if err := c.writeMethod(0, classConnection, methodConnectionOpen, args); err != nil {
+		return err
+	}
+	if _, err := c.expect(classConnection, methodConnectionOpenOk); err != nil {
+		return fmt.Errorf("open virtual host %q: %w", vhost, err)
+	}
+
+	if err := c.writeMethod(1, classChannel, methodChannelOpen, appendShortString(nil, "")); err != nil {
+		return err
+	}
+	if _, err := c.expect(classChannel, methodChannelOpenOk); err != nil {
+		return fmt.Errorf("open channel: %w", err)
+	}
+	return nil
+}
+
+// confirmSelect puts the channel into publisher confirm mode.
+func (c *conn) confirmSelect() error {
+	if err := c.writeMethod(1, classConfirm, methodConfirmSelect, []byte{0}); err != nil {
+		return err
+	}
+	if _, err := c.expect(classConfirm, methodConfirmSelectOk); err != nil {
+		return fmt.Errorf("enable publisher confirms: %w", err)
+	}
+	return nil
+}
+
+// publish sends basic.publish with the content header and body frames.
+func (c *conn) publish(exchange, routingKey string, mandatory bool, props properties, body []byte) error {
+	args := binary.BigEndian.AppendUint16(nil, 0)
+	args = appendShortString(args, exchange)
+	args = appendShortString(args, routingKey)
+	var bits byte
+	if mandatory {
+		bits |= 0x01
+	}
+	args = append(args, bits)
+	if err := c.writeMethod(1, classBasic, methodBasicPublish, args); err != nil {
+		return err
+	}
+
+	header := binary.BigEndian.AppendUint16(nil, classBasic)
+	header = binary.BigEndian.AppendUint16(header, 0)
+	header = binary.BigEndian.AppendUint64(header, uint64(len(body)))
+	header = appendProperties(header, props)
+	if err := c.writeFrame(frameHeader, 1, header); err != nil {
+		return err
+	}
+
+	// Frame overhead is 7 bytes of header and 1 frame-end byte
+	maxBody := int(c.frameMax) - 8
+	for len(body) > 0 {
+		chunk := body[:min(len(body), maxBody)]
+		if err := c.writeFrame(frameBody, 1, chunk); err != nil {
+			return err
+		}
+		body = body[len(chunk):]
+	}
+	return nil
+}
+
+// waitConfirm waits for the broker to acknowledge the published message.
+// Unroutable mandatory messages are returned by the broker before the acknowledgement.
+func (c *conn) waitConfirm() error {
+	var returned error
+	for {
+		f, err := c.readFrame()
+		if err != nil {
+			return err
+		}
+		if f.kind != frameMethod {
+			// Content frames of a returned message
+			continue
+		}
+
+		switch class, method := f.method(); {
+		case class == classBasic && method == methodBasicAck:
+			return returned
+		case class == classBasic && method == methodBasicNack:
+			return errors.New("message rejected by broker")
+		case class == classBasic && method == methodBasicReturn:
+			code, text := replyCode(f.payload[4:])
+			returned = fmt.Errorf("message returned (%d): %s", code, text)
+		default:
+			if err := closeError(f); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// close closes the connection gracefully.
+func (c *conn) close() error {
+	args := binary.BigEndian.AppendUint16(nil, 200)
+	args = appendShortString(args, "")
+	args = binary.BigEndian.AppendUint32(args, 0)
+	if err := c.writeMethod(0, classConnection, methodConnectionClose, args); err == nil {
+		_, _ = c.expect(classConnection, methodConnectionCloseOk)
+	}
+	return c.netConn.Close()
+}
+
+// expect reads frames until the given method arrives and returns its arguments.
+func (c *conn) expect(class, method uint16) ([]byte, error) {
+	for {
+		f, err := c.readFrame()
+		if err != nil {
+			return nil, err
+		}
+		if f.kind == frameHeartbeat {
+			continue
+		}
+		if gotClass, gotMethod := f.method(); gotClass == class && gotMethod == method {
+			return f.payload[4:], nil
+		}
+		if err := closeError(f); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("unexpected frame, expected method %d.%d", class, method)
+	}
+}
+
+func (c *conn) writeMethod(channel, class, method uint16, args []byte) error {
+	payload := binary.BigEndian.AppendUint16(nil, class)
+	payload = binary.BigEndian.AppendUint16(payload, method)
+	return c.writeFrame(frameMethod, channel, append(payload, args...))
+}
+
+func (c *conn) writeFrame(kind byte, channel uint16, payload []byte) error {
+	buf := []byte{kind}
+	buf = binary.BigEndian.AppendUint16(buf, channel)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(payload)))
+	buf = append(buf, payload...)
+	buf = append(buf, frameEnd)
+	_, err := c.netConn.Write(buf)
+	return err
+}
+
+func (c *conn) readFrame() (frame, error) {
+	header := make([]byte, 7)
+	if _, err := io.ReadFull(c.reader, header); err != nil {
+		return frame{}, err
+	}
+	size := binary.BigEndian.Uint32(header[3:])
+	if c.frameMax > 0 && size > c.frameMax {
+		return frame{}, fmt.Errorf("frame size %d exceeds maximum %d", size, c.frameMax)
+	}
+	payload := make([]byte, size+1)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return frame{}, err
+	}
+	if payload[size] != frameEnd {
+		return frame{}, errors.New("malformed frame")
+	}
+	return frame{kind: header[0], channel: binary.BigEndian.Uint16(header[1:]), payload: payload[:size]}, nil
+}
+
+// closeError converts a connection.close or channel.close sent by the broker into an error.
+func closeError(f frame) error {
+	class, method := f.method()
+	if (class == classConnection && method == methodConnectionClose) || (class == classChannel && method == methodChannelClose) {
+		code, text := replyCode(f.payload[4:])
+		return fmt.Errorf("closed by broker (%d): %s", code, text)
+	}
+	return nil
+}
+
+// replyCode decodes the reply-code and reply-text arguments shared by close and return methods.
+func replyCode(args []byte) (uint16, string) {
+	if len(args) < 3 {
+		return 0, ""
+	}
+	code := binary.BigEndian.Uint16(args)
+	length := int(args[2])
+	if len(args) < 3+length {
+		return code, ""
+	}
+	return code, string(args[3 : 3+length])
+}
+
+func appendProperties(b []byte, props properties) []byte {
+	var flags uint16
+	var list []byte
+	if props.contentType != "" {
+		flags |= 0x8000
+		list = appendShortString(list, props.contentType)
+	}
+	if len(props.headers) > 0 {
+		flags |= 0x2000
+		list = appendTable(list, props.headers)
+	}
+	if props.deliveryMode != 0 {
+		flags |= 0x1000
+		list = append(list, props.deliveryMode)
+	}
+	if props.priority != 0 {
+		flags |= 0x0800
+		list = append(list, props.priority)
+	}
+	if props.correlationID != "" {
+		flags |= 0x0400
+		list = appendShortString(list, props.correlationID)
+	}
+	if props.expiration != "" {
+		flags |= 0x0100
+		list = appendShortString(list, props.expiration)
+	}
+	if props.messageID != "" {
+		flags |= 0x0080
+		list = appendShortString(list, props.messageID)
+	}
+	if !props.timestamp.IsZero() {
+		flags |= 0x0040
+		list = binary.BigEndian.AppendUint64(list, uint64(props.timestamp.Unix()))
+	}
+	if props.messageType != "" {
+		flags |= 0x0020
+		list = appendShortString(list, props.messageType)
+	}
+	if props.appID != "" {
+		flags |= 0x0008
+		list = appendShortString(list, props.appID)
+	}
+	b = binary.BigEndian.AppendUint16(b, flags)
+	return append(b, list...)
+}
+
+// appendTable encodes a field table with long string values.
+func appendTable(b []byte, table map[string]string) []byte {
+	var fields []byte
+	for key, value := range table {
+		fields = appendShortString(fields, key)
+		fields = append(fields, 'S')
+		fields = appendLongString(fields, value)
+	}
+	b = binary.BigEndian.AppendUint32(b, uint32(len(fields)))
+	return append(b, fields...)
+}
+
+func appendShortString(b []byte, s string) []byte {
+	if len(s) > 255 {
+		s = s[:255]
+	}
+	b = append(b, byte(len(s)))
+	return append(b, s...)
+}
+
+func appendLongString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
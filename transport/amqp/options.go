@@ -0,0 +1,111 @@
+package amqp
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for AMQP.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Exchange overrides the exchange the message is published to.
+func (o *Options) Exchange(exchange string) *Options {
+	o.options["exchange"] = exchange
+	return o
+}
+
+// RoutingKey overrides the routing key.
+func (o *Options) RoutingKey(routingKey string) *Options {
+	o.options["routing_key"] = routingKey
+	return o
+}
+
+// Persistent overrides whether the message is stored on disk by the broker.
+func (o *Options) Persistent(persistent bool) *Options {
+	o.options["persistent"] = persistent
+	return o
+}
+
+// Mandatory overrides whether unroutable messages make Send fail.
+func (o *Options) Mandatory(mandatory bool) *Options {
+	o.options["mandatory"] = mandatory
+	return o
+}
+
+// Priority sets the message priority (0-9), used by priority queues.
+func (o *Options) Priority(priority byte) *Options {
+	o.options["priority"] = priority
+	return o
+}
+
+// Expiration sets the time-to-live of the message in the queue.
+func (o *Options) Expiration(ttl time.Duration) *Options {
+	o.options["expiration"] = ttl
+	return o
+}
+
+// MessageID sets the message ID, a random ID is generated otherwise.
+func (o *Options) MessageID(id string) *Options {
+	o.options["message_id"] = id
+	return o
+}
+
+// CorrelationID sets the correlation ID property.
+func (o *Options) CorrelationID(id string) *Options {
+	o.options["correlation_id"] = id
+	return o
+}
+
+// Header adds an AMQP message header, e.g. for headers exchanges.
+func (o *Options) Header(key, value string) *Options {
+	headers, ok := o.options["headers"].(map[string]string)
+	if !ok {
+		headers = make(map[string]string)
+		o.options["headers"] = headers
+	}
+	headers[key] = value
+	return o
+}
+
+// Metadata adds a key-value pair to the metadata of the published payload.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"priority":   byte(0),
+	"expiration": time.Duration(0),
+	"headers":    map[string]string(nil),
+	"metadata":   map[string]string(nil),
+}
@@ -0,0 +1,240 @@
+package amqp
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the AMQP port for unencrypted connections.
+const DefaultPort = 5672
+
+// DefaultTLSPort is the AMQP port for TLS connections.
+const DefaultTLSPort = 5671
+
+// Transport publishes messages as JSON to an AMQP 0-9-1 exchange (e.g., RabbitMQ).
+// Each Send opens a short-lived connection. Messages are persistent and wait for
+// a publisher confirm by default.
+type Transport struct {
+	*notifier.AbstractTransport
+	exchange           string
+	routingKey         string
+	username           string
+	password           string
+	vhost              string
+	persistent         bool
+	confirm            bool
+	mandatory          bool
+	useTLS             bool
+	insecureSkipVerify bool
+	now                func() time.Time
+}
+
+// NewTransport creates a new AMQP transport publishing to the given exchange and routing key.
+// Use an empty exchange to publish directly to the queue named by the routing key.
+func NewTransport(exchange, routingKey string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		exchange:          exchange,
+		routingKey:        routingKey,
+		username:          "guest",
+		password:          "guest",
+		vhost:             "/",
+		persistent:        true,
+		confirm:           true,
+		now:               time.Now,
+	}
+}
+
+// SetCredentials sets the user name and password, "guest" by default.
+func (t *Transport) SetCredentials(username, password string) *Transport {
+	t.username = username
+	t.password = password
+	return t
+}
+
+// SetVirtualHost sets the virtual host, "/" by default.
+func (t *Transport) SetVirtualHost(vhost string) *Transport {
+	t.vhost = vhost
+	return t
+}
+
+// SetPersistent sets whether messages are published with the persistent delivery mode.
+func (t *Transport) SetPersistent(persistent bool) *Transport {
+	t.persistent = persistent
+	return t
+}
+
+// SetConfirm sets whether Send waits for the broker to confirm the message.
+func (t *Transport) SetConfirm(confirm bool) *Transport {
+	t.confirm = confirm
+	return t
+}
+
+// SetMandatory makes Send fail when the message cannot be routed to any queue.
+// It requires publisher confirms.
+func (t *Transport) SetMandatory(mandatory bool) *Transport {
+	t.mandatory = mandatory
+	return t
+}
+
+// SetTLS enables TLS for the broker connection.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed brokers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	scheme := "amqp"
+	if t.useTLS {
+		scheme = "amqps"
+	}
+	return fmt.Sprintf("%s://%s?exchange=%s&routing_key=%s", scheme, t.getAddress(), t.exchange, t.routingKey)
+}
+
+// Supports returns true for all message types, they are published as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("amqp").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	exchange, routingKey, persistent, mandatory := t.exchange, t.routingKey, t.persistent, t.mandatory
+	if value, ok := options["exchange"].(string); ok {
+		exchange = value
+	}
+	if value, ok := options["routing_key"].(string); ok {
+		routingKey = value
+	}
+	if value, ok := options["persistent"].(bool); ok {
+		persistent = value
+	}
+	if value, ok := options["mandatory"].(bool); ok {
+		mandatory = value
+	}
+	if exchange == "" && routingKey == "" {
+		return nil, fmt.Errorf("amqp: missing exchange or routing key")
+	}
+	if mandatory && !t.confirm {
+		return nil, fmt.Errorf("amqp: mandatory publishing requires publisher confirms")
+	}
+
+	payload := notifier.NewPayload(message)
+	if metadata, ok := options["metadata"].(map[string]string); ok {
+		payload.Metadata = metadata
+	}
+	body, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("amqp: marshal payload: %w", err)
+	}
+
+	props := properties{
+		contentType:  "application/json",
+		deliveryMode: 1,
+		timestamp:    t.now(),
+		messageType:  payload.Type,
+		appID:        "go-notifier",
+	}
+	if persistent {
+		props.deliveryMode = 2
+	}
+	if value, ok := options["headers"].(map[string]string); ok {
+		props.headers = value
+	}
+	if value, ok := options["priority"].(byte); ok {
+		props.priority = value
+	}
+	if value, ok := options["expiration"].(time.Duration); ok {
+		props.expiration = strconv.FormatInt(value.Milliseconds(), 10)
+	}
+	if value, ok := options["correlation_id"].(string); ok {
+		props.correlationID = value
+	}
+	props.messageID, _ = options["message_id"].(string)
+	if props.messageID == "" {
+		props.messageID = newMessageID()
+	}
+
+	netConn, err := t.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("amqp: connect: %w", err)
+	}
+	stop := notifier.BindConnContext(ctx, netConn)
+	defer stop()
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn)}
+	defer func() { _ = c.close() }()
+
+	if err := c.open(t.username, t.password, t.vhost); err != nil {
+		return nil, fmt.Errorf("amqp: %w", err)
+	}
+	if t.confirm {
+		if err := c.confirmSelect(); err != nil {
+			return nil, fmt.Errorf("amqp: %w", err)
+		}
+	}
+
+	if err := c.publish(exchange, routingKey, mandatory, props, body); err != nil {
+		return nil, fmt.Errorf("amqp: publish: %w", err)
+	}
+	if t.confirm {
+		if err := c.waitConfirm(); err != nil {
+			return nil, fmt.Errorf("amqp: publish: %w", err)
+		}
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetMessageID(props.messageID)
+	sentMessage.SetInfo("exchange", exchange)
+	sentMessage.SetInfo("routing_key", routingKey)
+	sentMessage.SetInfo("confirmed", t.confirm)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) dial(ctx context.Context) (net.Conn, error) {
+	address := t.getAddress()
+	if t.useTLS {
+		host, _, _ := net.SplitHostPort(address)
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}}
+		return dialer.DialContext(ctx, "tcp", address)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", address)
+}
+
+func (t *Transport) getAddress() string {
+	endpoint := t.GetEndpoint()
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		port := DefaultPort
+		if t.useTLS {
+			port = DefaultTLSPort
+		}
+		return net.JoinHostPort(endpoint, strconv.Itoa(port))
+	}
+	return endpoint
+}
+
+func newMessageID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,114 @@
+package apns
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for APNs.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the device token.
+func (o *Options) Recipient(token string) *Options {
+	o.options["recipient_id"] = token
+	return o
+}
+
+// Topic overrides the topic (usually the app bundle ID) configured on the transport.
+func (o *Options) Topic(topic string) *Options {
+	o.options["topic"] = topic
+	return o
+}
+
+// Background sends a silent background push that wakes the app without alerting the user.
+func (o *Options) Background() *Options {
+	o.options["push_type"] = "background"
+	o.options["content_available"] = true
+	o.options["priority"] = 5
+	return o
+}
+
+// PushType sets the apns-push-type header (e.g., "alert", "background", "voip").
+func (o *Options) PushType(pushType string) *Options {
+	o.options["push_type"] = pushType
+	return o
+}
+
+// Priority sets the delivery priority: 10 for immediate, 5 for power considerate delivery.
+func (o *Options) Priority(priority int) *Options {
+	o.options["priority"] = priority
+	return o
+}
+
+// CollapseID coalesces notifications with the same ID into one on the device.
+func (o *Options) CollapseID(id string) *Options {
+	o.options["collapse_id"] = id
+	return o
+}
+
+// Expiration sets when APNs stops trying to deliver the notification.
+func (o *Options) Expiration(expiration time.Time) *Options {
+	o.options["expiration"] = expiration.Unix()
+	return o
+}
+
+// Badge sets the number displayed on the app icon.
+func (o *Options) Badge(badge int) *Options {
+	o.options["badge"] = badge
+	return o
+}
+
+// Sound sets the notification sound (e.g., "default").
+func (o *Options) Sound(sound string) *Options {
+	o.options["sound"] = sound
+	return o
+}
+
+// ThreadID groups notifications on the device.
+func (o *Options) ThreadID(id string) *Options {
+	o.options["thread_id"] = id
+	return o
+}
+
+// Category sets the notification category for actionable notifications.
+func (o *Options) Category(category string) *Options {
+	o.options["category"] = category
+	return o
+}
+
+// MutableContent allows a notification service extension to modify the notification.
+func (o *Options) MutableContent(mutable bool) *Options {
+	o.options["mutable_content"] = mutable
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"priority":   0,
+	"expiration": int64(0),
+	"badge":      0,
+}
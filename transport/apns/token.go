@@ -0,0 +1,81 @@
+package apns
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// tokenLifetime is how long a provider token is reused. Apple rejects tokens older
+// than one hour and throttles tokens refreshed more often than every 20 minutes.
+const tokenLifetime = 50 * time.Minute
+
+// ParsePrivateKey parses a PEM encoded .p8 signing key downloaded from the Apple developer portal.
+func ParsePrivateKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("apns: private key is not PEM encoded")
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("apns: parse private key: %w", err)
+	}
+	key, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("apns: private key is not an ECDSA key")
+	}
+	return key, nil
+}
+
+// providerToken creates and caches the ES256 signed JWT used for token-based authentication.
+type providerToken struct {
+	keyID  string
+	teamID string
+	key    *ecdsa.PrivateKey
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+func (p *providerToken) get(now time.Time) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.token != "" && now.Sub(p.issuedAt) < tokenLifetime {
+		return p.token, nil
+	}
+
+	headerJSON, err := json.Marshal(map[string]any{"alg": "ES256", "kid": p.keyID})
+	if err != nil {
+		return "", fmt.Errorf("apns: marshal jwt header: %w", err)
+	}
+	claimsJSON, err := json.Marshal(map[string]any{"iss": p.teamID, "iat": now.Unix()})
+	if err != nil {
+		return "", fmt.Errorf("apns: marshal jwt claims: %w", err)
+	}
+
+	unsigned := base64.RawURLEncoding.EncodeToString(headerJSON) + "." + base64.RawURLEncoding.EncodeToString(claimsJSON)
+	digest := sha256.Sum256([]byte(unsigned))
+	r, s, err := ecdsa.Sign(rand.Reader, p.key, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("apns: sign jwt: %w", err)
+	}
+
+	// JWS uses the fixed-size R || S encoding instead of ASN.1
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+
+	p.token = unsigned + "." + base64.RawURLEncoding.EncodeToString(signature)
+	p.issuedAt = now
+
+	return p.token, nil
+}
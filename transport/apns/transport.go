@@ -0,0 +1,184 @@
+package apns
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+const (
+	productionHost = "api.push.apple.com"
+	sandboxHost    = "api.sandbox.push.apple.com"
+)
+
+// Transport sends push notifications directly to Apple Push Notification service
+// using token-based authentication. Requests are sent over HTTP/2, which the
+// default http.Client negotiates automatically.
+type Transport struct {
+	*notifier.AbstractTransport
+	topic   string
+	sandbox bool
+	token   *providerToken
+}
+
+// NewTransport creates a new APNs transport.
+// topic is the bundle ID of the app receiving the notifications.
+func NewTransport(keyID, teamID string, key *ecdsa.PrivateKey, topic string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		topic:             topic,
+		token:             &providerToken{keyID: keyID, teamID: teamID, key: key},
+	}
+}
+
+// SetSandbox selects the development environment instead of production.
+func (t *Transport) SetSandbox(sandbox bool) *Transport {
+	t.sandbox = sandbox
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("apns://%s?topic=%s", t.getEndpoint(), t.topic)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.PushMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	pushMsg, ok := message.(*notifier.PushMessage)
+	if !ok {
+		return nil, fmt.Errorf("apns: unsupported message type %T, expected PushMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := pushMsg.GetOptions("apns").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	deviceToken := pushMsg.GetRecipientId()
+	if deviceToken == "" {
+		return nil, fmt.Errorf("apns: missing recipient device token")
+	}
+
+	aps := make(map[string]any)
+	alert := make(map[string]any)
+	if subject := pushMsg.GetSubject(); subject != "" {
+		alert["title"] = subject
+	}
+	if content := pushMsg.GetContent(); content != "" {
+		alert["body"] = content
+	}
+	if len(alert) > 0 {
+		aps["alert"] = alert
+	}
+	for key, apsKey := range map[string]string{
+		"badge":     "badge",
+		"sound":     "sound",
+		"thread_id": "thread-id",
+		"category":  "category",
+	} {
+		if value, ok := options[key]; ok {
+			aps[apsKey] = value
+		}
+	}
+	if available, _ := options["content_available"].(bool); available {
+		aps["content-available"] = 1
+	}
+	if mutable, _ := options["mutable_content"].(bool); mutable {
+		aps["mutable-content"] = 1
+	}
+
+	// Custom data is sent next to the aps dictionary
+	payload := map[string]any{"aps": aps}
+	for key, value := range pushMsg.GetData() {
+		payload[key] = value
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("apns: marshal payload: %w", err)
+	}
+
+	authToken, err := t.token.get(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("https://%s/3/device/%s", t.getEndpoint(), deviceToken)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("apns: create request: %w", err)
+	}
+
+	topic := t.topic
+	if override, ok := options["topic"].(string); ok && override != "" {
+		topic = override
+	}
+	pushType := "alert"
+	if override, ok := options["push_type"].(string); ok && override != "" {
+		pushType = override
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "bearer "+authToken)
+	req.Header.Set("apns-topic", topic)
+	req.Header.Set("apns-push-type", pushType)
+	if priority, ok := options["priority"].(int); ok {
+		req.Header.Set("apns-priority", strconv.Itoa(priority))
+	}
+	if collapseID, ok := options["collapse_id"].(string); ok && collapseID != "" {
+		req.Header.Set("apns-collapse-id", collapseID)
+	}
+	if expiration, ok := options["expiration"].(int64); ok {
+		req.Header.Set("apns-expiration", strconv.FormatInt(expiration, 10))
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("apns: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var result struct {
+			Reason string `json:"reason"`
+		}
+		if json.Unmarshal(respBody, &result) == nil && result.Reason != "" {
+			if resp.StatusCode == http.StatusTooManyRequests {
+				return nil, &notifier.RateLimitError{Transport: "apns", Message: result.Reason}
+			}
+			return nil, fmt.Errorf("apns: API error (status %d): %s", resp.StatusCode, result.Reason)
+		}
+		return nil, fmt.Errorf("apns: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetMessageID(resp.Header.Get("apns-id"))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		if t.sandbox {
+			return sandboxHost
+		}
+		return productionHost
+	}
+	return endpoint
+}
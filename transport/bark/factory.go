@@ -0,0 +1,81 @@
+package bark
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("bark", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Bark transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Bark transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Bark transport from a DSN.
+// DSN format: bark://<device_key>@<host>
+// Example: bark://NxHJzxG7FqYy3Ea5c9B2kL@default
+//
+// Use the host of a self-hosted bark-server instead of default to send through it.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "bark" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	deviceKey := dsn.GetUser()
+	if deviceKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing device key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(deviceKey, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"bark"}
+}
@@ -0,0 +1,109 @@
+package bark
+
+import (
+	"encoding/json"
+)
+
+// Interruption levels.
+const (
+	LevelActive        = "active"
+	LevelTimeSensitive = "timeSensitive"
+	LevelPassive       = "passive"
+	LevelCritical      = "critical"
+)
+
+// Options implements MessageOptionsInterface for Bark.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the device key, overriding the one from the DSN.
+func (o *Options) Recipient(deviceKey string) *Options {
+	o.options["recipient_id"] = deviceKey
+	return o
+}
+
+// Title sets the notification title.
+func (o *Options) Title(title string) *Options {
+	o.options["title"] = title
+	return o
+}
+
+// Group groups notifications in the notification center and the app history.
+func (o *Options) Group(group string) *Options {
+	o.options["group"] = group
+	return o
+}
+
+// Icon sets the URL of a custom notification icon.
+func (o *Options) Icon(url string) *Options {
+	o.options["icon"] = url
+	return o
+}
+
+// Sound sets the notification sound (e.g., "alarm", "bell", "minuet").
+func (o *Options) Sound(sound string) *Options {
+	o.options["sound"] = sound
+	return o
+}
+
+// URL sets the URL opened when the notification is tapped.
+func (o *Options) URL(url string) *Options {
+	o.options["url"] = url
+	return o
+}
+
+// Level sets the interruption level (LevelActive, LevelTimeSensitive, LevelPassive or LevelCritical).
+func (o *Options) Level(level string) *Options {
+	o.options["level"] = level
+	return o
+}
+
+// Badge sets the app icon badge number.
+func (o *Options) Badge(badge int) *Options {
+	o.options["badge"] = badge
+	return o
+}
+
+// Copy sets the text copied when the notification is copied, instead of the body.
+func (o *Options) Copy(text string) *Options {
+	o.options["copy"] = text
+	return o
+}
+
+// Archive controls whether the notification is saved in the app history.
+func (o *Options) Archive(archive bool) *Options {
+	if archive {
+		o.options["isArchive"] = 1
+	} else {
+		o.options["isArchive"] = 0
+	}
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"badge": 0,
+}
@@ -0,0 +1,122 @@
+package bark
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends notifications to the Bark iOS app.
+type Transport struct {
+	*notifier.AbstractTransport
+	deviceKey string
+}
+
+// NewTransport creates a new Bark transport.
+func NewTransport(deviceKey string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		deviceKey:         deviceKey,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("bark://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	switch message.(type) {
+	case *notifier.ChatMessage, *notifier.PushMessage:
+		return true
+	}
+	return false
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	payload := make(map[string]any)
+
+	switch msg := message.(type) {
+	case *notifier.ChatMessage:
+		payload["body"] = msg.GetSubject()
+		if content := msg.GetContent(); content != "" {
+			payload["title"] = msg.GetSubject()
+			payload["body"] = content
+		}
+	case *notifier.PushMessage:
+		if subject := msg.GetSubject(); subject != "" {
+			payload["title"] = subject
+		}
+		payload["body"] = msg.GetContent()
+	default:
+		return nil, fmt.Errorf("bark: unsupported message type %T, expected ChatMessage or PushMessage", message)
+	}
+
+	deviceKey := t.deviceKey
+	if opts, ok := message.GetOptions("bark").(*Options); ok {
+		for key, value := range opts.ToMap() {
+			if key == "recipient_id" {
+				deviceKey = value.(string)
+				continue
+			}
+			payload[key] = value
+		}
+	}
+	if deviceKey == "" {
+		return nil, fmt.Errorf("bark: missing device key")
+	}
+	payload["device_key"] = deviceKey
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("bark: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/push", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("bark: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("bark: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("bark: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("bark: decode response: %w", err)
+	}
+
+	if result.Code != http.StatusOK {
+		return nil, fmt.Errorf("bark: API error (code %d): %s", result.Code, result.Message)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.day.app"
+	}
+	return endpoint
+}
@@ -0,0 +1,187 @@
+package bark
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSendChatMessage(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://api.day.app/push" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(200, `{"code":200,"message":"success","timestamp":1700000000}`), nil
+	})
+
+	transport := NewTransport("device-key", client)
+
+	msg := notifier.NewChatMessage("Backup completed").
+		WithOptions("bark", NewOptions().
+			Title("Server").
+			Group("backups").
+			Icon("https://example.com/icon.png").
+			Sound("minuet").
+			URL("https://example.com/backups").
+			Level(LevelTimeSensitive).
+			Badge(1).
+			Archive(false))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sentMsg.GetTransport() != "bark://api.day.app" {
+		t.Errorf("Unexpected transport: %s", sentMsg.GetTransport())
+	}
+
+	expected := map[string]any{
+		"device_key": "device-key",
+		"body":       "Backup completed",
+		"title":      "Server",
+		"group":      "backups",
+		"icon":       "https://example.com/icon.png",
+		"sound":      "minuet",
+		"url":        "https://example.com/backups",
+		"level":      "timeSensitive",
+		"badge":      float64(1),
+		"isArchive":  float64(0),
+	}
+	for key, value := range expected {
+		if capturedBody[key] != value {
+			t.Errorf("Expected %s '%v', got '%v'", key, value, capturedBody[key])
+		}
+	}
+}
+
+func TestTransportSendPushMessage(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Host != "bark.example.com" {
+			t.Errorf("Unexpected host: %s", req.URL.Host)
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(200, `{"code":200,"message":"success"}`), nil
+	})
+
+	transport := NewTransport("device-key", client)
+	transport.SetHost("bark.example.com")
+
+	msg := notifier.NewPushMessage("Order shipped", "Your order is on its way").
+		WithOptions("bark", NewOptions().Recipient("other-device"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedBody["title"] != "Order shipped" || capturedBody["body"] != "Your order is on its way" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if capturedBody["device_key"] != "other-device" {
+		t.Errorf("Expected device key 'other-device', got '%v'", capturedBody["device_key"])
+	}
+	if _, ok := capturedBody["recipient_id"]; ok {
+		t.Error("Expected recipient_id not to be sent")
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(400, `{"code":400,"message":"failed to get device token: failed to get [device-key] device token from database"}`), nil
+	})
+
+	transport := NewTransport("device-key", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 400)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendErrorCode(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(200, `{"code":500,"message":"push failed"}`), nil
+	})
+
+	transport := NewTransport("device-key", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "API error (code 500): push failed") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportUnsupportedMessage(t *testing.T) {
+	transport := NewTransport("device-key", nil)
+
+	if transport.Supports(notifier.NewSmsMessage("+15551234567", "Hello")) {
+		t.Error("Expected SmsMessage not to be supported")
+	}
+
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("+15551234567", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported message type") {
+		t.Errorf("Expected unsupported message error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	for dsnString, expected := range map[string]string{
+		"bark://device-key@default":               "bark://api.day.app",
+		"bark://device-key@bark.example.com:8080": "bark://bark.example.com:8080",
+	} {
+		dsn, _ := notifier.NewDSN(dsnString)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", dsnString)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport: %v", err)
+		}
+		if transport.String() != expected {
+			t.Errorf("Expected '%s', got '%s'", expected, transport.String())
+		}
+	}
+
+	dsn, _ := notifier.NewDSN("bark://default")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Expected error for missing device key")
+	}
+}
@@ -0,0 +1,56 @@
+package bluesky
+
+import (
+	"context"
+	"regexp"
+	"sort"
+)
+
+// Patterns from the AT Protocol rich text documentation. Go has no lookbehind, the first
+// group matches the character before the mention or link.
+var (
+	mentionPattern = regexp.MustCompile(`(?:^|\W)(@(?:[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)`)
+	linkPattern    = regexp.MustCompile(`(?:^|\W)(https?://(?:www\.)?[-a-zA-Z0-9@:%._+~#=]{1,256}\.[a-zA-Z0-9()]{1,6}\b(?:[-a-zA-Z0-9()@:%_+.~#?&/=]*[-a-zA-Z0-9@%_+~#/=])?)`)
+)
+
+// detectFacets returns the link and mention facets of the text. Facets address the text by
+// UTF-8 byte offsets. Mentions of handles that cannot be resolved are left as plain text.
+func (t *Transport) detectFacets(ctx context.Context, text string) []map[string]any {
+	facets := make([]map[string]any, 0)
+
+	for _, match := range mentionPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := match[2], match[3]
+		did, err := t.resolveHandle(ctx, text[start+1:end])
+		if err != nil {
+			continue
+		}
+		facets = append(facets, facet(start, end, map[string]any{
+			"$type": "app.bsky.richtext.facet#mention",
+			"did":   did,
+		}))
+	}
+
+	for _, match := range linkPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := match[2], match[3]
+		facets = append(facets, facet(start, end, map[string]any{
+			"$type": "app.bsky.richtext.facet#link",
+			"uri":   text[start:end],
+		}))
+	}
+
+	sort.SliceStable(facets, func(i, j int) bool {
+		return facetStart(facets[i]) < facetStart(facets[j])
+	})
+	return facets
+}
+
+func facetStart(facet map[string]any) int {
+	return facet["index"].(map[string]any)["byteStart"].(int)
+}
+
+func facet(start, end int, feature map[string]any) map[string]any {
+	return map[string]any{
+		"index":    map[string]any{"byteStart": start, "byteEnd": end},
+		"features": []map[string]any{feature},
+	}
+}
@@ -0,0 +1,58 @@
+package bluesky
+
+import (
+	"encoding/json"
+)
+
+// Image is a local image file embedded in a post.
+type Image struct {
+	Path string
+	Alt  string
+}
+
+// Options implements MessageOptionsInterface for Bluesky.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Image uploads a local image file and embeds it in the post. The alt text describes the
+// image for screen readers. At most 4 images can be embedded.
+func (o *Options) Image(path, alt string) *Options {
+	images, _ := o.options["images"].([]Image)
+	o.options["images"] = append(images, Image{Path: path, Alt: alt})
+	return o
+}
+
+// Languages sets the BCP 47 language codes of the post (e.g., "en").
+func (o *Options) Languages(languages ...string) *Options {
+	o.options["langs"] = languages
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"images": []Image(nil),
+	"langs":  []string(nil),
+}
@@ -0,0 +1,311 @@
+package bluesky
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// maxImages is the maximum number of images embedded in a post.
+const maxImages = 4
+
+// Transport creates posts on Bluesky, or another AT Protocol service, authenticating with an
+// app password. The session is reused until its access token expires.
+type Transport struct {
+	*notifier.AbstractTransport
+	identifier string
+	password   string
+
+	mu        sync.Mutex
+	accessJwt string
+	did       string
+	expiresAt time.Time
+}
+
+// NewTransport creates a new Bluesky transport logging in with the handle (or DID) and app password.
+func NewTransport(identifier, password string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		identifier:        identifier,
+		password:          password,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("bluesky://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("bluesky: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("bluesky").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	images, _ := options["images"].([]Image)
+	if len(images) > maxImages {
+		return nil, fmt.Errorf("bluesky: too many images (%d), at most %d are allowed", len(images), maxImages)
+	}
+
+	text := chatMsg.GetText()
+	if text == "" && len(images) == 0 {
+		return nil, fmt.Errorf("bluesky: message has no content")
+	}
+
+	accessJwt, did, err := t.session(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := map[string]any{
+		"$type":     "app.bsky.feed.post",
+		"text":      text,
+		"createdAt": time.Now().UTC().Format(time.RFC3339Nano),
+	}
+	if facets := t.detectFacets(ctx, text); len(facets) > 0 {
+		record["facets"] = facets
+	}
+	if langs, ok := options["langs"].([]string); ok && len(langs) > 0 {
+		record["langs"] = langs
+	}
+
+	if len(images) > 0 {
+		embedded := make([]map[string]any, 0, len(images))
+		for _, image := range images {
+			blob, err := t.uploadBlob(ctx, accessJwt, image.Path)
+			if err != nil {
+				return nil, err
+			}
+			embedded = append(embedded, map[string]any{"alt": image.Alt, "image": blob})
+		}
+		record["embed"] = map[string]any{
+			"$type":  "app.bsky.embed.images",
+			"images": embedded,
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(map[string]any{
+		"repo":       did,
+		"collection": "app.bsky.feed.post",
+		"record":     record,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("bluesky: marshal options: %w", err)
+	}
+
+	var result struct {
+		URI string `json:"uri"`
+		CID string `json:"cid"`
+	}
+
+	raw, err := t.call(ctx, "POST", "com.atproto.repo.createRecord", nil, bytes.NewReader(jsonBody), "application/json", accessJwt, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.URI)
+	sentMessage.SetInfo("cid", result.CID)
+	// at://<did>/app.bsky.feed.post/<rkey>
+	if rkey := result.URI[strings.LastIndex(result.URI, "/")+1:]; rkey != "" {
+		sentMessage.SetInfo("url", fmt.Sprintf("https://bsky.app/profile/%s/post/%s", did, rkey))
+	}
+
+	return sentMessage, nil
+}
+
+// session returns the cached access token and DID, creating a new session when expired.
+func (t *Transport) session(ctx context.Context) (string, string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessJwt != "" && time.Now().Before(t.expiresAt) {
+		return t.accessJwt, t.did, nil
+	}
+
+	jsonBody, err := json.Marshal(map[string]string{
+		"identifier": t.identifier,
+		"password":   t.password,
+	})
+	if err != nil {
+		return "", "", fmt.Errorf("bluesky: marshal session: %w", err)
+	}
+
+	var result struct {
+		AccessJwt string `json:"accessJwt"`
+		DID       string `json:"did"`
+	}
+	if _, err := t.call(ctx, "POST", "com.atproto.server.createSession", nil, bytes.NewReader(jsonBody), "application/json", "", &result); err != nil {
+		return "", "", err
+	}
+
+	t.accessJwt, t.did = result.AccessJwt, result.DID
+	// Refresh a minute early to avoid using a token that expires in flight
+	t.expiresAt = tokenExpiry(result.AccessJwt).Add(-time.Minute)
+
+	return t.accessJwt, t.did, nil
+}
+
+// resetSession discards the cached session, e.g. after the token was revoked.
+func (t *Transport) resetSession() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.accessJwt = ""
+}
+
+// resolveHandle returns the DID of the handle.
+func (t *Transport) resolveHandle(ctx context.Context, handle string) (string, error) {
+	var result struct {
+		DID string `json:"did"`
+	}
+	query := url.Values{"handle": {handle}}
+	if _, err := t.call(ctx, "GET", "com.atproto.identity.resolveHandle", query, nil, "", "", &result); err != nil {
+		return "", err
+	}
+	return result.DID, nil
+}
+
+// uploadBlob uploads the image and returns the blob reference to embed.
+func (t *Transport) uploadBlob(ctx context.Context, accessJwt, path string) (json.RawMessage, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("bluesky: read image: %w", err)
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = http.DetectContentType(data)
+	}
+
+	var result struct {
+		Blob json.RawMessage `json:"blob"`
+	}
+	if _, err := t.call(ctx, "POST", "com.atproto.repo.uploadBlob", nil, bytes.NewReader(data), contentType, accessJwt, &result); err != nil {
+		return nil, err
+	}
+	return result.Blob, nil
+}
+
+// call sends an XRPC request and decodes the response into result.
+func (t *Transport) call(ctx context.Context, method, nsid string, query url.Values, body io.Reader, contentType, accessJwt string, result any) (any, error) {
+	endpoint := fmt.Sprintf("https://%s/xrpc/%s", t.getEndpoint(), nsid)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("bluesky: create request: %w", err)
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if accessJwt != "" {
+		req.Header.Set("Authorization", "Bearer "+accessJwt)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("bluesky: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Error   string `json:"error"`
+			Message string `json:"message"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Error != "" {
+			errMessage = apiResp.Error
+			if apiResp.Message != "" {
+				errMessage += ": " + apiResp.Message
+			}
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "bluesky",
+				RetryAfter: retryAfter(resp.Header.Get("Ratelimit-Reset")),
+				Message:    errMessage,
+			}
+		}
+		if accessJwt != "" && (apiResp.Error == "ExpiredToken" || apiResp.Error == "InvalidToken") {
+			t.resetSession()
+		}
+		return nil, fmt.Errorf("bluesky: %s: API error (status %d): %s", nsid, resp.StatusCode, errMessage)
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, result)
+	if err != nil {
+		return nil, fmt.Errorf("bluesky: decode response: %w", err)
+	}
+	return raw, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "bsky.social"
+	}
+	return endpoint
+}
+
+// tokenExpiry returns the expiry of the JWT, or the current time if it cannot be read.
+func tokenExpiry(jwt string) time.Time {
+	parts := strings.Split(jwt, ".")
+	if len(parts) != 3 {
+		return time.Now()
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return time.Now()
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Exp == 0 {
+		return time.Now()
+	}
+	return time.Unix(claims.Exp, 0)
+}
+
+// retryAfter returns the time until the rate limit resets at the given unix time.
+func retryAfter(reset string) time.Duration {
+	seconds, err := strconv.ParseInt(reset, 10, 64)
+	if err != nil {
+		return 0
+	}
+	if wait := time.Until(time.Unix(seconds, 0)); wait > 0 {
+		return wait
+	}
+	return 0
+}
@@ -0,0 +1,56 @@
+package clicksend
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for ClickSend.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Schedule delays delivery until the given time.
+func (o *Options) Schedule(at time.Time) *Options {
+	o.options["schedule"] = at.Unix()
+	return o
+}
+
+// CustomString sets a reference that is returned in delivery reports.
+func (o *Options) CustomString(value string) *Options {
+	o.options["custom_string"] = value
+	return o
+}
+
+// Country sets the recipient country (ISO alpha-2) for numbers in local format.
+func (o *Options) Country(code string) *Options {
+	o.options["country"] = code
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"schedule": int64(0),
+}
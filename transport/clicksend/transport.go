@@ -0,0 +1,143 @@
+package clicksend
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends SMS via the ClickSend REST API.
+type Transport struct {
+	*notifier.AbstractTransport
+	username string
+	apiKey   string
+	from     string
+}
+
+// NewTransport creates a new ClickSend transport. from is the default sender ID;
+// when empty, ClickSend uses a shared number.
+func NewTransport(username, apiKey, from string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		username:          username,
+		apiKey:            apiKey,
+		from:              from,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.from == "" {
+		return fmt.Sprintf("clicksend://%s", t.getEndpoint())
+	}
+	return fmt.Sprintf("clicksend://%s?from=%s", t.getEndpoint(), t.from)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.SmsMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	smsMsg, ok := message.(*notifier.SmsMessage)
+	if !ok {
+		return nil, fmt.Errorf("clicksend: unsupported message type %T, expected SmsMessage", message)
+	}
+
+	sms := make(map[string]any)
+	if opts, ok := smsMsg.GetOptions("clicksend").(*Options); ok {
+		for key, value := range opts.ToMap() {
+			sms[key] = value
+		}
+	}
+
+	from := smsMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+	if from != "" {
+		sms["from"] = from
+	}
+	sms["source"] = "go-notifier"
+	sms["to"] = smsMsg.GetPhone()
+	sms["body"] = smsMsg.GetSubject()
+
+	jsonBody, err := notifier.JSONMarshal(map[string]any{"messages": []any{sms}})
+	if err != nil {
+		return nil, fmt.Errorf("clicksend: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v3/sms/send", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("clicksend: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(t.username, t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("clicksend: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "clicksend",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("clicksend: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ResponseCode string `json:"response_code"`
+		ResponseMsg  string `json:"response_msg"`
+		Data         struct {
+			TotalPrice float64 `json:"total_price"`
+			Messages   []struct {
+				MessageID string `json:"message_id"`
+				Status    string `json:"status"`
+			} `json:"messages"`
+		} `json:"data"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("clicksend: decode response: %w", err)
+	}
+
+	if len(result.Data.Messages) == 0 {
+		return nil, fmt.Errorf("clicksend: API error (%s): %s", result.ResponseCode, result.ResponseMsg)
+	}
+
+	// The request succeeds as a whole even if the message itself was rejected
+	sent := result.Data.Messages[0]
+	if sent.Status != "SUCCESS" {
+		return nil, fmt.Errorf("clicksend: message rejected: %s", sent.Status)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(sent.MessageID)
+	sentMessage.SetInfo("total_price", result.Data.TotalPrice)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "rest.clicksend.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,82 @@
+package datadogevents
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("datadogevents", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Datadog Events transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Datadog Events transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Datadog Events transport from a DSN.
+// DSN format: datadogevents://<api_key>@default[?site=<site>][&tags=<tag1,tag2>]
+// Example: datadogevents://API_KEY@default?site=datadoghq.eu&tags=env:production,team:ops
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "datadogevents" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	apiKey := dsn.GetUser()
+	if apiKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing API key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(apiKey, dsn.GetOption("site", DefaultSite), f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+	if tags := dsn.GetOption("tags"); tags != "" {
+		transport.SetTags(strings.Split(tags, ",")...)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"datadogevents"}
+}
@@ -0,0 +1,87 @@
+package datadogevents
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Datadog Events.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Title sets the event title, which defaults to the message subject.
+func (o *Options) Title(title string) *Options {
+	o.options["title"] = title
+	return o
+}
+
+// Text sets the event body, which supports Markdown when starting with "%%% \n" and ending with "\n %%%".
+func (o *Options) Text(text string) *Options {
+	o.options["text"] = text
+	return o
+}
+
+// Tags adds tags to the event, next to the configured tags.
+func (o *Options) Tags(tags ...string) *Options {
+	existing, _ := o.options["tags"].([]string)
+	o.options["tags"] = append(existing, tags...)
+	return o
+}
+
+// AlertType sets the alert type ("error", "warning", "info", "success", "user_update",
+// "recommendation" or "snapshot") instead of deriving it from the message importance.
+func (o *Options) AlertType(alertType string) *Options {
+	o.options["alert_type"] = alertType
+	return o
+}
+
+// Priority sets the event priority ("normal" or "low").
+func (o *Options) Priority(priority string) *Options {
+	o.options["priority"] = priority
+	return o
+}
+
+// AggregationKey groups events with the same key in the event stream.
+func (o *Options) AggregationKey(key string) *Options {
+	o.options["aggregation_key"] = key
+	return o
+}
+
+// SourceType sets the source type name of the event (e.g., "jenkins").
+func (o *Options) SourceType(source string) *Options {
+	o.options["source_type_name"] = source
+	return o
+}
+
+// Host sets the host name the event is associated with.
+func (o *Options) Host(host string) *Options {
+	o.options["host"] = host
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"tags": []string(nil),
+}
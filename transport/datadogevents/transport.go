@@ -0,0 +1,193 @@
+package datadogevents
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultSite is the Datadog site used when none is configured.
+const DefaultSite = "datadoghq.com"
+
+// DefaultAlertTypeMapping translates message importance into Datadog alert types.
+var DefaultAlertTypeMapping = map[string]string{
+	notifier.ImportanceUrgent: "error",
+	notifier.ImportanceHigh:   "warning",
+	notifier.ImportanceMedium: "info",
+	notifier.ImportanceLow:    "info",
+}
+
+// Transport posts messages as events to the Datadog Events API.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey           string
+	site             string
+	tags             []string
+	alertTypeMapping map[string]string
+}
+
+// NewTransport creates a new Datadog Events transport for the site (e.g., "datadoghq.eu").
+func NewTransport(apiKey, site string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if site == "" {
+		site = DefaultSite
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		site:              site,
+		alertTypeMapping:  DefaultAlertTypeMapping,
+	}
+}
+
+// SetTags sets tags added to every event (e.g., "env:production").
+func (t *Transport) SetTags(tags ...string) *Transport {
+	t.tags = tags
+	return t
+}
+
+// SetAlertTypeMapping overrides the importance to alert type mapping.
+func (t *Transport) SetAlertTypeMapping(mapping map[string]string) *Transport {
+	t.alertTypeMapping = mapping
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("datadogevents://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("datadogevents: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("datadogevents").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	event := map[string]any{
+		"title": truncate(chatMsg.GetSubject(), 100),
+		"text":  chatMsg.GetSubject(),
+	}
+	if content := chatMsg.GetContent(); content != "" {
+		event["text"] = truncate(content, 4000)
+	}
+	if text, ok := options["text"].(string); ok && text != "" {
+		event["text"] = truncate(text, 4000)
+	}
+	if title, ok := options["title"].(string); ok && title != "" {
+		event["title"] = truncate(title, 100)
+	}
+
+	tags := append([]string{}, t.tags...)
+	if value, ok := options["tags"].([]string); ok {
+		tags = append(tags, value...)
+	}
+	if len(tags) > 0 {
+		event["tags"] = tags
+	}
+
+	// An explicit alert type option takes precedence over the message importance
+	if alertType, ok := options["alert_type"].(string); ok {
+		event["alert_type"] = alertType
+	} else if alertType, ok := t.alertTypeMapping[chatMsg.GetImportance()]; ok {
+		event["alert_type"] = alertType
+	}
+	if chatMsg.GetImportance() == notifier.ImportanceLow {
+		event["priority"] = "low"
+	}
+
+	for _, key := range []string{"aggregation_key", "source_type_name", "host", "priority"} {
+		if value, ok := options[key].(string); ok && value != "" {
+			event[key] = value
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("datadogevents: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/api/v1/events", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("datadogevents: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("DD-API-KEY", t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("datadogevents: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// X-RateLimit-Reset holds the seconds until the rate limit window resets
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "datadogevents",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("X-RateLimit-Reset")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("datadogevents: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Status string `json:"status"`
+		Event  struct {
+			ID  int64  `json:"id"`
+			URL string `json:"url"`
+		} `json:"event"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("datadogevents: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(strconv.FormatInt(result.Event.ID, 10))
+	if result.Event.URL != "" {
+		sentMessage.SetInfo("url", result.Event.URL)
+	}
+	sentMessage.SetInfo("alert_type", event["alert_type"])
+
+	return sentMessage, nil
+}
+
+// truncate shortens text to the limit of the Events API, counted in characters.
+func truncate(text string, limit int) string {
+	if runes := []rune(text); len(runes) > limit {
+		return string(runes[:limit-1]) + "…"
+	}
+	return text
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api." + t.site
+	}
+	return endpoint
+}
@@ -0,0 +1,193 @@
+package datadogevents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://api.datadoghq.eu/api/v1/events" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("DD-API-KEY") != "api-key" {
+			t.Errorf("Unexpected DD-API-KEY header: %s", req.Header.Get("DD-API-KEY"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(202, `{"status":"ok","event":{"id":7413520349,"url":"https://app.datadoghq.eu/event/event?id=7413520349"}}`), nil
+	})
+
+	transport := NewTransport("api-key", "datadoghq.eu", client).SetTags("env:production")
+
+	msg := notifier.NewChatMessage("Deploy failed").
+		Importance(notifier.ImportanceUrgent).
+		WithOptions("datadogevents", NewOptions().
+			Text("Rollback started").
+			Tags("service:api").
+			AggregationKey("deploy-api"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "7413520349" || sentMsg.GetInfo("url") != "https://app.datadoghq.eu/event/event?id=7413520349" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	if capturedBody["title"] != "Deploy failed" || capturedBody["text"] != "Rollback started" || capturedBody["alert_type"] != "error" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if capturedBody["aggregation_key"] != "deploy-api" {
+		t.Errorf("Unexpected aggregation key: %v", capturedBody["aggregation_key"])
+	}
+	if tags, _ := capturedBody["tags"].([]any); len(tags) != 2 || tags[0] != "env:production" || tags[1] != "service:api" {
+		t.Errorf("Unexpected tags: %v", capturedBody["tags"])
+	}
+	if _, ok := capturedBody["priority"]; ok {
+		t.Errorf("Expected no priority, got %v", capturedBody["priority"])
+	}
+}
+
+func TestTransportSendImportance(t *testing.T) {
+	tests := []struct {
+		importance string
+		options    *Options
+		alertType  string
+		priority   any
+	}{
+		{notifier.ImportanceHigh, nil, "warning", nil},
+		{notifier.ImportanceMedium, nil, "info", nil},
+		{notifier.ImportanceLow, nil, "info", "low"},
+		{notifier.ImportanceLow, NewOptions().AlertType("success").Priority("normal"), "success", "normal"},
+	}
+
+	for _, tt := range tests {
+		var capturedBody map[string]any
+		client := newMockClient(func(req *http.Request) (*http.Response, error) {
+			_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+			return newResponse(202, `{"status":"ok","event":{"id":1}}`), nil
+		})
+
+		msg := notifier.NewChatMessage("Hello").Importance(tt.importance)
+		if tt.options != nil {
+			msg.WithOptions("datadogevents", tt.options)
+		}
+
+		if _, err := NewTransport("api-key", "", client).Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if capturedBody["alert_type"] != tt.alertType || capturedBody["priority"] != tt.priority {
+			t.Errorf("Unexpected alert type or priority for %s: %v", tt.importance, capturedBody)
+		}
+	}
+}
+
+func TestTransportSendTruncatesTitle(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(202, `{"status":"ok","event":{"id":1}}`), nil
+	})
+
+	if _, err := NewTransport("api-key", "", client).Send(context.Background(), notifier.NewChatMessage(strings.Repeat("é", 150))); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if title, _ := capturedBody["title"].(string); len([]rune(title)) != 100 {
+		t.Errorf("Expected title of 100 characters, got %d", len([]rune(title)))
+	}
+	if text, _ := capturedBody["text"].(string); len([]rune(text)) != 150 {
+		t.Errorf("Expected full text, got %d characters", len([]rune(text)))
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(403, `{"errors":["Forbidden"]}`), nil
+	})
+
+	_, err := NewTransport("wrong", "", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "datadogevents: API error (status 403)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(429, `{"errors":["Too many requests"]}`)
+		resp.Header.Set("X-RateLimit-Reset", "12")
+		return resp, nil
+	})
+
+	_, err = NewTransport("api-key", "", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter.Seconds() != 12 {
+		t.Errorf("Expected RateLimitError with retry after, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	tests := []struct {
+		dsn      string
+		expected string
+		tags     int
+	}{
+		{"datadogevents://api-key@default", "datadogevents://api.datadoghq.com", 0},
+		{"datadogevents://api-key@default?site=us5.datadoghq.com&tags=env:production,team:ops", "datadogevents://api.us5.datadoghq.com", 2},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+
+		if transport.String() != tt.expected {
+			t.Errorf("Expected string '%s', got '%s'", tt.expected, transport.String())
+		}
+		if len(transport.(*Transport).tags) != tt.tags {
+			t.Errorf("Unexpected tags for %s: %v", tt.dsn, transport.(*Transport).tags)
+		}
+	}
+
+	dsn, _ := notifier.NewDSN("datadogevents://default")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Expected error for missing API key")
+	}
+}
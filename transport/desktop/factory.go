@@ -0,0 +1,59 @@
+package desktop
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory())
+	notifier.RegisterOptionsDecoder("desktop", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates desktop notification transports from DSN.
+type TransportFactory struct{}
+
+// NewTransportFactory creates a new desktop notification transport factory.
+func NewTransportFactory() *TransportFactory {
+	return &TransportFactory{}
+}
+
+// Create creates a desktop notification transport from a DSN.
+// DSN format: desktop://default[?app_name=<name>][&icon=<icon>]
+// Example: desktop://default?app_name=backup&icon=dialog-information
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "desktop" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	if host := dsn.GetHost(); host != "default" && host != "localhost" {
+		return nil, fmt.Errorf("invalid DSN: Desktop notifications are local, use \"default\" as host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(dsn.GetOption("app_name", DefaultAppName))
+	transport.SetIcon(dsn.GetOption("icon"))
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"desktop"}
+}
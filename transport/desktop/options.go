@@ -0,0 +1,69 @@
+package desktop
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for desktop notifications.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Title sets the notification title, which defaults to the application name.
+func (o *Options) Title(title string) *Options {
+	o.options["title"] = title
+	return o
+}
+
+// Subtitle sets the notification subtitle (macOS only).
+func (o *Options) Subtitle(subtitle string) *Options {
+	o.options["subtitle"] = subtitle
+	return o
+}
+
+// Sound plays the named sound (macOS only, e.g., "Glass"). Urgent and high importance
+// messages play the default sound.
+func (o *Options) Sound(sound string) *Options {
+	o.options["sound"] = sound
+	return o
+}
+
+// Icon sets the icon name or path instead of the configured one (notify-send only).
+func (o *Options) Icon(icon string) *Options {
+	o.options["icon"] = icon
+	return o
+}
+
+// Timeout sets how long the notification is shown (notify-send only, honored by some notification servers).
+func (o *Options) Timeout(timeout time.Duration) *Options {
+	o.options["timeout"] = int(timeout.Milliseconds())
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"timeout": 0,
+}
@@ -0,0 +1,179 @@
+package desktop
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultAppName is the application name shown with notifications when none is configured.
+const DefaultAppName = "go-notifier"
+
+// DefaultUrgencyMapping translates message importance into notify-send urgency levels.
+var DefaultUrgencyMapping = map[string]string{
+	notifier.ImportanceUrgent: "critical",
+	notifier.ImportanceHigh:   "normal",
+	notifier.ImportanceMedium: "normal",
+	notifier.ImportanceLow:    "low",
+}
+
+// Transport shows messages as notifications on the local desktop using notify-send on Linux and BSD,
+// osascript on macOS and a PowerShell toast on Windows.
+type Transport struct {
+	*notifier.AbstractTransport
+	appName        string
+	icon           string
+	urgencyMapping map[string]string
+	goos           string
+	run            func(ctx context.Context, name string, args ...string) error
+}
+
+// NewTransport creates a new desktop notification transport for the current operating system.
+func NewTransport(appName string) *Transport {
+	if appName == "" {
+		appName = DefaultAppName
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		appName:           appName,
+		urgencyMapping:    DefaultUrgencyMapping,
+		goos:              runtime.GOOS,
+		run:               runCommand,
+	}
+}
+
+// SetIcon sets the icon name or path of notifications (notify-send only).
+func (t *Transport) SetIcon(icon string) *Transport {
+	t.icon = icon
+	return t
+}
+
+// SetUrgencyMapping overrides the importance to notify-send urgency mapping.
+func (t *Transport) SetUrgencyMapping(mapping map[string]string) *Transport {
+	t.urgencyMapping = mapping
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("desktop://default?app_name=%s", t.appName)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("desktop: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("desktop").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	title, body := t.appName, chatMsg.GetSubject()
+	if value, ok := options["title"].(string); ok && value != "" {
+		title, body = value, chatMsg.GetText()
+	} else if content := chatMsg.GetContent(); content != "" {
+		title, body = chatMsg.GetSubject(), content
+	}
+	importance := chatMsg.GetImportance()
+
+	var name string
+	var args []string
+	switch t.goos {
+	case "darwin":
+		script := fmt.Sprintf("display notification %s with title %s", appleScriptString(body), appleScriptString(title))
+		if subtitle, ok := options["subtitle"].(string); ok && subtitle != "" {
+			script += " subtitle " + appleScriptString(subtitle)
+		}
+		if sound, ok := options["sound"].(string); ok && sound != "" {
+			script += " sound name " + appleScriptString(sound)
+		} else if importance == notifier.ImportanceUrgent || importance == notifier.ImportanceHigh {
+			script += ` sound name "default"`
+		}
+		name, args = "osascript", []string{"-e", script}
+	case "windows":
+		name, args = "powershell", []string{"-NoProfile", "-NonInteractive", "-Command", toastScript(t.appName, title, body)}
+	default:
+		args = []string{"--app-name", t.appName}
+		if urgency, ok := t.urgencyMapping[importance]; ok {
+			args = append(args, "--urgency", urgency)
+		}
+		icon := t.icon
+		if value, ok := options["icon"].(string); ok && value != "" {
+			icon = value
+		}
+		if icon != "" {
+			args = append(args, "--icon", icon)
+		}
+		if timeout, ok := options["timeout"].(int); ok {
+			args = append(args, "--expire-time", fmt.Sprintf("%d", timeout))
+		}
+		// "--" keeps subjects starting with a dash from being parsed as flags
+		name, args = "notify-send", append(args, "--", title, body)
+	}
+
+	if err := t.run(ctx, name, args...); err != nil {
+		return nil, fmt.Errorf("desktop: %s: %w", name, err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("command", name)
+
+	return sentMessage, nil
+}
+
+// runCommand runs the command, including its error output in the returned error.
+func runCommand(ctx context.Context, name string, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if output := strings.TrimSpace(stderr.String()); output != "" {
+			return fmt.Errorf("%w: %s", err, output)
+		}
+		return err
+	}
+	return nil
+}
+
+// appleScriptString quotes text as an AppleScript string literal.
+func appleScriptString(text string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(text) + `"`
+}
+
+// toastScript builds a PowerShell script showing a toast notification through the WinRT API.
+func toastScript(appName, title, body string) string {
+	var escapedTitle, escapedBody bytes.Buffer
+	_ = xml.EscapeText(&escapedTitle, []byte(title))
+	_ = xml.EscapeText(&escapedBody, []byte(body))
+	toast := fmt.Sprintf(`<toast><visual><binding template="ToastGeneric"><text>%s</text><text>%s</text></binding></visual></toast>`, escapedTitle.String(), escapedBody.String())
+
+	return strings.Join([]string{
+		`[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] | Out-Null`,
+		`[Windows.Data.Xml.Dom.XmlDocument, Windows.Data.Xml.Dom.XmlDocument, ContentType = WindowsRuntime] | Out-Null`,
+		`$xml = New-Object Windows.Data.Xml.Dom.XmlDocument`,
+		`$xml.LoadXml(` + powerShellString(toast) + `)`,
+		`$toast = New-Object Windows.UI.Notifications.ToastNotification $xml`,
+		`[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier(` + powerShellString(appName) + `).Show($toast)`,
+	}, "; ")
+}
+
+// powerShellQuotes doubles every character PowerShell accepts as a single quote, including the
+// typographic quotes U+2018 to U+201B, so none of them can end a verbatim string.
+var powerShellQuotes = strings.NewReplacer("'", "''", "\u2018", "\u2018\u2018", "\u2019", "\u2019\u2019", "\u201a", "\u201a\u201a", "\u201b", "\u201b\u201b")
+
+// powerShellString quotes text as a verbatim PowerShell string literal.
+func powerShellString(text string) string {
+	return "'" + powerShellQuotes.Replace(text) + "'"
+}
@@ -0,0 +1,160 @@
+package desktop
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+type recordedCommand struct {
+	name string
+	args []string
+}
+
+// newTestTransport returns a transport for goos recording commands instead of running them.
+func newTestTransport(goos string, err error) (*Transport, *recordedCommand) {
+	recorded := &recordedCommand{}
+	transport := NewTransport("backup")
+	transport.goos = goos
+	transport.run = func(ctx context.Context, name string, args ...string) error {
+		recorded.name = name
+		recorded.args = args
+		return err
+	}
+	return transport, recorded
+}
+
+func TestTransportSendLinux(t *testing.T) {
+	transport, recorded := newTestTransport("linux", nil)
+	transport.SetIcon("dialog-information")
+
+	msg := notifier.NewChatMessage("-5% disk left").
+		Importance(notifier.ImportanceUrgent).
+		WithOptions("desktop", NewOptions().Title("Disk").Timeout(5*time.Second))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetInfo("command") != "notify-send" {
+		t.Errorf("Unexpected info: %v", sentMsg.GetInfo())
+	}
+
+	expected := "--app-name backup --urgency critical --icon dialog-information --expire-time 5000 -- Disk -5% disk left"
+	if recorded.name != "notify-send" || strings.Join(recorded.args, " ") != expected {
+		t.Errorf("Unexpected command: %s %v", recorded.name, recorded.args)
+	}
+}
+
+func TestTransportSendMacOS(t *testing.T) {
+	transport, recorded := newTestTransport("darwin", nil)
+
+	msg := notifier.NewChatMessage(`Backup "nightly" done`).
+		Importance(notifier.ImportanceHigh).
+		WithOptions("desktop", NewOptions().Subtitle("db-1"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := `display notification "Backup \"nightly\" done" with title "backup" subtitle "db-1" sound name "default"`
+	if recorded.name != "osascript" || len(recorded.args) != 2 || recorded.args[1] != expected {
+		t.Errorf("Unexpected command: %s %v", recorded.name, recorded.args)
+	}
+}
+
+func TestTransportSendWindows(t *testing.T) {
+	transport, recorded := newTestTransport("windows", nil)
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("It's <done> & dusted")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if recorded.name != "powershell" || len(recorded.args) != 4 {
+		t.Fatalf("Unexpected command: %s %v", recorded.name, recorded.args)
+	}
+	script := recorded.args[3]
+	if !strings.Contains(script, "<text>It&#39;s &lt;done&gt; &amp; dusted</text>") {
+		t.Errorf("Expected escaped body in script: %s", script)
+	}
+	if !strings.Contains(script, "CreateToastNotifier('backup')") {
+		t.Errorf("Expected app name in script: %s", script)
+	}
+}
+
+func TestPowerShellString(t *testing.T) {
+	tests := []struct {
+		text     string
+		expected string
+	}{
+		{"done", "'done'"},
+		{"It's done", "'It''s done'"},
+		{"don\u2019t", "'don\u2019\u2019t'"},
+		{"\u2018'; Remove-Item C:\\ ; \u201b\u201a", "'\u2018\u2018''; Remove-Item C:\\ ; \u201b\u201b\u201a\u201a'"},
+	}
+
+	for _, tt := range tests {
+		if got := powerShellString(tt.text); got != tt.expected {
+			t.Errorf("powerShellString(%q) = %q, expected %q", tt.text, got, tt.expected)
+		}
+	}
+}
+
+func TestTransportSendUrgencyMapping(t *testing.T) {
+	transport, recorded := newTestTransport("linux", nil)
+	transport.SetUrgencyMapping(map[string]string{notifier.ImportanceHigh: "critical"})
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Disk full").Importance(notifier.ImportanceHigh)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if expected := "--app-name backup --urgency critical -- backup Disk full"; strings.Join(recorded.args, " ") != expected {
+		t.Errorf("Unexpected command: %s %v", recorded.name, recorded.args)
+	}
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Disk full").Importance(notifier.ImportanceUrgent)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if expected := "--app-name backup -- backup Disk full"; strings.Join(recorded.args, " ") != expected {
+		t.Errorf("Expected no urgency for unmapped importance, got: %v", recorded.args)
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	transport, _ := newTestTransport("linux", errors.New("executable file not found in $PATH"))
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "desktop: notify-send: executable file not found") {
+		t.Errorf("Expected command error, got: %v", err)
+	}
+
+	_, err = transport.Send(context.Background(), notifier.NewSmsMessage("+33612345678", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported message type") {
+		t.Errorf("Expected unsupported message error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory()
+
+	dsn, _ := notifier.NewDSN("desktop://default?app_name=backup&icon=dialog-information")
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support desktop scheme")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "desktop://default?app_name=backup" || transport.(*Transport).icon != "dialog-information" {
+		t.Errorf("Unexpected transport: %s", transport.String())
+	}
+
+	dsn, _ = notifier.NewDSN("desktop://example.com")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Expected error for remote host")
+	}
+}
@@ -0,0 +1,82 @@
+package dingtalk
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("dingtalk", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates DingTalk robot transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new DingTalk robot transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a DingTalk robot transport from a DSN.
+// DSN format: dingtalk://<access_token>@default[?secret=<secret>]
+// Example: dingtalk://1c8a5a0e9b3f4d2a8e7c6b5a4f3e2d1c@default?secret=SEC0123456789abcdef
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "dingtalk" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	accessToken := dsn.GetUser()
+	if accessToken == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing access token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(accessToken, f.client)
+	if secret := dsn.GetOption("secret"); secret != "" {
+		transport.SetSecret(secret)
+	}
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"dingtalk"}
+}
@@ -0,0 +1,101 @@
+package dingtalk
+
+import (
+	"encoding/json"
+)
+
+// Message types.
+const (
+	MsgTypeText       = "text"
+	MsgTypeMarkdown   = "markdown"
+	MsgTypeLink       = "link"
+	MsgTypeActionCard = "actionCard"
+)
+
+// ActionCardButton is a button of an action card opening the URL.
+type ActionCardButton struct {
+	Title     string `json:"title"`
+	ActionURL string `json:"actionURL"`
+}
+
+// Options implements MessageOptionsInterface for DingTalk.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Markdown sends the content as markdown, with the subject as title shown in notifications.
+func (o *Options) Markdown() *Options {
+	o.options["msgtype"] = MsgTypeMarkdown
+	return o
+}
+
+// Link sends a link card opening the message URL, with an optional picture.
+func (o *Options) Link(messageURL, picURL string) *Options {
+	o.options["msgtype"] = MsgTypeLink
+	o.options["message_url"] = messageURL
+	o.options["pic_url"] = picURL
+	return o
+}
+
+// ActionCard sends the content as markdown card with buttons. A single button spans the
+// whole card.
+func (o *Options) ActionCard(buttons ...ActionCardButton) *Options {
+	o.options["msgtype"] = MsgTypeActionCard
+	o.options["buttons"] = buttons
+	return o
+}
+
+// HorizontalButtons lays out the action card buttons side by side instead of stacked.
+func (o *Options) HorizontalButtons() *Options {
+	o.options["btn_orientation"] = "1"
+	return o
+}
+
+// MentionMobile mentions group members by mobile number. Text and markdown messages only.
+func (o *Options) MentionMobile(mobiles ...string) *Options {
+	mentions, _ := o.options["at_mobiles"].([]string)
+	o.options["at_mobiles"] = append(mentions, mobiles...)
+	return o
+}
+
+// MentionUser mentions group members by user ID. Text and markdown messages only.
+func (o *Options) MentionUser(userIDs ...string) *Options {
+	mentions, _ := o.options["at_user_ids"].([]string)
+	o.options["at_user_ids"] = append(mentions, userIDs...)
+	return o
+}
+
+// MentionAll mentions everyone in the group. Text and markdown messages only.
+func (o *Options) MentionAll() *Options {
+	o.options["is_at_all"] = true
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"buttons":     []ActionCardButton(nil),
+	"at_mobiles":  []string(nil),
+	"at_user_ids": []string(nil),
+}
@@ -0,0 +1,205 @@
+package dingtalk
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// errCodeRateLimited is returned when a robot sends more than 20 messages per minute.
+// The robot is then throttled for 10 minutes.
+const errCodeRateLimited = 130101
+
+// Transport sends messages to a DingTalk group robot webhook.
+type Transport struct {
+	*notifier.AbstractTransport
+	accessToken string
+	secret      string
+	now         func() time.Time
+}
+
+// NewTransport creates a new DingTalk robot transport. accessToken is the access_token
+// parameter of the webhook URL.
+func NewTransport(accessToken string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		accessToken:       accessToken,
+		now:               time.Now,
+	}
+}
+
+// SetSecret sets the secret of robots secured with signatures ("SEC..."). Requests are signed
+// with HMAC-SHA256 over the timestamp.
+func (t *Transport) SetSecret(secret string) *Transport {
+	t.secret = secret
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("dingtalk://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("dingtalk: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("dingtalk").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	jsonBody, err := notifier.JSONMarshal(buildMessage(chatMsg, options))
+	if err != nil {
+		return nil, fmt.Errorf("dingtalk: marshal options: %w", err)
+	}
+
+	query := url.Values{"access_token": {t.accessToken}}
+	if t.secret != "" {
+		timestamp := strconv.FormatInt(t.now().UnixMilli(), 10)
+		query.Set("timestamp", timestamp)
+		query.Set("sign", sign(timestamp, t.secret))
+	}
+
+	endpoint := fmt.Sprintf("https://%s/robot/send?%s", t.getEndpoint(), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("dingtalk: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("dingtalk: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("dingtalk: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	// Errors are reported with HTTP status 200 and a non-zero errcode
+	var result struct {
+		ErrCode int    `json:"errcode"`
+		ErrMsg  string `json:"errmsg"`
+	}
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("dingtalk: decode response: %w", err)
+	}
+	switch result.ErrCode {
+	case 0:
+	case errCodeRateLimited:
+		return nil, &notifier.RateLimitError{Transport: "dingtalk", RetryAfter: 10 * time.Minute, Message: result.ErrMsg}
+	default:
+		return nil, fmt.Errorf("dingtalk: API error (errcode %d): %s", result.ErrCode, result.ErrMsg)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "oapi.dingtalk.com"
+	}
+	return endpoint
+}
+
+// sign returns the signature of the timestamp in milliseconds.
+func sign(timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "\n" + secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// buildMessage builds the robot message. Cards use the subject as title and the content as text.
+func buildMessage(chatMsg *notifier.ChatMessage, options map[string]any) map[string]any {
+	msgType, _ := options["msgtype"].(string)
+	if msgType == "" {
+		msgType = MsgTypeText
+	}
+
+	title, text := chatMsg.GetSubject(), chatMsg.GetContent()
+	if text == "" {
+		text = title
+	}
+
+	message := map[string]any{"msgtype": msgType}
+	switch msgType {
+	case MsgTypeText:
+		message["text"] = map[string]any{"content": withMentions(chatMsg.GetText(), options)}
+	case MsgTypeMarkdown:
+		message["markdown"] = map[string]any{"title": title, "text": withMentions(text, options)}
+	case MsgTypeLink:
+		message["link"] = map[string]any{
+			"title":      title,
+			"text":       text,
+			"messageUrl": options["message_url"],
+			"picUrl":     options["pic_url"],
+		}
+	case MsgTypeActionCard:
+		card := map[string]any{"title": title, "text": text}
+		buttons, _ := options["buttons"].([]ActionCardButton)
+		if len(buttons) == 1 {
+			card["singleTitle"] = buttons[0].Title
+			card["singleURL"] = buttons[0].ActionURL
+		} else {
+			card["btns"] = buttons
+		}
+		if orientation, ok := options["btn_orientation"]; ok {
+			card["btnOrientation"] = orientation
+		}
+		message["actionCard"] = card
+	}
+
+	at := make(map[string]any)
+	if mobiles, ok := options["at_mobiles"].([]string); ok {
+		at["atMobiles"] = mobiles
+	}
+	if userIDs, ok := options["at_user_ids"].([]string); ok {
+		at["atUserIds"] = userIDs
+	}
+	if all, ok := options["is_at_all"].(bool); ok && all {
+		at["isAtAll"] = true
+	}
+	if len(at) > 0 {
+		message["at"] = at
+	}
+
+	return message
+}
+
+// withMentions appends the mentioned members missing in the text, DingTalk only highlights
+// mentions that appear in the text.
+func withMentions(text string, options map[string]any) string {
+	mobiles, _ := options["at_mobiles"].([]string)
+	userIDs, _ := options["at_user_ids"].([]string)
+	for _, mention := range append(append([]string(nil), mobiles...), userIDs...) {
+		if !strings.Contains(text, "@"+mention) {
+			text += " @" + mention
+		}
+	}
+	return text
+}
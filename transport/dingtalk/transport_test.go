@@ -0,0 +1,204 @@
+package dingtalk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		*body = nil
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusOK, `{"errcode":0,"errmsg":"ok"}`), nil
+	})
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("robot-token", captureBody(&req, &body))
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Deploy finished").Content("Version 1.2.3 is live")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://oapi.dingtalk.com/robot/send?access_token=robot-token" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+
+	data, _ := json.Marshal(body)
+	if string(data) != `{"msgtype":"text","text":{"content":"Deploy finished\n\nVersion 1.2.3 is live"}}` {
+		t.Errorf("Unexpected body: %s", data)
+	}
+}
+
+func TestTransportSendSigned(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("robot-token", captureBody(&req, &body)).SetSecret("SECtest")
+	transport.now = func() time.Time { return time.UnixMilli(1700000000000) }
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "https://oapi.dingtalk.com/robot/send?access_token=robot-token&sign=aZLLrriXgn05YbwaGR7knYsLeJADjr9NwLaNNKpxh4g%3D&timestamp=1700000000000"
+	if req.URL.String() != expected {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+}
+
+func TestTransportOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  *notifier.ChatMessage
+		expected string
+	}{
+		{
+			"mentions",
+			notifier.NewChatMessage("Disk full @13800001111").WithOptions("dingtalk", NewOptions().
+				MentionMobile("13800001111", "13800002222").
+				MentionUser("manager01").
+				MentionAll()),
+			`{"at":{"atMobiles":["13800001111","13800002222"],"atUserIds":["manager01"],"isAtAll":true},"msgtype":"text","text":{"content":"Disk full @13800001111 @13800002222 @manager01"}}`,
+		},
+		{
+			"markdown",
+			notifier.NewChatMessage("Deploy finished").Content("**1.2.3** is live").WithOptions("dingtalk", NewOptions().Markdown().MentionMobile("13800001111")),
+			`{"at":{"atMobiles":["13800001111"]},"markdown":{"text":"**1.2.3** is live @13800001111","title":"Deploy finished"},"msgtype":"markdown"}`,
+		},
+		{
+			"link",
+			notifier.NewChatMessage("Release notes").Content("What's new in 1.2.3").WithOptions("dingtalk", NewOptions().Link("https://example.com/releases/1.2.3", "https://example.com/logo.png")),
+			`{"link":{"messageUrl":"https://example.com/releases/1.2.3","picUrl":"https://example.com/logo.png","text":"What's new in 1.2.3","title":"Release notes"},"msgtype":"link"}`,
+		},
+		{
+			"single action card",
+			notifier.NewChatMessage("Deploy failed").WithOptions("dingtalk", NewOptions().ActionCard(ActionCardButton{Title: "Open logs", ActionURL: "https://ci.example.com/42"})),
+			`{"actionCard":{"singleTitle":"Open logs","singleURL":"https://ci.example.com/42","text":"Deploy failed","title":"Deploy failed"},"msgtype":"actionCard"}`,
+		},
+		{
+			"action card buttons",
+			notifier.NewChatMessage("Approve release?").Content("Version 1.2.3").WithOptions("dingtalk", NewOptions().
+				ActionCard(
+					ActionCardButton{Title: "Approve", ActionURL: "https://ci.example.com/approve"},
+					ActionCardButton{Title: "Reject", ActionURL: "https://ci.example.com/reject"},
+				).
+				HorizontalButtons()),
+			`{"actionCard":{"btnOrientation":"1","btns":[{"actionURL":"https://ci.example.com/approve","title":"Approve"},{"actionURL":"https://ci.example.com/reject","title":"Reject"}],"text":"Version 1.2.3","title":"Approve release?"},"msgtype":"actionCard"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var body map[string]any
+			transport := NewTransport("robot-token", captureBody(&req, &body))
+
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			data, _ := json.Marshal(body)
+			if string(data) != tt.expected {
+				t.Errorf("Unexpected body: %s", data)
+			}
+		})
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `{"errcode":310000,"errmsg":"sign not match"}`), nil
+	})
+
+	_, err := NewTransport("robot-token", client).Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || err.Error() != "dingtalk: API error (errcode 310000): sign not match" {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendRateLimited(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `{"errcode":130101,"errmsg":"send too fast, exceed 20 times per minute"}`), nil
+	})
+
+	_, err := NewTransport("robot-token", client).Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 10*time.Minute {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestTransportUnsupportedMessage(t *testing.T) {
+	transport := NewTransport("robot-token", nil)
+	msg := notifier.NewSmsMessage("+15551234567", "Hi")
+
+	if transport.Supports(msg) {
+		t.Error("Transport should not support SmsMessage")
+	}
+	if _, err := transport.Send(context.Background(), msg); err == nil {
+		t.Error("Expected error for unsupported message")
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("dingtalk://robot-token@default?secret=SECtest")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support dingtalk DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	dingtalkTransport := transport.(*Transport)
+	if dingtalkTransport.accessToken != "robot-token" || dingtalkTransport.secret != "SECtest" {
+		t.Errorf("Unexpected transport: %+v", dingtalkTransport)
+	}
+	if transport.String() != "dingtalk://oapi.dingtalk.com" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	dsn, _ = notifier.NewDSN("dingtalk://default")
+	if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+		t.Errorf("Expected incomplete DSN error, got: %v", err)
+	}
+}
@@ -71,6 +71,11 @@ func (o *Options) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.options)
 }
 
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"embeds": []map[string]any(nil),
+}
+
 // Embed represents a Discord embed.
 type Embed struct {
 	options map[string]any
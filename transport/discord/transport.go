@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"time"
 
 	"github.com/shyim/go-notifier"
 )
@@ -14,8 +16,9 @@ import (
 // Transport sends messages via Discord Webhook API.
 type Transport struct {
 	*notifier.AbstractTransport
-	webhookID string
-	token     string
+	webhookID    string
+	token        string
+	colorMapping map[string]int
 }
 
 // NewTransport creates a new Discord transport.
@@ -27,9 +30,16 @@ func NewTransport(webhookID, token string, client *http.Client) *Transport {
 		AbstractTransport: notifier.NewAbstractTransport(client),
 		webhookID:         webhookID,
 		token:             token,
+		colorMapping:      notifier.ImportanceColors,
 	}
 }
 
+// SetColorMapping overrides the importance to embed color mapping (0xRRGGBB).
+func (t *Transport) SetColorMapping(mapping map[string]int) *Transport {
+	t.colorMapping = mapping
+	return t
+}
+
 func (t *Transport) String() string {
 	endpoint := t.getEndpoint()
 	return fmt.Sprintf("discord://%s?webhook_id=%s", endpoint, t.webhookID)
@@ -48,10 +58,26 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 
 	options := make(map[string]any)
 	if opts, ok := chatMsg.GetOptions("discord").(*Options); ok {
-		options = opts.ToMap()
+		options = maps.Clone(opts.ToMap())
 	}
 
-	options["content"] = chatMsg.GetSubject()
+	options["content"] = chatMsg.GetText()
+
+	// Messages with an importance are sent as a colored embed unless they carry their own embeds
+	if color, ok := t.colorMapping[chatMsg.GetImportance()]; ok {
+		if _, hasEmbeds := options["embeds"]; !hasEmbeds {
+			embed := map[string]any{
+				"color":       color,
+				"description": chatMsg.GetSubject(),
+			}
+			if content := chatMsg.GetContent(); content != "" {
+				embed["title"] = chatMsg.GetSubject()
+				embed["description"] = content
+			}
+			options["embeds"] = []map[string]any{embed}
+			delete(options, "content")
+		}
+	}
 
 	// Filter out empty values
 	filteredOptions := make(map[string]any)
@@ -61,12 +87,16 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 		}
 	}
 
-	jsonBody, err := json.Marshal(filteredOptions)
+	jsonBody, err := notifier.JSONMarshal(filteredOptions)
 	if err != nil {
 		return nil, fmt.Errorf("discord: marshal options: %w", err)
 	}
 
 	endpoint := fmt.Sprintf("https://%s/api/webhooks/%s/%s", t.getEndpoint(), t.webhookID, t.token)
+	if t.CapturesRawResponse() {
+		// Discord only returns the created message when asked to wait for it
+		endpoint += "?wait=true"
+	}
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
 	if err != nil {
 		return nil, fmt.Errorf("discord: create request: %w", err)
@@ -74,22 +104,63 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := t.AbstractTransport.GetClient().Do(req)
+	resp, err := t.AbstractTransport.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("discord: send request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	// Discord returns 204 on success
-	if resp.StatusCode != http.StatusNoContent {
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, rateLimitError(resp, respBody)
+	}
+
+	// Discord returns 204 on success, or 200 with the created message with wait=true
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("discord: API error (status %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	sentMessage := notifier.NewSentMessage(message, t.String())
+	if resp.StatusCode == http.StatusOK {
+		var result struct {
+			ID        string `json:"id"`
+			ChannelID string `json:"channel_id"`
+		}
+		raw, err := t.DecodeResponse(resp.Body, &result)
+		if err != nil {
+			return nil, fmt.Errorf("discord: decode response: %w", err)
+		}
+		t.SetRawResponse(sentMessage, raw)
+		sentMessage.SetMessageID(result.ID)
+		sentMessage.SetInfo("channel_id", result.ChannelID)
+	}
 	return sentMessage, nil
 }
 
+// rateLimitError converts a 429 response into a RateLimitError. Discord sends the delay in
+// seconds with fractions as retry_after, the Retry-After header is used if it is missing.
+func rateLimitError(resp *http.Response, respBody []byte) *notifier.RateLimitError {
+	var result struct {
+		Message    string  `json:"message"`
+		RetryAfter float64 `json:"retry_after"`
+	}
+	err := &notifier.RateLimitError{
+		Transport:  "discord",
+		RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		Message:    string(respBody),
+	}
+	if json.Unmarshal(respBody, &result) == nil {
+		if result.RetryAfter > 0 {
+			err.RetryAfter = time.Duration(result.RetryAfter * float64(time.Second))
+		}
+		if result.Message != "" {
+			err.Message = result.Message
+		}
+	}
+	return err
+}
+
 func (t *Transport) getEndpoint() string {
 	endpoint := t.GetEndpoint()
 	if endpoint == "" || endpoint == "localhost" {
@@ -10,6 +10,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/shyim/go-notifier"
 )
@@ -238,6 +239,140 @@ func TestSendSuccessfulWebhookPost(t *testing.T) {
 	}
 }
 
+func TestSendRawResponse(t *testing.T) {
+	var capturedQuery string
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedQuery = r.URL.RawQuery
+		if r.URL.Query().Get("wait") != "true" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"1100000000000000000","channel_id":"1000000000000000000","content":"Test message"}`))
+	}))
+	defer server.Close()
+
+	transport := NewTransport("webhook123", "token456", server.Client())
+	transport.SetHost(strings.TrimPrefix(server.URL, "https://"))
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Test message"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedQuery != "" || sentMsg.GetInfo(notifier.RawResponseInfo) != nil {
+		t.Errorf("Expected no wait and no raw response by default, got query '%s'", capturedQuery)
+	}
+
+	transport.SetCaptureRawResponse(true)
+	sentMsg, err = transport.Send(context.Background(), notifier.NewChatMessage("Test message"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedQuery != "wait=true" {
+		t.Errorf("Expected wait=true, got '%s'", capturedQuery)
+	}
+	raw, ok := sentMsg.GetInfo(notifier.RawResponseInfo).(map[string]any)
+	if !ok || raw["content"] != "Test message" {
+		t.Fatalf("Unexpected raw response: %v", sentMsg.GetInfo(notifier.RawResponseInfo))
+	}
+	if sentMsg.GetMessageID() != "1100000000000000000" || sentMsg.GetInfo("channel_id") != "1000000000000000000" {
+		t.Errorf("Unexpected message ID '%s' or channel '%v'", sentMsg.GetMessageID(), sentMsg.GetInfo("channel_id"))
+	}
+}
+
+func TestSendImportanceColor(t *testing.T) {
+	var capturedBody []byte
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport := NewTransport("webhook123", "token456", server.Client())
+	transport.SetHost(strings.TrimPrefix(server.URL, "https://"))
+	transport.SetColorMapping(map[string]int{notifier.ImportanceHigh: 0x00FF00})
+
+	msg := notifier.NewChatMessage("Queue is backing up").Importance(notifier.ImportanceHigh)
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var body struct {
+		Content string           `json:"content"`
+		Embeds  []map[string]any `json:"embeds"`
+	}
+	if err := json.Unmarshal(capturedBody, &body); err != nil {
+		t.Fatalf("Failed to parse request body: %v", err)
+	}
+
+	if body.Content != "" {
+		t.Errorf("Expected no content next to the embed, got: %s", body.Content)
+	}
+	if len(body.Embeds) != 1 {
+		t.Fatalf("Expected 1 embed, got: %d", len(body.Embeds))
+	}
+	if color, _ := body.Embeds[0]["color"].(float64); int(color) != 0x00FF00 {
+		t.Errorf("Expected color 0x00FF00, got: %v", body.Embeds[0]["color"])
+	}
+	if body.Embeds[0]["description"] != "Queue is backing up" {
+		t.Errorf("Expected subject as description, got: %v", body.Embeds[0]["description"])
+	}
+}
+
+func TestSendImportanceColorReusedOptions(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport := NewTransport("webhook123", "token456", server.Client())
+	transport.SetHost(strings.TrimPrefix(server.URL, "https://"))
+	options := NewOptions().Username("ops-bot")
+
+	urgent := notifier.NewChatMessage("Queue is backing up").Importance(notifier.ImportanceUrgent).WithOptions("discord", options)
+	if _, err := transport.Send(context.Background(), urgent); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// The embed of the urgent message doesn't stick to the shared options
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Queue drained").WithOptions("discord", options)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if body["content"] != "Queue drained" || body["embeds"] != nil {
+		t.Errorf("Expected a plain message, got: %v", body)
+	}
+	if _, ok := options.ToMap()["embeds"]; ok {
+		t.Errorf("Expected the options not to be modified, got: %v", options.ToMap())
+	}
+}
+
+func TestSendPathPrefix(t *testing.T) {
+	var capturedPath string
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	transport := NewTransport("webhook123", "token456", server.Client())
+	transport.SetHost(strings.TrimPrefix(server.URL, "https://"))
+	transport.SetPathPrefix("/proxied/discord/")
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Test message")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expectedPath := "/proxied/discord/api/webhooks/webhook123/token456"
+	if capturedPath != expectedPath {
+		t.Errorf("Expected path %s, got: %s", expectedPath, capturedPath)
+	}
+}
+
 func TestSendWithDiscordOptions(t *testing.T) {
 	var capturedBody []byte
 
@@ -371,12 +506,6 @@ func TestSendHTTPErrorResponses(t *testing.T) {
 			responseBody:   `{"message": "Unknown webhook"}`,
 			expectedErrMsg: "API error (status 404)",
 		},
-		{
-			name:           "Rate Limit",
-			statusCode:     http.StatusTooManyRequests,
-			responseBody:   `{"message": "You are being rate limited", "retry_after": 1000}`,
-			expectedErrMsg: "API error (status 429)",
-		},
 		{
 			name:           "Internal Server Error",
 			statusCode:     http.StatusInternalServerError,
@@ -421,6 +550,55 @@ func TestSendHTTPErrorResponses(t *testing.T) {
 	}
 }
 
+func TestSendRateLimited(t *testing.T) {
+	tests := []struct {
+		name               string
+		retryAfterHeader   string
+		responseBody       string
+		expectedRetryAfter time.Duration
+		expectedMessage    string
+	}{
+		{
+			name:               "retry_after field",
+			retryAfterHeader:   "1",
+			responseBody:       `{"message": "You are being rate limited.", "retry_after": 0.25, "global": false}`,
+			expectedRetryAfter: 250 * time.Millisecond,
+			expectedMessage:    "You are being rate limited.",
+		},
+		{
+			name:               "Retry-After header",
+			retryAfterHeader:   "2",
+			responseBody:       `{"message": "You are being rate limited."}`,
+			expectedRetryAfter: 2 * time.Second,
+			expectedMessage:    "You are being rate limited.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", tt.retryAfterHeader)
+				w.WriteHeader(http.StatusTooManyRequests)
+				w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			transport := NewTransport("webhook123", "token456", server.Client())
+			transport.SetHost(strings.TrimPrefix(server.URL, "https://"))
+
+			_, err := transport.Send(context.Background(), notifier.NewChatMessage("Test message"))
+
+			var rateLimitErr *notifier.RateLimitError
+			if !errors.As(err, &rateLimitErr) {
+				t.Fatalf("Expected RateLimitError, got: %v", err)
+			}
+			if rateLimitErr.Transport != "discord" || rateLimitErr.RetryAfter != tt.expectedRetryAfter || rateLimitErr.Message != tt.expectedMessage {
+				t.Errorf("Unexpected RateLimitError: %+v", rateLimitErr)
+			}
+		})
+	}
+}
+
 func TestSendNetworkError(t *testing.T) {
 	// Create a custom RoundTripper that simulates a network error
 	networkErrorTransport := &errorRoundTripper{
@@ -0,0 +1,108 @@
+package fcm
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("fcm", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates Firebase Cloud Messaging transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Firebase Cloud Messaging transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Firebase Cloud Messaging transport from a DSN.
+// DSN format: fcm://[<project_id>]@default?credentials_file=<path> or ?credentials=<base64_json>
+// Example: fcm://default?credentials_file=/etc/firebase/service-account.json
+//
+// The project ID defaults to the project_id of the service account.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "fcm" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	var data []byte
+	if path := dsn.GetOption("credentials_file"); path != "" {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("fcm: read credentials file: %w", err)
+		}
+		data = content
+	} else if encoded := dsn.GetOption("credentials"); encoded != "" {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("fcm: decode credentials: %w", err)
+		}
+		data = decoded
+	} else {
+		return nil, fmt.Errorf("missing required option: credentials_file or credentials")
+	}
+
+	account, err := ParseServiceAccount(data)
+	if err != nil {
+		return nil, err
+	}
+
+	projectID := dsn.GetUser()
+	if projectID == "" {
+		projectID = account.ProjectID
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing project ID. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(projectID, account, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"fcm"}
+}
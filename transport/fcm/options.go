@@ -0,0 +1,75 @@
+package fcm
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Firebase Cloud Messaging.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the device registration token.
+func (o *Options) Recipient(token string) *Options {
+	o.options["recipient_id"] = token
+	return o
+}
+
+// Topic sends the notification to all devices subscribed to the topic.
+func (o *Options) Topic(topic string) *Options {
+	o.options["topic"] = topic
+	return o
+}
+
+// Condition sends the notification to devices matching a topic condition
+// (e.g., "'stock' in topics && 'tech' in topics").
+func (o *Options) Condition(condition string) *Options {
+	o.options["condition"] = condition
+	return o
+}
+
+// Image sets the URL of an image shown in the notification.
+func (o *Options) Image(url string) *Options {
+	o.options["image"] = url
+	return o
+}
+
+// Android sets the Android specific configuration (AndroidConfig).
+func (o *Options) Android(config map[string]any) *Options {
+	o.options["android"] = config
+	return o
+}
+
+// APNS sets the Apple specific configuration (ApnsConfig).
+func (o *Options) APNS(config map[string]any) *Options {
+	o.options["apns"] = config
+	return o
+}
+
+// Webpush sets the Web Push specific configuration (WebpushConfig).
+func (o *Options) Webpush(config map[string]any) *Options {
+	o.options["webpush"] = config
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
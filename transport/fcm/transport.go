@@ -0,0 +1,155 @@
+package fcm
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends push notifications via the Firebase Cloud Messaging HTTP v1 API.
+type Transport struct {
+	*notifier.AbstractTransport
+	projectID string
+	tokens    *tokenSource
+}
+
+// NewTransport creates a new Firebase Cloud Messaging transport.
+func NewTransport(projectID string, account *ServiceAccount, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		projectID:         projectID,
+		tokens:            &tokenSource{account: account, client: client},
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("fcm://%s?project=%s", t.getEndpoint(), t.projectID)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.PushMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	pushMsg, ok := message.(*notifier.PushMessage)
+	if !ok {
+		return nil, fmt.Errorf("fcm: unsupported message type %T, expected PushMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := pushMsg.GetOptions("fcm").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	payload := make(map[string]any)
+
+	topic, _ := options["topic"].(string)
+	condition, _ := options["condition"].(string)
+	switch {
+	case topic != "":
+		payload["topic"] = strings.TrimPrefix(topic, "/topics/")
+	case condition != "":
+		payload["condition"] = condition
+	default:
+		token := pushMsg.GetRecipientId()
+		if token == "" {
+			return nil, fmt.Errorf("fcm: missing recipient, set a device token, topic or condition")
+		}
+		payload["token"] = token
+	}
+
+	notification := make(map[string]any)
+	if subject := pushMsg.GetSubject(); subject != "" {
+		notification["title"] = subject
+	}
+	if content := pushMsg.GetContent(); content != "" {
+		notification["body"] = content
+	}
+	if image, ok := options["image"].(string); ok && image != "" {
+		notification["image"] = image
+	}
+	// Data-only messages are delivered silently to the app
+	if len(notification) > 0 {
+		payload["notification"] = notification
+	}
+
+	if data := pushMsg.GetData(); len(data) > 0 {
+		payload["data"] = data
+	}
+	for _, key := range []string{"android", "apns", "webpush"} {
+		if config, ok := options[key]; ok {
+			payload[key] = config
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(map[string]any{"message": payload})
+	if err != nil {
+		return nil, fmt.Errorf("fcm: marshal options: %w", err)
+	}
+
+	accessToken, err := t.tokens.token(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v1/projects/%s/messages:send", t.getEndpoint(), t.projectID)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("fcm: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fcm: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "fcm",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("fcm: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Name string `json:"name"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("fcm: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Name)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "fcm.googleapis.com"
+	}
+	return endpoint
+}
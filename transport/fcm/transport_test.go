@@ -0,0 +1,260 @@
+package fcm
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func newTestServiceAccount(t *testing.T) ([]byte, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	der, _ := x509.MarshalPKCS8PrivateKey(key)
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+
+	data, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   "my-project",
+		"private_key":  string(pemKey),
+		"client_email": "sender@my-project.iam.gserviceaccount.com",
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	return data, key
+}
+
+func newTestTransport(t *testing.T, handler func(req *http.Request) (*http.Response, error)) *Transport {
+	data, key := newTestServiceAccount(t)
+	account, err := ParseServiceAccount(data)
+	if err != nil {
+		t.Fatalf("Failed to parse service account: %v", err)
+	}
+
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() == "https://oauth2.googleapis.com/token" {
+			body, _ := io.ReadAll(req.Body)
+			form, _ := url.ParseQuery(string(body))
+
+			// Verify the assertion signature with the public key
+			parts := strings.Split(form.Get("assertion"), ".")
+			signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+			digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+			if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+				return newResponse(http.StatusUnauthorized, `{"error":"invalid_grant"}`), nil
+			}
+			return newResponse(http.StatusOK, `{"access_token":"ya29.token","expires_in":3599}`), nil
+		}
+		return handler(req)
+	})
+
+	return NewTransport("my-project", account, client)
+}
+
+func TestTransportSupports(t *testing.T) {
+	transport := newTestTransport(t, nil)
+
+	if !transport.Supports(notifier.NewPushMessage("Title", "Body")) {
+		t.Error("Transport should support PushMessage")
+	}
+	if transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should not support ChatMessage")
+	}
+}
+
+func TestTransportString(t *testing.T) {
+	transport := newTestTransport(t, nil)
+
+	if transport.String() != "fcm://fcm.googleapis.com?project=my-project" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+}
+
+func TestFactory(t *testing.T) {
+	data, _ := newTestServiceAccount(t)
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("fcm://default?credentials=" + url.QueryEscape(base64.StdEncoding.EncodeToString(data)))
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support fcm DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.(*Transport).projectID != "my-project" {
+		t.Errorf("Expected project ID from service account, got '%s'", transport.(*Transport).projectID)
+	}
+
+	for _, invalid := range []string{
+		"fcm://default",
+		"fcm://default?credentials_file=/does/not/exist.json",
+		"fcm://default?credentials=not-base64!",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
+
+func TestHTTPSendToDevice(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody map[string]any
+	transport := newTestTransport(t, func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"name":"projects/my-project/messages/0:1500415314455276"}`), nil
+	})
+
+	msg := notifier.NewPushMessage("Order shipped", "Your order is on its way").
+		Recipient("device-token").
+		Data("order_id", "1234").
+		WithOptions("fcm", NewOptions().
+			Image("https://example.com/box.png").
+			Android(map[string]any{"priority": "high"}).
+			APNS(map[string]any{"headers": map[string]any{"apns-priority": "10"}}))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.URL.String() != "https://fcm.googleapis.com/v1/projects/my-project/messages:send" {
+		t.Errorf("Unexpected URL: %s", capturedRequest.URL.String())
+	}
+	if capturedRequest.Header.Get("Authorization") != "Bearer ya29.token" {
+		t.Errorf("Unexpected Authorization: %s", capturedRequest.Header.Get("Authorization"))
+	}
+
+	message := capturedBody["message"].(map[string]any)
+	if message["token"] != "device-token" {
+		t.Errorf("Expected token 'device-token', got '%v'", message["token"])
+	}
+	notification := message["notification"].(map[string]any)
+	if notification["title"] != "Order shipped" || notification["body"] != "Your order is on its way" || notification["image"] != "https://example.com/box.png" {
+		t.Errorf("Unexpected notification: %v", notification)
+	}
+	if message["data"].(map[string]any)["order_id"] != "1234" {
+		t.Errorf("Unexpected data: %v", message["data"])
+	}
+	if message["android"] == nil || message["apns"] == nil {
+		t.Errorf("Expected android and apns overrides, got: %v", message)
+	}
+
+	if sentMsg.GetMessageID() != "projects/my-project/messages/0:1500415314455276" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestHTTPSendToTopic(t *testing.T) {
+	var capturedBody map[string]any
+	transport := newTestTransport(t, func(req *http.Request) (*http.Response, error) {
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"name":"projects/my-project/messages/1"}`), nil
+	})
+
+	msg := notifier.NewPushMessage("", "").
+		Data("sync", "true").
+		WithOptions("fcm", NewOptions().Topic("/topics/news"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	message := capturedBody["message"].(map[string]any)
+	if message["topic"] != "news" {
+		t.Errorf("Expected topic 'news', got '%v'", message["topic"])
+	}
+	if _, ok := message["notification"]; ok {
+		t.Error("Data-only message should not contain a notification")
+	}
+}
+
+func TestHTTPMissingRecipient(t *testing.T) {
+	transport := newTestTransport(t, nil)
+
+	_, err := transport.Send(context.Background(), notifier.NewPushMessage("Title", "Body"))
+	if err == nil || !strings.Contains(err.Error(), "missing recipient") {
+		t.Errorf("Expected missing recipient error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		check      func(err error) bool
+	}{
+		{"not found", http.StatusNotFound, func(err error) bool {
+			return strings.Contains(err.Error(), "API error (status 404)")
+		}},
+		{"quota exceeded", http.StatusTooManyRequests, func(err error) bool {
+			var rateLimitErr *notifier.RateLimitError
+			return errors.As(err, &rateLimitErr)
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := newTestTransport(t, func(req *http.Request) (*http.Response, error) {
+				return newResponse(tt.statusCode, `{"error":{"status":"UNREGISTERED"}}`), nil
+			})
+
+			_, err := transport.Send(context.Background(), notifier.NewPushMessage("Title", "Body").Recipient("token"))
+			if err == nil || !tt.check(err) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseServiceAccountInvalid(t *testing.T) {
+	for _, data := range []string{
+		`not json`,
+		`{"client_email":"a@b.c"}`,
+		`{"client_email":"a@b.c","private_key":"not pem"}`,
+	} {
+		if _, err := ParseServiceAccount([]byte(data)); err == nil {
+			t.Errorf("Expected error for %s", data)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package file
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory())
+	notifier.RegisterOptionsDecoder("file", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// sizeUnits are the suffixes accepted by the max_size option.
+var sizeUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	{"GB", 1 << 30},
+	{"MB", 1 << 20},
+	{"KB", 1 << 10},
+	{"B", 1},
+}
+
+// TransportFactory creates file transports from DSN.
+type TransportFactory struct{}
+
+// NewTransportFactory creates a new file transport factory.
+func NewTransportFactory() *TransportFactory {
+	return &TransportFactory{}
+}
+
+// Create creates a file transport from a DSN.
+// DSN format: file://default/<absolute_path>[?max_size=<size>][&max_backups=<n>][&sync=1]
+// Example: file://default/var/log/notifications.jsonl?max_size=10MB&max_backups=5
+//
+// Relative paths are given with the path option: file://default?path=notifications.jsonl
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "file" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	path := dsn.GetOption("path", dsn.GetPath())
+	if path == "" || path == "/" {
+		return nil, fmt.Errorf("incomplete DSN: Missing file path. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(path)
+	transport.SetSync(dsn.GetBooleanOption("sync"))
+
+	if value := dsn.GetOption("max_size"); value != "" {
+		size, err := parseSize(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid DSN: Invalid max_size \"%s\". DSN: %s", value, dsn.GetOriginalDSN())
+		}
+		transport.SetMaxSize(size)
+	}
+	if value := dsn.GetOption("max_backups"); value != "" {
+		backups, err := strconv.Atoi(value)
+		if err != nil || backups < 0 {
+			return nil, fmt.Errorf("invalid DSN: Invalid max_backups \"%s\". DSN: %s", value, dsn.GetOriginalDSN())
+		}
+		transport.SetMaxBackups(backups)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"file"}
+}
+
+// parseSize parses a size in bytes with an optional KB, MB or GB suffix.
+func parseSize(value string) (int64, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	multiplier := int64(1)
+	for _, unit := range sizeUnits {
+		if strings.HasSuffix(value, unit.suffix) {
+			value, multiplier = strings.TrimSpace(strings.TrimSuffix(value, unit.suffix)), unit.multiplier
+			break
+		}
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+	return size * multiplier, nil
+}
@@ -0,0 +1,48 @@
+package file
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for the file transport.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Metadata adds a key-value pair to the metadata of the written line.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"metadata": map[string]string(nil),
+}
@@ -0,0 +1,184 @@
+package file
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// record is a line of the file: the message payload with the time it was written.
+type record struct {
+	Timestamp time.Time `json:"timestamp"`
+	*notifier.Payload
+}
+
+// Transport appends messages as JSON lines to a file, for audit trails and environments without
+// network access. The file is rotated once it exceeds the maximum size, keeping numbered backups
+// (notifications.jsonl.1 being the most recent).
+type Transport struct {
+	*notifier.AbstractTransport
+	path       string
+	maxSize    int64
+	maxBackups int
+	sync       bool
+	now        func() time.Time
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+}
+
+// NewTransport creates a new file transport appending to the file at path, which is created if needed.
+func NewTransport(path string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		path:              path,
+		now:               time.Now,
+	}
+}
+
+// SetMaxSize sets the size in bytes after which the file is rotated. Zero disables rotation.
+func (t *Transport) SetMaxSize(size int64) *Transport {
+	t.maxSize = size
+	return t
+}
+
+// SetMaxBackups sets the number of rotated files to keep. Zero removes the file when rotating.
+func (t *Transport) SetMaxBackups(backups int) *Transport {
+	t.maxBackups = backups
+	return t
+}
+
+// SetSync flushes every line to disk before Send returns.
+func (t *Transport) SetSync(sync bool) *Transport {
+	t.sync = sync
+	return t
+}
+
+func (t *Transport) String() string {
+	if filepath.IsAbs(t.path) {
+		return fmt.Sprintf("file://default%s", t.path)
+	}
+	return fmt.Sprintf("file://default?path=%s", t.path)
+}
+
+// Supports returns true for all message types, they are written as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	payload := notifier.NewPayload(message)
+	if opts, ok := message.GetOptions("file").(*Options); ok {
+		if metadata, ok := opts.ToMap()["metadata"].(map[string]string); ok {
+			payload.Metadata = metadata
+		}
+	}
+
+	line, err := notifier.JSONMarshal(record{Timestamp: t.now().UTC(), Payload: payload})
+	if err != nil {
+		return nil, fmt.Errorf("file: marshal payload: %w", err)
+	}
+	line = append(line, '\n')
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := t.open(); err != nil {
+		return nil, err
+	}
+	if t.maxSize > 0 && t.size > 0 && t.size+int64(len(line)) > t.maxSize {
+		if err := t.rotate(); err != nil {
+			return nil, err
+		}
+	}
+
+	n, err := t.file.Write(line)
+	t.size += int64(n)
+	if err != nil {
+		return nil, fmt.Errorf("file: write %s: %w", t.path, err)
+	}
+	if t.sync {
+		if err := t.file.Sync(); err != nil {
+			return nil, fmt.Errorf("file: sync %s: %w", t.path, err)
+		}
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("path", t.path)
+	sentMessage.SetInfo("offset", t.size-int64(n))
+
+	return sentMessage, nil
+}
+
+// Close closes the file. The next Send reopens it.
+func (t *Transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.file == nil {
+		return nil
+	}
+	err := t.file.Close()
+	t.file = nil
+	return err
+}
+
+func (t *Transport) open() error {
+	if t.file != nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(t.path), 0o755); err != nil {
+		return fmt.Errorf("file: create directory: %w", err)
+	}
+	file, err := os.OpenFile(t.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("file: open %s: %w", t.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("file: stat %s: %w", t.path, err)
+	}
+	t.file, t.size = file, info.Size()
+	return nil
+}
+
+// rotate shifts the backups by one, moves the current file to the first backup and reopens it.
+func (t *Transport) rotate() error {
+	if err := t.file.Close(); err != nil {
+		return fmt.Errorf("file: close %s: %w", t.path, err)
+	}
+	t.file = nil
+
+	if t.maxBackups == 0 {
+		if err := os.Remove(t.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("file: rotate %s: %w", t.path, err)
+		}
+		return t.open()
+	}
+
+	_ = os.Remove(backupPath(t.path, t.maxBackups))
+	for i := t.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupPath(t.path, i), backupPath(t.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("file: rotate %s: %w", t.path, err)
+		}
+	}
+	if err := os.Rename(t.path, backupPath(t.path, 1)); err != nil {
+		return fmt.Errorf("file: rotate %s: %w", t.path, err)
+	}
+	return t.open()
+}
+
+func backupPath(path string, index int) string {
+	return fmt.Sprintf("%s.%d", path, index)
+}
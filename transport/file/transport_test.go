@@ -0,0 +1,128 @@
+package file
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+func readLines(t *testing.T, path string) []map[string]any {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read %s: %v", path, err)
+	}
+	var lines []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n") {
+		var record map[string]any
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Fatalf("Invalid JSON line %q: %v", line, err)
+		}
+		lines = append(lines, record)
+	}
+	return lines
+}
+
+func TestTransportSend(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "notifications.jsonl")
+	transport := NewTransport(path)
+	transport.now = func() time.Time { return time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC) }
+	defer func() { _ = transport.Close() }()
+
+	message := notifier.NewChatMessage("Backup finished").
+		Importance(notifier.ImportanceHigh).
+		WithOptions("file", NewOptions().Metadata("job", "backup"))
+	sent, err := transport.Send(context.Background(), message)
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if sent.GetInfo("path") != path || sent.GetInfo("offset") != int64(0) {
+		t.Errorf("Unexpected info: %v", sent.GetInfo())
+	}
+
+	if _, err := transport.Send(context.Background(), notifier.NewSmsMessage("+15551234567", "Hello")); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	lines := readLines(t, path)
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if lines[0]["timestamp"] != "2026-01-02T03:04:05Z" || lines[0]["subject"] != "Backup finished" || lines[0]["importance"] != "high" {
+		t.Errorf("Unexpected first line: %v", lines[0])
+	}
+	if metadata, _ := lines[0]["metadata"].(map[string]any); metadata["job"] != "backup" {
+		t.Errorf("Unexpected metadata: %v", lines[0]["metadata"])
+	}
+	if lines[1]["recipient"] != "+15551234567" {
+		t.Errorf("Unexpected second line: %v", lines[1])
+	}
+}
+
+func TestTransportRotate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.jsonl")
+	transport := NewTransport(path).SetMaxSize(1).SetMaxBackups(2)
+	defer func() { _ = transport.Close() }()
+
+	for _, subject := range []string{"one", "two", "three", "four"} {
+		if _, err := transport.Send(context.Background(), notifier.NewChatMessage(subject)); err != nil {
+			t.Fatalf("Send failed: %v", err)
+		}
+	}
+
+	for file, subject := range map[string]string{path: "four", path + ".1": "three", path + ".2": "two"} {
+		lines := readLines(t, file)
+		if len(lines) != 1 || lines[0]["subject"] != subject {
+			t.Errorf("Expected %s to contain %q, got %v", file, subject, lines)
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("Expected only 2 backups")
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory()
+
+	dsn, _ := notifier.NewDSN("file://default/var/log/notifications.jsonl?max_size=10MB&max_backups=5&sync=1")
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support file scheme")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	fileTransport := transport.(*Transport)
+	if fileTransport.path != "/var/log/notifications.jsonl" || fileTransport.maxSize != 10<<20 || fileTransport.maxBackups != 5 || !fileTransport.sync {
+		t.Errorf("Unexpected transport: %+v", fileTransport)
+	}
+
+	dsn, _ = notifier.NewDSN("file://default?path=notifications.jsonl")
+	transport, err = factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.(*Transport).path != "notifications.jsonl" {
+		t.Errorf("Unexpected path: %s", transport.(*Transport).path)
+	}
+
+	invalid := []string{
+		"file://default",
+		"file://default/tmp/log.jsonl?max_size=big",
+		"file://default/tmp/log.jsonl?max_backups=-1",
+		"log://default/tmp/log.jsonl",
+	}
+	for _, value := range invalid {
+		dsn, _ := notifier.NewDSN(value)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", value)
+		}
+	}
+}
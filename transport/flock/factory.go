@@ -0,0 +1,79 @@
+package flock
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("flock", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Flock transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Flock transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Flock transport from a DSN.
+// DSN format: flock://<token>@default
+// Example: flock://8c8e7b3a-1234-5678-9abc-def012345678@default
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "flock" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	token := dsn.GetUser()
+	if token == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(token, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"flock"}
+}
@@ -0,0 +1,152 @@
+package flock
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// Options implements MessageOptionsInterface for Flock.
+type Options struct {
+	options     map[string]any
+	attachments []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options:     make(map[string]any),
+		attachments: make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.attachments) > 0 {
+		o.options["attachments"] = o.attachments
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// FlockML sets the rich text version of the message (e.g., "<b>Deploy</b> finished").
+// The <flockml> root element is added when missing.
+func (o *Options) FlockML(flockml string) *Options {
+	o.options["flockml"] = wrapFlockML(flockml)
+	return o
+}
+
+// Notification overrides the text shown in the notification of the message.
+func (o *Options) Notification(notification string) *Options {
+	o.options["notification"] = notification
+	return o
+}
+
+// SendAs overrides the sender name and profile image of the webhook.
+func (o *Options) SendAs(name, profileImage string) *Options {
+	sendAs := map[string]any{"name": name}
+	if profileImage != "" {
+		sendAs["profileImage"] = profileImage
+	}
+	o.options["sendAs"] = sendAs
+	return o
+}
+
+// AddAttachment adds an attachment to the message.
+func (o *Options) AddAttachment(attachment *Attachment) *Options {
+	o.attachments = append(o.attachments, attachment.ToMap())
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"attachments": []map[string]any(nil),
+}
+
+// Attachment represents a Flock message attachment.
+type Attachment struct {
+	options map[string]any
+	views   map[string]any
+	buttons []map[string]any
+}
+
+func NewAttachment() *Attachment {
+	return &Attachment{
+		options: make(map[string]any),
+		views:   make(map[string]any),
+		buttons: make([]map[string]any, 0),
+	}
+}
+
+func (a *Attachment) ToMap() map[string]any {
+	if len(a.views) > 0 {
+		a.options["views"] = a.views
+	}
+	if len(a.buttons) > 0 {
+		a.options["buttons"] = a.buttons
+	}
+	return a.options
+}
+
+// Title sets the attachment title.
+func (a *Attachment) Title(title string) *Attachment {
+	a.options["title"] = title
+	return a
+}
+
+// Description sets the attachment description.
+func (a *Attachment) Description(description string) *Attachment {
+	a.options["description"] = description
+	return a
+}
+
+// Color sets the color of the attachment bar (e.g., "#0ABE51").
+func (a *Attachment) Color(color string) *Attachment {
+	a.options["color"] = color
+	return a
+}
+
+// URL sets the URL opened when the attachment is clicked.
+func (a *Attachment) URL(url string) *Attachment {
+	a.options["url"] = url
+	return a
+}
+
+// FlockML sets the rich text view of the attachment.
+func (a *Attachment) FlockML(flockml string) *Attachment {
+	a.views["flockml"] = wrapFlockML(flockml)
+	return a
+}
+
+// Image sets the image view of the attachment.
+func (a *Attachment) Image(src string, width, height int) *Attachment {
+	a.views["image"] = map[string]any{
+		"original": map[string]any{"src": src, "width": width, "height": height},
+	}
+	return a
+}
+
+// AddButton adds a button opening the URL in the browser.
+func (a *Attachment) AddButton(name, url string) *Attachment {
+	a.buttons = append(a.buttons, map[string]any{
+		"name":   name,
+		"id":     name,
+		"action": map[string]any{"type": "openBrowser", "url": url},
+	})
+	return a
+}
+
+func wrapFlockML(flockml string) string {
+	if strings.HasPrefix(strings.TrimSpace(flockml), "<flockml>") {
+		return flockml
+	}
+	return "<flockml>" + flockml + "</flockml>"
+}
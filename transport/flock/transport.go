@@ -0,0 +1,131 @@
+package flock
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends messages via Flock incoming webhooks.
+type Transport struct {
+	*notifier.AbstractTransport
+	token string
+}
+
+// NewTransport creates a new Flock transport for the token of an incoming webhook URL
+// (https://api.flock.com/hooks/sendMessage/<token>).
+func NewTransport(token string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("flock://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("flock: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("flock").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	// The text is shown by clients without FlockML support and in notifications
+	options["text"] = chatMsg.GetText()
+
+	// Filter out empty values
+	filteredOptions := make(map[string]any)
+	for k, v := range options {
+		if !isEmptyValue(v) {
+			filteredOptions[k] = v
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(filteredOptions)
+	if err != nil {
+		return nil, fmt.Errorf("flock: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/hooks/sendMessage/%s", t.getEndpoint(), t.token)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("flock: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("flock: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &notifier.RateLimitError{
+			Transport:  "flock",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("flock: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+
+	var result struct {
+		UID string `json:"uid"`
+	}
+	if raw, err := t.DecodeResponse(bytes.NewReader(respBody), &result); err == nil {
+		t.SetRawResponse(sentMessage, raw)
+		sentMessage.SetMessageID(result.UID)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.flock.com"
+	}
+	return endpoint
+}
+
+func isEmptyValue(v any) bool {
+	switch val := v.(type) {
+	case string:
+		return val == ""
+	case []any:
+		return len(val) == 0
+	case []map[string]any:
+		return len(val) == 0
+	case map[string]any:
+		return len(val) == 0
+	case nil:
+		return true
+	default:
+		return false
+	}
+}
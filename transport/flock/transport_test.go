@@ -0,0 +1,144 @@
+package flock
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://api.flock.com/hooks/sendMessage/hook-token" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(200, `{"uid":"m:8c8e7b3a"}`), nil
+	})
+
+	transport := NewTransport("hook-token", client)
+
+	msg := notifier.NewChatMessage("Deploy finished").
+		WithOptions("flock", NewOptions().
+			FlockML("<b>Deploy</b> finished").
+			SendAs("CI", "https://example.com/ci.png").
+			AddAttachment(NewAttachment().
+				Title("Release 1.2.3").
+				Color("#0ABE51").
+				FlockML("<i>3 commits</i>").
+				AddButton("Changelog", "https://example.com/changelog")))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "m:8c8e7b3a" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+
+	if capturedBody["text"] != "Deploy finished" || capturedBody["flockml"] != "<flockml><b>Deploy</b> finished</flockml>" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if sendAs, _ := capturedBody["sendAs"].(map[string]any); sendAs["name"] != "CI" {
+		t.Errorf("Unexpected sendAs: %v", capturedBody["sendAs"])
+	}
+
+	attachments, _ := capturedBody["attachments"].([]any)
+	if len(attachments) != 1 {
+		t.Fatalf("Expected one attachment, got %v", capturedBody["attachments"])
+	}
+	attachment, _ := attachments[0].(map[string]any)
+	views, _ := attachment["views"].(map[string]any)
+	buttons, _ := attachment["buttons"].([]any)
+	if attachment["title"] != "Release 1.2.3" || views["flockml"] != "<flockml><i>3 commits</i></flockml>" || len(buttons) != 1 {
+		t.Errorf("Unexpected attachment: %v", attachment)
+	}
+}
+
+func TestTransportSendPlainText(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(200, `{}`), nil
+	})
+
+	if _, err := NewTransport("hook-token", client).Send(context.Background(), notifier.NewChatMessage("Hello")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(capturedBody) != 1 || capturedBody["text"] != "Hello" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(400, `{"error":"InvalidToken"}`), nil
+	})
+
+	_, err := NewTransport("wrong", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "flock: API error (status 400)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(429, "Too Many Requests"), nil
+	})
+
+	_, err = NewTransport("hook-token", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	dsn, _ := notifier.NewDSN("flock://hook-token@default")
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support flock scheme")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "flock://api.flock.com" || transport.(*Transport).token != "hook-token" {
+		t.Errorf("Unexpected transport: %s", transport.String())
+	}
+
+	dsn, _ = notifier.NewDSN("flock://default")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Expected error for missing token")
+	}
+}
@@ -0,0 +1,133 @@
+package freemobile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// errorMessages describes the status codes of the SMS API.
+var errorMessages = map[int]string{
+	http.StatusBadRequest:      "a required parameter is missing",
+	http.StatusPaymentRequired: "too many SMS sent in too short a time",
+	http.StatusForbidden:       "service not enabled or invalid credentials",
+}
+
+// Transport sends SMS to the own phone of a Free Mobile subscriber through the
+// "Notifications par SMS" option of the account.
+type Transport struct {
+	*notifier.AbstractTransport
+	user  string
+	key   string
+	phone string
+}
+
+// NewTransport creates a new Free Mobile transport for the subscriber login and the API key of the
+// SMS notifications option. phone is the subscriber number; when set, SMS messages to other numbers
+// are not supported.
+func NewTransport(user, key, phone string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		user:              user,
+		key:               key,
+		phone:             phone,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.phone == "" {
+		return fmt.Sprintf("freemobile://%s", t.getEndpoint())
+	}
+	return fmt.Sprintf("freemobile://%s?phone=%s", t.getEndpoint(), t.phone)
+}
+
+// Supports returns true for chat messages, and for SMS messages to the subscriber number.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	switch m := message.(type) {
+	case *notifier.ChatMessage:
+		return true
+	case *notifier.SmsMessage:
+		return t.phone == "" || normalizePhone(m.GetPhone()) == normalizePhone(t.phone)
+	}
+	return false
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	if !t.Supports(message) {
+		if smsMsg, ok := message.(*notifier.SmsMessage); ok {
+			return nil, fmt.Errorf("freemobile: SMS can only be sent to the subscriber number %s, not %s", t.phone, smsMsg.GetPhone())
+		}
+		return nil, fmt.Errorf("freemobile: unsupported message type %T, expected ChatMessage or SmsMessage", message)
+	}
+
+	text := message.GetSubject()
+	if chatMsg, ok := message.(*notifier.ChatMessage); ok {
+		text = chatMsg.GetText()
+	}
+
+	jsonBody, err := notifier.JSONMarshal(map[string]string{
+		"user": t.user,
+		"pass": t.key,
+		"msg":  text,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("freemobile: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/sendmsg", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("freemobile: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("freemobile: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	// 402 signals the per-minute SMS limit of the option
+	if resp.StatusCode == http.StatusPaymentRequired {
+		return nil, &notifier.RateLimitError{
+			Transport: "freemobile",
+			Message:   errorMessages[resp.StatusCode],
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if description, ok := errorMessages[resp.StatusCode]; ok {
+			return nil, fmt.Errorf("freemobile: API error (status %d): %s", resp.StatusCode, description)
+		}
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("freemobile: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return notifier.NewSentMessage(message, t.String()), nil
+}
+
+// normalizePhone converts French numbers to the international format without spaces.
+func normalizePhone(phone string) string {
+	phone = strings.NewReplacer(" ", "", ".", "", "-", "").Replace(phone)
+	if strings.HasPrefix(phone, "0") {
+		return "+33" + phone[1:]
+	}
+	return phone
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "smsapi.free-mobile.fr"
+	}
+	return endpoint
+}
@@ -0,0 +1,121 @@
+package gitlab
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("gitlab", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates GitLab transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new GitLab transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a GitLab transport from a DSN.
+// DSN format: gitlab://<token>@<host>?project=<id_or_path>[&issue=<iid>|&merge_request=<iid>][&labels=<a,b>]
+// Example: gitlab://glpat-xxxx@default?project=group/project&labels=alert,ops
+//
+// Use default as host for gitlab.com. Plain HTTP servers can be reached with
+// gitlab+http://<token>@<host> or ?scheme=http.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	token := dsn.GetUser()
+	if token == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	project, err := dsn.GetRequiredOption("project")
+	if err != nil {
+		return nil, err
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(token, project, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	issue := dsn.GetOption("issue")
+	mergeRequest := dsn.GetOption("merge_request")
+	if issue != "" && mergeRequest != "" {
+		return nil, fmt.Errorf("invalid DSN: Only one of issue or merge_request is allowed. DSN: %s", dsn.GetOriginalDSN())
+	}
+	if issue != "" {
+		iid, err := strconv.Atoi(issue)
+		if err != nil || iid <= 0 {
+			return nil, fmt.Errorf("invalid DSN: Invalid issue \"%s\". DSN: %s", issue, dsn.GetOriginalDSN())
+		}
+		transport.SetIssue(iid)
+	}
+	if mergeRequest != "" {
+		iid, err := strconv.Atoi(mergeRequest)
+		if err != nil || iid <= 0 {
+			return nil, fmt.Errorf("invalid DSN: Invalid merge_request \"%s\". DSN: %s", mergeRequest, dsn.GetOriginalDSN())
+		}
+		transport.SetMergeRequest(iid)
+	}
+
+	if labels := dsn.GetOption("labels"); labels != "" {
+		transport.SetLabels(strings.Split(labels, ",")...)
+	}
+
+	urlScheme := dsn.GetOption("scheme", "https")
+	if scheme == "gitlab+http" {
+		urlScheme = "http"
+	}
+	if urlScheme != "https" && urlScheme != "http" {
+		return nil, fmt.Errorf("invalid DSN: Unsupported URL scheme \"%s\" (supported: https, http). DSN: %s", urlScheme, dsn.GetOriginalDSN())
+	}
+	transport.SetScheme(urlScheme)
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"gitlab", "gitlab+http"}
+}
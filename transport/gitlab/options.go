@@ -0,0 +1,89 @@
+package gitlab
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for GitLab.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Description sets the Markdown description of the issue, or the note text below the subject.
+func (o *Options) Description(description string) *Options {
+	o.options["description"] = description
+	return o
+}
+
+// Issue adds the message as a note to the issue with the given IID.
+func (o *Options) Issue(iid int) *Options {
+	o.options["issue"] = iid
+	return o
+}
+
+// MergeRequest adds the message as a note to the merge request with the given IID.
+func (o *Options) MergeRequest(iid int) *Options {
+	o.options["merge_request"] = iid
+	return o
+}
+
+// Labels sets the labels of the created issue, replacing the configured labels.
+func (o *Options) Labels(labels ...string) *Options {
+	o.options["labels"] = labels
+	return o
+}
+
+// Assignees sets the user IDs the created issue is assigned to.
+func (o *Options) Assignees(ids ...int) *Options {
+	o.options["assignee_ids"] = ids
+	return o
+}
+
+// Confidential marks the created issue as confidential.
+func (o *Options) Confidential(confidential bool) *Options {
+	o.options["confidential"] = confidential
+	return o
+}
+
+// DueDate sets the due date of the created issue (YYYY-MM-DD).
+func (o *Options) DueDate(date string) *Options {
+	o.options["due_date"] = date
+	return o
+}
+
+// Milestone sets the milestone ID of the created issue.
+func (o *Options) Milestone(id int) *Options {
+	o.options["milestone_id"] = id
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"issue":         0,
+	"merge_request": 0,
+	"labels":        []string(nil),
+	"assignee_ids":  []int(nil),
+	"milestone_id":  0,
+}
@@ -0,0 +1,197 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport creates GitLab issues, or adds notes to an issue or merge request.
+type Transport struct {
+	*notifier.AbstractTransport
+	token        string
+	project      string
+	scheme       string
+	issue        int
+	mergeRequest int
+	labels       []string
+}
+
+// NewTransport creates a new GitLab transport for the project ID or path (e.g., "group/project")
+// authenticating with a personal, project or group access token.
+func NewTransport(token, project string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		project:           project,
+		scheme:            "https",
+	}
+}
+
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+// SetIssue adds messages as notes to the issue with the given IID instead of creating issues.
+func (t *Transport) SetIssue(iid int) *Transport {
+	t.issue = iid
+	return t
+}
+
+// SetMergeRequest adds messages as notes to the merge request with the given IID instead of creating issues.
+func (t *Transport) SetMergeRequest(iid int) *Transport {
+	t.mergeRequest = iid
+	return t
+}
+
+// SetLabels sets the default labels of created issues.
+func (t *Transport) SetLabels(labels ...string) *Transport {
+	t.labels = labels
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("gitlab://%s?project=%s", t.getEndpoint(), t.project)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("gitlab: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("gitlab").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	issue, mergeRequest := t.issue, t.mergeRequest
+	if value, ok := options["issue"].(int); ok {
+		issue, mergeRequest = value, 0
+	}
+	if value, ok := options["merge_request"].(int); ok {
+		issue, mergeRequest = 0, value
+	}
+	description, _ := options["description"].(string)
+	if description == "" {
+		description = chatMsg.GetContent()
+	}
+
+	var path string
+	body := make(map[string]any)
+	switch {
+	case issue > 0:
+		path = fmt.Sprintf("issues/%d/notes", issue)
+		body["body"] = noteBody(chatMsg.GetSubject(), description)
+	case mergeRequest > 0:
+		path = fmt.Sprintf("merge_requests/%d/notes", mergeRequest)
+		body["body"] = noteBody(chatMsg.GetSubject(), description)
+	default:
+		path = "issues"
+		body["title"] = chatMsg.GetSubject()
+		if description != "" {
+			body["description"] = description
+		}
+		labels := t.labels
+		if value, ok := options["labels"].([]string); ok {
+			labels = value
+		}
+		if len(labels) > 0 {
+			body["labels"] = strings.Join(labels, ",")
+		}
+		for _, key := range []string{"assignee_ids", "confidential", "due_date", "milestone_id"} {
+			if value, ok := options[key]; ok {
+				body[key] = value
+			}
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s://%s/api/v4/projects/%s/%s", t.scheme, t.getEndpoint(), url.PathEscape(t.project), path)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("PRIVATE-TOKEN", t.token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "gitlab",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("gitlab: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ID     int    `json:"id"`
+		IID    int    `json:"iid"`
+		WebURL string `json:"web_url"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("gitlab: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(fmt.Sprintf("%d", result.ID))
+	if result.IID > 0 {
+		sentMessage.SetInfo("iid", result.IID)
+	}
+	if result.WebURL != "" {
+		sentMessage.SetInfo("web_url", result.WebURL)
+	}
+
+	return sentMessage, nil
+}
+
+// noteBody renders the subject in bold above the description, if any.
+func noteBody(subject, description string) string {
+	if description == "" {
+		return subject
+	}
+	return fmt.Sprintf("**%s**\n\n%s", subject, description)
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "gitlab.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,182 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSendIssue(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://gitlab.com/api/v4/projects/group%2Fproject/issues" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("PRIVATE-TOKEN") != "glpat-token" {
+			t.Errorf("Unexpected PRIVATE-TOKEN header: %s", req.Header.Get("PRIVATE-TOKEN"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(201, `{"id":84,"iid":14,"web_url":"https://gitlab.com/group/project/-/issues/14"}`), nil
+	})
+
+	transport := NewTransport("glpat-token", "group/project", client).SetLabels("alert")
+
+	msg := notifier.NewChatMessage("Backup failed").
+		WithOptions("gitlab", NewOptions().
+			Description("The nightly backup of `db-1` failed.").
+			Labels("alert", "ops").
+			Assignees(7).
+			Confidential(true))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "84" || sentMsg.GetInfo("iid") != 14 || sentMsg.GetInfo("web_url") != "https://gitlab.com/group/project/-/issues/14" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	if capturedBody["title"] != "Backup failed" || capturedBody["description"] != "The nightly backup of `db-1` failed." {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if capturedBody["labels"] != "alert,ops" || capturedBody["confidential"] != true {
+		t.Errorf("Unexpected issue fields: %v", capturedBody)
+	}
+	if assignees, _ := capturedBody["assignee_ids"].([]any); len(assignees) != 1 || assignees[0] != float64(7) {
+		t.Errorf("Unexpected assignees: %v", capturedBody["assignee_ids"])
+	}
+}
+
+func TestTransportSendNote(t *testing.T) {
+	var capturedURL string
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedURL = req.URL.String()
+		_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(201, `{"id":1301,"body":"Deployed"}`), nil
+	})
+
+	transport := NewTransport("glpat-token", "42", client).SetScheme("http").SetIssue(14)
+	transport.SetHost("gitlab.internal")
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Deployed"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedURL != "http://gitlab.internal/api/v4/projects/42/issues/14/notes" || capturedBody["body"] != "Deployed" {
+		t.Errorf("Unexpected request: %s %v", capturedURL, capturedBody)
+	}
+	if sentMsg.GetMessageID() != "1301" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+
+	msg := notifier.NewChatMessage("Pipeline failed").
+		WithOptions("gitlab", NewOptions().MergeRequest(7).Description("See job 123"))
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedURL != "http://gitlab.internal/api/v4/projects/42/merge_requests/7/notes" || capturedBody["body"] != "**Pipeline failed**\n\nSee job 123" {
+		t.Errorf("Unexpected request: %s %v", capturedURL, capturedBody)
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(404, `{"message":"404 Project Not Found"}`), nil
+	})
+
+	_, err := NewTransport("glpat-token", "missing/project", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "gitlab: API error (status 404)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(429, "Retry later")
+		resp.Header.Set("Retry-After", "30")
+		return resp, nil
+	})
+
+	_, err = NewTransport("glpat-token", "group/project", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	tests := []struct {
+		dsn          string
+		expected     string
+		issue        int
+		mergeRequest int
+		labels       int
+	}{
+		{"gitlab://glpat-token@default?project=group/project&labels=alert,ops", "gitlab://gitlab.com?project=group/project", 0, 0, 2},
+		{"gitlab://glpat-token@gitlab.internal:8443?project=42&issue=14", "gitlab://gitlab.internal:8443?project=42", 14, 0, 0},
+		{"gitlab+http://glpat-token@gitlab.local?project=42&merge_request=7", "gitlab://gitlab.local?project=42", 0, 7, 0},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+
+		gitlabTransport := transport.(*Transport)
+		if transport.String() != tt.expected {
+			t.Errorf("Expected string '%s', got '%s'", tt.expected, transport.String())
+		}
+		if gitlabTransport.issue != tt.issue || gitlabTransport.mergeRequest != tt.mergeRequest || len(gitlabTransport.labels) != tt.labels {
+			t.Errorf("Unexpected settings for %s: %+v", tt.dsn, gitlabTransport)
+		}
+	}
+
+	for _, invalid := range []string{
+		"gitlab://default?project=42",
+		"gitlab://glpat-token@default",
+		"gitlab://glpat-token@default?project=42&issue=abc",
+		"gitlab://glpat-token@default?project=42&issue=1&merge_request=2",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package googlechat
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Google Chat.
+type Options struct {
+	options map[string]any
+	cards   []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+		cards:   make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.cards) > 0 {
+		o.options["cardsV2"] = o.cards
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the recipient ID. Webhooks always post into their space, so it is unused.
+func (o *Options) Recipient(id string) *Options {
+	o.options["recipient_id"] = id
+	return o
+}
+
+// ThreadKey replies in the thread with the key, starting it if it doesn't exist yet.
+func (o *Options) ThreadKey(key string) *Options {
+	o.options["thread_key"] = key
+	return o
+}
+
+// Card adds a card to the message.
+func (o *Options) Card(card *Card) *Options {
+	o.cards = append(o.cards, card.ToMap())
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.ToMap())
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"cardsV2": []map[string]any(nil),
+}
+
+// Card represents a Google Chat card (cardsV2).
+type Card struct {
+	id       string
+	options  map[string]any
+	sections []map[string]any
+}
+
+// NewCard creates a card. The ID identifies the card within the message.
+func NewCard(id string) *Card {
+	return &Card{
+		id:       id,
+		options:  make(map[string]any),
+		sections: make([]map[string]any, 0),
+	}
+}
+
+// Header sets the card header.
+func (c *Card) Header(title string, subtitle ...string) *Card {
+	header := c.header()
+	header["title"] = title
+	if len(subtitle) > 0 {
+		header["subtitle"] = subtitle[0]
+	}
+	return c
+}
+
+// HeaderImage sets the image of the card header, optionally cropped to a circle.
+func (c *Card) HeaderImage(url string, circle ...bool) *Card {
+	header := c.header()
+	header["imageUrl"] = url
+	if len(circle) > 0 && circle[0] {
+		header["imageType"] = "CIRCLE"
+	}
+	return c
+}
+
+// Section adds a section to the card.
+func (c *Card) Section(section *CardSection) *Card {
+	c.sections = append(c.sections, section.ToMap())
+	return c
+}
+
+func (c *Card) header() map[string]any {
+	header, ok := c.options["header"].(map[string]any)
+	if !ok {
+		header = make(map[string]any)
+		c.options["header"] = header
+	}
+	return header
+}
+
+func (c *Card) ToMap() map[string]any {
+	if len(c.sections) > 0 {
+		c.options["sections"] = c.sections
+	}
+	return map[string]any{
+		"cardId": c.id,
+		"card":   c.options,
+	}
+}
+
+// CardSection represents a section of a card.
+type CardSection struct {
+	options map[string]any
+	widgets []map[string]any
+}
+
+func NewCardSection() *CardSection {
+	return &CardSection{
+		options: make(map[string]any),
+		widgets: make([]map[string]any, 0),
+	}
+}
+
+// Header sets the section header.
+func (s *CardSection) Header(header string) *CardSection {
+	s.options["header"] = header
+	return s
+}
+
+// Collapsible collapses the section, showing only the first widgets.
+func (s *CardSection) Collapsible(uncollapsibleWidgets int) *CardSection {
+	s.options["collapsible"] = true
+	s.options["uncollapsibleWidgetsCount"] = uncollapsibleWidgets
+	return s
+}
+
+// Widget adds a widget to the section.
+func (s *CardSection) Widget(widget Widget) *CardSection {
+	s.widgets = append(s.widgets, widget.ToMap())
+	return s
+}
+
+func (s *CardSection) ToMap() map[string]any {
+	s.options["widgets"] = s.widgets
+	return s.options
+}
+
+// Widget represents a card widget.
+type Widget interface {
+	ToMap() map[string]any
+}
+
+// TextParagraphWidget represents a paragraph of formatted text.
+type TextParagraphWidget struct {
+	options map[string]any
+}
+
+func NewTextParagraphWidget(text string) *TextParagraphWidget {
+	return &TextParagraphWidget{
+		options: map[string]any{"text": text},
+	}
+}
+
+func (w *TextParagraphWidget) ToMap() map[string]any {
+	return map[string]any{"textParagraph": w.options}
+}
+
+// DecoratedTextWidget represents a text with labels, an icon and an optional button.
+type DecoratedTextWidget struct {
+	options map[string]any
+}
+
+func NewDecoratedTextWidget(text string) *DecoratedTextWidget {
+	return &DecoratedTextWidget{
+		options: map[string]any{"text": text},
+	}
+}
+
+// TopLabel sets the label above the text.
+func (w *DecoratedTextWidget) TopLabel(label string) *DecoratedTextWidget {
+	w.options["topLabel"] = label
+	return w
+}
+
+// BottomLabel sets the label below the text.
+func (w *DecoratedTextWidget) BottomLabel(label string) *DecoratedTextWidget {
+	w.options["bottomLabel"] = label
+	return w
+}
+
+// Icon sets a built-in icon (e.g., "CLOCK", "EMAIL", "STAR") in front of the text.
+func (w *DecoratedTextWidget) Icon(knownIcon string) *DecoratedTextWidget {
+	w.options["startIcon"] = map[string]any{"knownIcon": knownIcon}
+	return w
+}
+
+// Wrap wraps long texts instead of truncating them.
+func (w *DecoratedTextWidget) Wrap(wrap bool) *DecoratedTextWidget {
+	w.options["wrapText"] = wrap
+	return w
+}
+
+// OpenLink opens the URL when the text is clicked.
+func (w *DecoratedTextWidget) OpenLink(url string) *DecoratedTextWidget {
+	w.options["onClick"] = openLink(url)
+	return w
+}
+
+// Button shows a button after the text.
+func (w *DecoratedTextWidget) Button(button *Button) *DecoratedTextWidget {
+	w.options["button"] = button.ToMap()
+	return w
+}
+
+func (w *DecoratedTextWidget) ToMap() map[string]any {
+	return map[string]any{"decoratedText": w.options}
+}
+
+// ImageWidget represents an image.
+type ImageWidget struct {
+	options map[string]any
+}
+
+func NewImageWidget(imageUrl, altText string) *ImageWidget {
+	return &ImageWidget{
+		options: map[string]any{
+			"imageUrl": imageUrl,
+			"altText":  altText,
+		},
+	}
+}
+
+// OpenLink opens the URL when the image is clicked.
+func (w *ImageWidget) OpenLink(url string) *ImageWidget {
+	w.options["onClick"] = openLink(url)
+	return w
+}
+
+func (w *ImageWidget) ToMap() map[string]any {
+	return map[string]any{"image": w.options}
+}
+
+// DividerWidget represents a horizontal line.
+type DividerWidget struct{}
+
+func NewDividerWidget() *DividerWidget {
+	return &DividerWidget{}
+}
+
+func (w *DividerWidget) ToMap() map[string]any {
+	return map[string]any{"divider": map[string]any{}}
+}
+
+// ButtonListWidget represents a row of buttons.
+type ButtonListWidget struct {
+	buttons []map[string]any
+}
+
+func NewButtonListWidget(buttons ...*Button) *ButtonListWidget {
+	w := &ButtonListWidget{buttons: make([]map[string]any, len(buttons))}
+	for i, button := range buttons {
+		w.buttons[i] = button.ToMap()
+	}
+	return w
+}
+
+func (w *ButtonListWidget) ToMap() map[string]any {
+	return map[string]any{"buttonList": map[string]any{"buttons": w.buttons}}
+}
+
+// Button represents a button opening a link.
+type Button struct {
+	options map[string]any
+}
+
+func NewButton(text, url string) *Button {
+	return &Button{
+		options: map[string]any{
+			"text":    text,
+			"onClick": openLink(url),
+		},
+	}
+}
+
+// Color sets the background color of the button (RGB components from 0 to 1).
+func (b *Button) Color(red, green, blue float64) *Button {
+	b.options["color"] = map[string]any{"red": red, "green": green, "blue": blue}
+	return b
+}
+
+// Disabled disables the button.
+func (b *Button) Disabled(disabled bool) *Button {
+	b.options["disabled"] = disabled
+	return b
+}
+
+func (b *Button) ToMap() map[string]any {
+	return b.options
+}
+
+func openLink(url string) map[string]any {
+	return map[string]any{"openLink": map[string]any{"url": url}}
+}
@@ -0,0 +1,155 @@
+package googlechat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts messages to a Google Chat space via an incoming webhook.
+type Transport struct {
+	*notifier.AbstractTransport
+	space     string
+	key       string
+	token     string
+	threadKey string
+}
+
+// NewTransport creates a new Google Chat transport from the space, key and token of the
+// webhook URL (https://chat.googleapis.com/v1/spaces/<space>/messages?key=<key>&token=<token>).
+func NewTransport(space, key, token string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		space:             space,
+		key:               key,
+		token:             token,
+	}
+}
+
+// SetThreadKey sets the thread key messages are posted to by default.
+func (t *Transport) SetThreadKey(threadKey string) *Transport {
+	t.threadKey = threadKey
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.threadKey != "" {
+		return fmt.Sprintf("googlechat://%s/%s?thread_key=%s", t.getEndpoint(), t.space, t.threadKey)
+	}
+	return fmt.Sprintf("googlechat://%s/%s", t.getEndpoint(), t.space)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("googlechat: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("googlechat").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	threadKey := t.threadKey
+	if key, ok := options["thread_key"].(string); ok && key != "" {
+		threadKey = key
+	}
+	delete(options, "recipient_id")
+	delete(options, "thread_key")
+	options["text"] = chatMsg.GetText()
+
+	query := url.Values{}
+	query.Set("key", t.key)
+	query.Set("token", t.token)
+	if threadKey != "" {
+		query.Set("threadKey", threadKey)
+		query.Set("messageReplyOption", "REPLY_MESSAGE_FALLBACK_TO_NEW_THREAD")
+	}
+
+	jsonBody, err := notifier.JSONMarshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("googlechat: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v1/spaces/%s/messages?%s", t.getEndpoint(), url.PathEscape(t.space), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("googlechat: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=UTF-8")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("googlechat: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Error struct {
+				Message string `json:"message"`
+				Status  string `json:"status"`
+			} `json:"error"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Error.Message != "" {
+			errMessage = fmt.Sprintf("%s: %s", apiResp.Error.Status, apiResp.Error.Message)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "googlechat",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("googlechat: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		Name   string `json:"name"`
+		Thread struct {
+			Name string `json:"name"`
+		} `json:"thread"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("googlechat: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Name)
+	if result.Thread.Name != "" {
+		sentMessage.SetInfo("thread_name", result.Thread.Name)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "chat.googleapis.com"
+	}
+	return endpoint
+}
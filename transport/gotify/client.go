@@ -0,0 +1,160 @@
+package gotify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Application is a Gotify application.
+type Application struct {
+	ID          int    `json:"id"`
+	Token       string `json:"token"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Internal    bool   `json:"internal"`
+	Image       string `json:"image"`
+}
+
+// Paging describes the position of a MessagePage in the message list.
+type Paging struct {
+	Size  int    `json:"size"`
+	Since int    `json:"since"`
+	Limit int    `json:"limit"`
+	Next  string `json:"next,omitempty"`
+}
+
+// MessagePage is a page of messages, newest first.
+type MessagePage struct {
+	Messages []*Message `json:"messages"`
+	Paging   Paging     `json:"paging"`
+}
+
+// HasNext reports whether older messages are available.
+func (p *MessagePage) HasNext() bool {
+	return p.Paging.Next != ""
+}
+
+// NextSince returns the since value to request the next page.
+func (p *MessagePage) NextSince() int {
+	if len(p.Messages) == 0 {
+		return 0
+	}
+	return p.Messages[len(p.Messages)-1].ID
+}
+
+// Client talks to the Gotify management API.
+// It requires a client token, as application tokens can only create messages.
+type Client struct {
+	baseURL     string
+	clientToken string
+	client      *http.Client
+}
+
+// NewClient creates a new Gotify API client.
+// baseURL is the server URL including scheme (e.g., https://gotify.example.com).
+func NewClient(baseURL, clientToken string, client *http.Client) *Client {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Client{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		clientToken: clientToken,
+		client:      client,
+	}
+}
+
+// UploadApplicationImage uploads or replaces the icon image of an application.
+func (c *Client) UploadApplicationImage(ctx context.Context, appID int, filename string, image io.Reader) (*Application, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	part, err := writer.CreateFormFile("file", filename)
+	if err != nil {
+		return nil, fmt.Errorf("gotify: create form file: %w", err)
+	}
+	if _, err := io.Copy(part, image); err != nil {
+		return nil, fmt.Errorf("gotify: copy image: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("gotify: close multipart writer: %w", err)
+	}
+
+	var application Application
+	endpoint := fmt.Sprintf("/application/%d/image", appID)
+	if err := c.do(ctx, "POST", endpoint, &body, writer.FormDataContentType(), &application); err != nil {
+		return nil, err
+	}
+
+	return &application, nil
+}
+
+// ListApplicationMessages lists messages of an application, newest first.
+// limit sets the page size (1-200, 0 for the server default) and since
+// returns only messages with an ID lower than the given one (0 for the first page).
+func (c *Client) ListApplicationMessages(ctx context.Context, appID, limit, since int) (*MessagePage, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+	if since > 0 {
+		query.Set("since", strconv.Itoa(since))
+	}
+
+	endpoint := fmt.Sprintf("/application/%d/message", appID)
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var page MessagePage
+	if err := c.do(ctx, "GET", endpoint, nil, "", &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// DeleteMessage deletes a single message.
+func (c *Client) DeleteMessage(ctx context.Context, messageID int) error {
+	return c.do(ctx, "DELETE", fmt.Sprintf("/message/%d", messageID), nil, "", nil)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body io.Reader, contentType string, result any) error {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("gotify: create request: %w", err)
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("X-Gotify-Key", c.clientToken)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("gotify: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("gotify: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("gotify: decode response: %w", err)
+	}
+
+	return nil
+}
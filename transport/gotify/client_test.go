@@ -0,0 +1,118 @@
+package gotify
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestClientUploadApplicationImage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/application/5/image" {
+			t.Errorf("Unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if r.Header.Get("X-Gotify-Key") != "client-token" {
+			t.Errorf("Expected client token, got '%s'", r.Header.Get("X-Gotify-Key"))
+		}
+
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Fatalf("Expected file field, got: %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "icon.png" || string(content) != "PNGDATA" {
+			t.Errorf("Unexpected upload: %s %q", header.Filename, content)
+		}
+
+		w.Write([]byte(`{"id":5,"name":"Backup","image":"image/abc.png"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "client-token", server.Client())
+	application, err := client.UploadApplicationImage(context.Background(), 5, "icon.png", strings.NewReader("PNGDATA"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if application.ID != 5 || application.Image != "image/abc.png" {
+		t.Errorf("Unexpected application: %+v", application)
+	}
+}
+
+func TestClientUploadApplicationImageError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":"Not Found"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "client-token", server.Client())
+	_, err := client.UploadApplicationImage(context.Background(), 99, "icon.png", strings.NewReader("PNGDATA"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 404)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestClientListApplicationMessages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/application/3/message" {
+			t.Errorf("Unexpected path: %s", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("since") {
+		case "":
+			if r.URL.Query().Get("limit") != "2" {
+				t.Errorf("Expected limit 2, got '%s'", r.URL.Query().Get("limit"))
+			}
+			w.Write([]byte(`{"messages":[{"id":9,"appid":3,"message":"a"},{"id":7,"appid":3,"message":"b"}],
+				"paging":{"size":2,"since":0,"limit":2,"next":"http://example.com/application/3/message?limit=2&since=7"}}`))
+		case "7":
+			w.Write([]byte(`{"messages":[{"id":4,"appid":3,"message":"c"}],"paging":{"size":1,"since":7,"limit":2}}`))
+		default:
+			t.Errorf("Unexpected since: %s", r.URL.Query().Get("since"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "client-token", server.Client())
+
+	var ids []int
+	since := 0
+	for {
+		page, err := client.ListApplicationMessages(context.Background(), 3, 2, since)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		for _, message := range page.Messages {
+			ids = append(ids, message.ID)
+		}
+		if !page.HasNext() {
+			break
+		}
+		since = page.NextSince()
+	}
+
+	if len(ids) != 3 || ids[0] != 9 || ids[1] != 7 || ids[2] != 4 {
+		t.Errorf("Unexpected message IDs: %v", ids)
+	}
+}
+
+func TestClientDeleteMessage(t *testing.T) {
+	var method, path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method, path = r.Method, r.URL.Path
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "client-token", server.Client())
+	if err := client.DeleteMessage(context.Background(), 7); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if method != "DELETE" || path != "/message/7" {
+		t.Errorf("Unexpected request: %s %s", method, path)
+	}
+}
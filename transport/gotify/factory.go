@@ -10,6 +10,11 @@ import (
 
 func init() {
 	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("gotify", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
 }
 
 // TransportFactory creates Gotify transports from DSN.
@@ -30,9 +35,11 @@ func NewTransportFactory(client *http.Client) *TransportFactory {
 // Create creates a Gotify transport from a DSN.
 // DSN format: gotify://<token>@<host> or gotify://<token>@default
 // Example: gotify://A1b2C3d4@mygotify.com or gotify://A1b2C3d4@default
+//
+// Plain HTTP servers can be reached with gotify+http://<token>@<host> or ?scheme=http.
 func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
 	scheme := dsn.GetScheme()
-	if scheme != "gotify" {
+	if !f.Supports(dsn) {
 		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
 	}
 
@@ -62,6 +69,15 @@ func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterfac
 		transport.SetPort(port)
 	}
 
+	urlScheme := dsn.GetOption("scheme", "https")
+	if scheme == "gotify+http" {
+		urlScheme = "http"
+	}
+	if urlScheme != "https" && urlScheme != "http" {
+		return nil, fmt.Errorf("invalid DSN: Unsupported URL scheme \"%s\" (supported: https, http). DSN: %s", urlScheme, dsn.GetOriginalDSN())
+	}
+	transport.SetScheme(urlScheme)
+
 	return transport, nil
 }
 
@@ -77,5 +93,5 @@ func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
 
 // GetSupportedSchemes returns the supported DSN schemes.
 func (f *TransportFactory) GetSupportedSchemes() []string {
-	return []string{"gotify"}
+	return []string{"gotify", "gotify+http"}
 }
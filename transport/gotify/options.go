@@ -72,3 +72,8 @@ func (o *Options) AddExtra(key string, value any) *Options {
 func (o *Options) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.options)
 }
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"priority": 0,
+}
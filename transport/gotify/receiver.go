@@ -0,0 +1,209 @@
+package gotify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is a message received from the Gotify stream.
+type Message struct {
+	ID       int            `json:"id"`
+	AppID    int            `json:"appid"`
+	Message  string         `json:"message"`
+	Title    string         `json:"title"`
+	Priority int            `json:"priority"`
+	Extras   map[string]any `json:"extras,omitempty"`
+	Date     time.Time      `json:"date"`
+}
+
+// Receiver receives messages from Gotify's /stream WebSocket endpoint.
+// It requires a client token, as application tokens cannot read messages.
+type Receiver struct {
+	baseURL     string
+	clientToken string
+	client      *http.Client
+
+	mu        sync.Mutex
+	err       error
+	listeners map[*wsConn]struct{}
+	closing   bool
+	wg        sync.WaitGroup
+}
+
+// NewReceiver creates a new Gotify stream receiver.
+// baseURL is the server URL including scheme (e.g., https://gotify.example.com).
+func NewReceiver(baseURL, clientToken string, client *http.Client) *Receiver {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Receiver{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		clientToken: clientToken,
+		client:      client,
+	}
+}
+
+// Listen connects to the stream and delivers incoming messages over the returned channel.
+// The channel is closed when the context is cancelled or the connection is lost;
+// Err reports the reason afterwards.
+func (r *Receiver) Listen(ctx context.Context) (<-chan *Message, error) {
+	conn, err := r.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	if r.listeners == nil {
+		r.listeners = make(map[*wsConn]struct{})
+	}
+	r.listeners[conn] = struct{}{}
+	r.wg.Add(1)
+	r.mu.Unlock()
+
+	messages := make(chan *Message)
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.close()
+		case <-done:
+		}
+	}()
+
+	go func() {
+		defer r.wg.Done()
+		defer close(messages)
+		defer close(done)
+		defer func() { _ = conn.rwc.Close() }()
+		defer func() {
+			r.mu.Lock()
+			delete(r.listeners, conn)
+			r.mu.Unlock()
+		}()
+
+		for {
+			data, err := conn.readMessage()
+			if err != nil {
+				if ctx.Err() != nil {
+					err = ctx.Err()
+				} else if err == io.EOF || r.isClosing() {
+					err = nil
+				}
+				r.setErr(err)
+				return
+			}
+
+			var message Message
+			if err := json.Unmarshal(data, &message); err != nil {
+				r.setErr(fmt.Errorf("gotify: decode stream message: %w", err))
+				return
+			}
+
+			select {
+			case messages <- &message:
+			case <-ctx.Done():
+				r.setErr(ctx.Err())
+				return
+			}
+		}
+	}()
+
+	return messages, nil
+}
+
+// Close closes all connections opened by Listen and waits until their channels are closed,
+// or until the context is done.
+func (r *Receiver) Close(ctx context.Context) error {
+	r.mu.Lock()
+	r.closing = true
+	for conn := range r.listeners {
+		_ = conn.close()
+	}
+	r.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		r.wg.Wait()
+		close(done)
+	}()
+
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	r.mu.Lock()
+	r.closing = false
+	r.mu.Unlock()
+	return err
+}
+
+func (r *Receiver) isClosing() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.closing
+}
+
+// Err returns the error that terminated the last Listen call, if any.
+func (r *Receiver) Err() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.err
+}
+
+func (r *Receiver) setErr(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.err = err
+}
+
+func (r *Receiver) connect(ctx context.Context) (*wsConn, error) {
+	key, err := newWebSocketKey()
+	if err != nil {
+		return nil, fmt.Errorf("gotify: generate websocket key: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", r.baseURL+"/stream", nil)
+	if err != nil {
+		return nil, fmt.Errorf("gotify: create stream request: %w", err)
+	}
+
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "websocket")
+	req.Header.Set("Sec-WebSocket-Version", "13")
+	req.Header.Set("Sec-WebSocket-Key", key)
+	req.Header.Set("X-Gotify-Key", r.clientToken)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("gotify: connect stream: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer func() { _ = resp.Body.Close() }()
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("gotify: stream error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	rwc, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("gotify: stream connection is not writable")
+	}
+
+	if resp.Header.Get("Sec-WebSocket-Accept") != acceptKey(key) {
+		_ = rwc.Close()
+		return nil, fmt.Errorf("gotify: invalid websocket handshake")
+	}
+
+	return &wsConn{rwc: rwc}, nil
+}
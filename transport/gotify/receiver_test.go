@@ -0,0 +1,161 @@
+package gotify
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// writeServerFrame writes an unmasked frame as a WebSocket server would.
+func writeServerFrame(w *bufio.Writer, opcode byte, payload string) {
+	w.WriteByte(0x80 | opcode)
+	w.WriteByte(byte(len(payload)))
+	w.WriteString(payload)
+	w.Flush()
+}
+
+func newStreamServer(t *testing.T, frames func(rw *bufio.ReadWriter)) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/stream" {
+			t.Errorf("Expected path '/stream', got '%s'", r.URL.Path)
+		}
+		if r.Header.Get("X-Gotify-Key") != "client-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"Unauthorized"}`))
+			return
+		}
+
+		conn, rw, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Fatalf("Hijack failed: %v", err)
+		}
+		defer conn.Close()
+
+		rw.WriteString("HTTP/1.1 101 Switching Protocols\r\n")
+		rw.WriteString("Upgrade: websocket\r\n")
+		rw.WriteString("Connection: Upgrade\r\n")
+		rw.WriteString("Sec-WebSocket-Accept: " + acceptKey(r.Header.Get("Sec-WebSocket-Key")) + "\r\n\r\n")
+		rw.Flush()
+
+		frames(rw)
+	}))
+}
+
+func TestReceiverListen(t *testing.T) {
+	server := newStreamServer(t, func(rw *bufio.ReadWriter) {
+		writeServerFrame(rw.Writer, opPing, "")
+		writeServerFrame(rw.Writer, opText, `{"id":1,"appid":2,"message":"restart","title":"cmd","priority":5}`)
+		writeServerFrame(rw.Writer, opText, `{"id":2,"appid":2,"message":"status"}`)
+		writeServerFrame(rw.Writer, opClose, "")
+		// Wait for the client's close reply
+		rw.Reader.ReadByte()
+	})
+	defer server.Close()
+
+	receiver := NewReceiver(server.URL+"/", "client-token", server.Client())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	messages, err := receiver.Listen(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	var received []*Message
+	for message := range messages {
+		received = append(received, message)
+	}
+
+	if len(received) != 2 {
+		t.Fatalf("Expected 2 messages, got %d", len(received))
+	}
+	if received[0].ID != 1 || received[0].AppID != 2 || received[0].Message != "restart" || received[0].Priority != 5 {
+		t.Errorf("Unexpected first message: %+v", received[0])
+	}
+	if received[1].Message != "status" {
+		t.Errorf("Unexpected second message: %+v", received[1])
+	}
+	if receiver.Err() != nil {
+		t.Errorf("Expected no error after normal close, got: %v", receiver.Err())
+	}
+}
+
+func TestReceiverContextCancellation(t *testing.T) {
+	server := newStreamServer(t, func(rw *bufio.ReadWriter) {
+		// Block until the client closes the connection
+		for {
+			if _, err := rw.Reader.ReadByte(); err != nil {
+				return
+			}
+		}
+	})
+	defer server.Close()
+
+	receiver := NewReceiver(server.URL, "client-token", server.Client())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	messages, err := receiver.Listen(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	cancel()
+
+	select {
+	case _, ok := <-messages:
+		if ok {
+			t.Error("Expected channel to be closed")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for channel to close")
+	}
+
+	if receiver.Err() != context.Canceled {
+		t.Errorf("Expected context.Canceled, got: %v", receiver.Err())
+	}
+}
+
+func TestReceiverClose(t *testing.T) {
+	server := newStreamServer(t, func(rw *bufio.ReadWriter) {
+		for {
+			if _, err := rw.Reader.ReadByte(); err != nil {
+				return
+			}
+		}
+	})
+	defer server.Close()
+
+	receiver := NewReceiver(server.URL, "client-token", server.Client())
+	messages, err := receiver.Listen(context.Background())
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := receiver.Close(ctx); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if _, ok := <-messages; ok {
+		t.Error("Expected channel to be closed")
+	}
+	if receiver.Err() != nil {
+		t.Errorf("Expected no error after Close, got: %v", receiver.Err())
+	}
+}
+
+func TestReceiverUnauthorized(t *testing.T) {
+	server := newStreamServer(t, func(rw *bufio.ReadWriter) {})
+	defer server.Close()
+
+	receiver := NewReceiver(server.URL, "wrong-token", server.Client())
+	_, err := receiver.Listen(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "stream error (status 401)") {
+		t.Errorf("Expected unauthorized error, got: %v", err)
+	}
+}
@@ -3,18 +3,28 @@ package gotify
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 
 	"github.com/shyim/go-notifier"
 )
 
+// DefaultPriorityMapping translates message importance into Gotify priorities.
+var DefaultPriorityMapping = map[string]int{
+	notifier.ImportanceUrgent: 8,
+	notifier.ImportanceHigh:   6,
+	notifier.ImportanceMedium: 4,
+	notifier.ImportanceLow:    2,
+}
+
 // Transport sends messages via Gotify API.
 type Transport struct {
 	*notifier.AbstractTransport
-	token string
+	token           string
+	scheme          string
+	priorityMapping map[string]int
 }
 
 // NewTransport creates a new Gotify transport.
@@ -25,9 +35,23 @@ func NewTransport(token string, client *http.Client) *Transport {
 	return &Transport{
 		AbstractTransport: notifier.NewAbstractTransport(client),
 		token:             token,
+		scheme:            "https",
+		priorityMapping:   DefaultPriorityMapping,
 	}
 }
 
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+// SetPriorityMapping overrides the importance to priority mapping.
+func (t *Transport) SetPriorityMapping(mapping map[string]int) *Transport {
+	t.priorityMapping = mapping
+	return t
+}
+
 func (t *Transport) String() string {
 	endpoint := t.getEndpoint()
 	return fmt.Sprintf("gotify://%s", endpoint)
@@ -46,14 +70,29 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 
 	options := make(map[string]any)
 	if opts, ok := chatMsg.GetOptions("gotify").(*Options); ok {
-		options = opts.ToMap()
+		// Copied so reused options don't keep the title and message of this one
+		options = maps.Clone(opts.ToMap())
 	}
 
 	// Gotify API expects title and message
-	if _, ok := options["title"]; !ok {
+	_, hasTitle := options["title"]
+	switch {
+	case hasTitle:
+		options["message"] = chatMsg.GetText()
+	case chatMsg.GetContent() != "":
+		options["title"] = chatMsg.GetSubject()
+		options["message"] = chatMsg.GetContent()
+	default:
 		options["title"] = "Notification"
+		options["message"] = chatMsg.GetSubject()
+	}
+
+	// An explicit priority option takes precedence over the message importance
+	if _, ok := options["priority"]; !ok {
+		if priority, ok := t.priorityMapping[chatMsg.GetImportance()]; ok {
+			options["priority"] = priority
+		}
 	}
-	options["message"] = chatMsg.GetSubject()
 
 	// Filter out empty values
 	filteredOptions := make(map[string]any)
@@ -63,12 +102,12 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 		}
 	}
 
-	jsonBody, err := json.Marshal(filteredOptions)
+	jsonBody, err := notifier.JSONMarshal(filteredOptions)
 	if err != nil {
 		return nil, fmt.Errorf("gotify: marshal options: %w", err)
 	}
 
-	endpoint := fmt.Sprintf("https://%s/message", t.getEndpoint())
+	endpoint := fmt.Sprintf("%s://%s/message", t.scheme, t.getEndpoint())
 	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
 	if err != nil {
 		return nil, fmt.Errorf("gotify: create request: %w", err)
@@ -77,12 +116,21 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("X-Gotify-Key", t.token)
 
-	resp, err := t.AbstractTransport.GetClient().Do(req)
+	resp, err := t.AbstractTransport.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("gotify: send request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "gotify",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("gotify: API error (status %d): %s", resp.StatusCode, string(respBody))
@@ -92,11 +140,13 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 		ID int `json:"id"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
 		return nil, fmt.Errorf("gotify: decode response: %w", err)
 	}
 
 	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
 	sentMessage.SetMessageID(fmt.Sprintf("%d", result.ID))
 	sentMessage.SetInfo("priority", filteredOptions["priority"])
 	sentMessage.SetInfo("title", filteredOptions["title"])
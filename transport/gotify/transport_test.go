@@ -239,6 +239,55 @@ func TestTransportSendHTTPErrors(t *testing.T) {
 	}
 }
 
+// TestTransportSendReusedOptions tests that options shared by messages are not modified
+func TestTransportSendReusedOptions(t *testing.T) {
+	var payload map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		payload = nil
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	transport := createTestTransport("token", server)
+	options := NewOptions().AddExtra("client::display", map[string]any{"contentType": "text/markdown"})
+
+	for _, subject := range []string{"first", "second"} {
+		msg := notifier.NewChatMessage(subject).Content(subject+" content").WithOptions("gotify", options)
+		if _, err := transport.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if payload["title"] != subject || payload["message"] != subject+" content" {
+			t.Errorf("Expected the %s message, got: %v", subject, payload)
+		}
+	}
+
+	if _, ok := options.ToMap()["title"]; ok {
+		t.Errorf("Expected the options not to be modified, got: %v", options.ToMap())
+	}
+}
+
+// TestTransportSendRateLimited tests that a 429 response returns a RateLimitError
+func TestTransportSendRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"error": "Too Many Requests", "errorCode": 429, "errorDescription": "rate limit exceeded"}`))
+	}))
+	defer server.Close()
+
+	transport := createTestTransport("token", server)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Test message"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+	if rateLimitErr.Transport != "gotify" || rateLimitErr.RetryAfter != 10*time.Second {
+		t.Errorf("Unexpected RateLimitError: %+v", rateLimitErr)
+	}
+}
+
 // TestTransportSendNetworkError tests network-level errors using custom RoundTripper
 func TestTransportSendNetworkError(t *testing.T) {
 	tests := []struct {
@@ -487,6 +536,33 @@ func TestTransportSendDefaultTitle(t *testing.T) {
 	}
 }
 
+// TestTransportSendContent tests that the subject becomes the title when a content body is set
+func TestTransportSendContent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var payload map[string]interface{}
+		json.Unmarshal(body, &payload)
+
+		if payload["title"] != "Deploy finished" {
+			t.Errorf("Expected subject as title, got %v", payload["title"])
+		}
+		if payload["message"] != "All 12 services are healthy" {
+			t.Errorf("Expected content as message, got %v", payload["message"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": 1}`))
+	}))
+	defer server.Close()
+
+	transport := createTestTransport("token", server)
+
+	msg := notifier.NewChatMessage("Deploy finished").Content("All 12 services are healthy")
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected successful send, got error: %v", err)
+	}
+}
+
 // TestTransportSendEmptyValuesFiltered tests that empty values are filtered out
 func TestTransportSendEmptyValuesFiltered(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -753,6 +829,80 @@ func TestPriorityClamping(t *testing.T) {
 	}
 }
 
+func TestTransportSendImportancePriority(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  *notifier.ChatMessage
+		mapping  map[string]int
+		expected any
+	}{
+		{"urgent", notifier.NewChatMessage("Test").Importance(notifier.ImportanceUrgent), nil, float64(8)},
+		{"low", notifier.NewChatMessage("Test").Importance(notifier.ImportanceLow), nil, float64(2)},
+		{"no importance", notifier.NewChatMessage("Test"), nil, nil},
+		{"explicit priority wins", notifier.NewChatMessage("Test").Importance(notifier.ImportanceUrgent).
+			WithOptions("gotify", NewOptions().Priority(1)), nil, float64(1)},
+		{"custom mapping", notifier.NewChatMessage("Test").Importance(notifier.ImportanceHigh),
+			map[string]int{notifier.ImportanceHigh: 10}, float64(10)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				json.NewDecoder(r.Body).Decode(&body)
+				w.Write([]byte(`{"id":1}`))
+			}))
+			defer server.Close()
+
+			transport := createTestTransport("token", server)
+			if tt.mapping != nil {
+				transport.SetPriorityMapping(tt.mapping)
+			}
+
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if body["priority"] != tt.expected {
+				t.Errorf("Expected priority %v, got %v", tt.expected, body["priority"])
+			}
+		})
+	}
+}
+
+func TestTransportSendImportancePriorityReusedOptions(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	transport := createTestTransport("token", server)
+	options := NewOptions().Title("Backups")
+
+	tests := []struct {
+		importance string
+		expected   any
+	}{
+		{notifier.ImportanceUrgent, float64(8)},
+		{notifier.ImportanceLow, float64(2)},
+	}
+	for _, tt := range tests {
+		msg := notifier.NewChatMessage("Backup").Importance(tt.importance).WithOptions("gotify", options)
+		if _, err := transport.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if body["priority"] != tt.expected {
+			t.Errorf("%s: expected priority %v, got %v", tt.importance, tt.expected, body["priority"])
+		}
+	}
+
+	if _, ok := options.ToMap()["priority"]; ok {
+		t.Errorf("Expected the options not to be modified, got: %v", options.ToMap())
+	}
+}
+
 func TestDSN(t *testing.T) {
 	dsn, err := notifier.NewDSN("gotify://A1b2C3d4@gotify.example.com")
 	if err != nil {
@@ -797,6 +947,49 @@ func TestFactory(t *testing.T) {
 	}
 }
 
+func TestFactoryPlainHTTP(t *testing.T) {
+	var requestURL string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.Path
+		w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	host := strings.TrimPrefix(server.URL, "http://")
+	factory := NewTransportFactory(server.Client())
+
+	for _, dsnString := range []string{
+		"gotify+http://token@" + host,
+		"gotify://token@" + host + "?scheme=http",
+	} {
+		dsn, _ := notifier.NewDSN(dsnString)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support %s", dsnString)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport: %v", err)
+		}
+
+		if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Test")); err != nil {
+			t.Fatalf("Expected no error for %s, got: %v", dsnString, err)
+		}
+		if requestURL != "/message" {
+			t.Errorf("Expected path '/message', got '%s'", requestURL)
+		}
+	}
+}
+
+func TestFactoryInvalidURLScheme(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("gotify://token@gotify.example.com?scheme=ftp")
+
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Factory should return error for unsupported URL scheme")
+	}
+}
+
 func TestMissingToken(t *testing.T) {
 	factory := NewTransportFactory(nil)
 	dsn, _ := notifier.NewDSN("gotify://@gotify.example.com")
@@ -0,0 +1,81 @@
+package grafanaoncall
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("grafanaoncall", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates Grafana OnCall transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Grafana OnCall transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Grafana OnCall transport from a DSN.
+// DSN format: grafanaoncall://<token>@<host>[/<base_path>]
+// Example: grafanaoncall://abc123@oncall-prod-us-central-0.grafana.net/oncall
+//
+// The token, host and base path come from the integration URL
+// (https://<host>/<base_path>/integrations/v1/formatted_webhook/<token>/).
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "grafanaoncall" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	token := dsn.GetUser()
+	if token == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	// Every stack has its own OnCall instance, so the host is required
+	host := dsn.GetHost()
+	if host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing OnCall host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(token, f.client)
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+	transport.SetBasePath(dsn.GetPath())
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"grafanaoncall"}
+}
@@ -0,0 +1,68 @@
+package grafanaoncall
+
+import (
+	"encoding/json"
+)
+
+// Alert states.
+const (
+	StateAlerting = "alerting"
+	StateOK       = "ok"
+)
+
+// Options implements MessageOptionsInterface for Grafana OnCall.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// AlertUID sets the ID grouping alerts into one alert group. Alerts of a group are resolved
+// together by an alert with the same ID and StateOK.
+func (o *Options) AlertUID(uid string) *Options {
+	o.options["alert_uid"] = uid
+	return o
+}
+
+// State sets the alert state (StateAlerting or StateOK).
+func (o *Options) State(state string) *Options {
+	o.options["state"] = state
+	return o
+}
+
+// Resolve resolves the alert group with the ID.
+func (o *Options) Resolve(uid string) *Options {
+	return o.AlertUID(uid).State(StateOK)
+}
+
+// ImageURL sets an image shown in the alert.
+func (o *Options) ImageURL(url string) *Options {
+	o.options["image_url"] = url
+	return o
+}
+
+// Link sets the link to details in the system that raised the alert.
+func (o *Options) Link(url string) *Options {
+	o.options["link_to_upstream_details"] = url
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
@@ -0,0 +1,115 @@
+package grafanaoncall
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends alerts to a Grafana OnCall formatted webhook integration.
+type Transport struct {
+	*notifier.AbstractTransport
+	token    string
+	basePath string
+}
+
+// NewTransport creates a new Grafana OnCall transport from the token of the integration URL
+// (.../integrations/v1/formatted_webhook/<token>/). The OnCall host is set with SetHost.
+func NewTransport(token string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+	}
+}
+
+// SetBasePath sets the path OnCall is served under, e.g. "/oncall" on Grafana Cloud.
+func (t *Transport) SetBasePath(path string) *Transport {
+	t.basePath = strings.TrimSuffix(path, "/")
+	if t.basePath != "" && !strings.HasPrefix(t.basePath, "/") {
+		t.basePath = "/" + t.basePath
+	}
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("grafanaoncall://%s%s", t.GetEndpoint(), t.basePath)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("grafanaoncall: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	payload := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("grafanaoncall").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			payload[k] = v
+		}
+	}
+	delete(payload, "recipient_id")
+
+	payload["title"] = chatMsg.GetSubject()
+	if content := chatMsg.GetContent(); content != "" {
+		payload["message"] = content
+	}
+	if _, ok := payload["state"]; !ok {
+		payload["state"] = StateAlerting
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("grafanaoncall: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s/integrations/v1/formatted_webhook/%s/", t.GetEndpoint(), t.basePath, url.PathEscape(t.token))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("grafanaoncall: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("grafanaoncall: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "grafanaoncall",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("grafanaoncall: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	// The response body is a plain "Ok."
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	if uid, ok := payload["alert_uid"].(string); ok {
+		sentMessage.SetMessageID(uid)
+	}
+	sentMessage.SetInfo("state", payload["state"])
+
+	return sentMessage, nil
+}
@@ -0,0 +1,142 @@
+package grafanaoncall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// newTransport returns a transport recording the request and its JSON body.
+func newTransport(req **http.Request, body *map[string]any) *Transport {
+	client := newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusOK, "Ok."), nil
+	})
+	transport := NewTransport("abc123", client)
+	transport.SetHost("oncall-prod-us-central-0.grafana.net")
+	transport.SetBasePath("oncall")
+	return transport
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := newTransport(&req, &body)
+
+	msg := notifier.NewChatMessage("Disk full on /var").
+		Content("95% used").
+		WithOptions("grafanaoncall", NewOptions().AlertUID("disk-api-1").ImageURL("https://example.com/graph.png").Link("https://example.com/dashboards/disk"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://oncall-prod-us-central-0.grafana.net/oncall/integrations/v1/formatted_webhook/abc123/" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+
+	data, _ := json.Marshal(body)
+	expected := `{"alert_uid":"disk-api-1","image_url":"https://example.com/graph.png","link_to_upstream_details":"https://example.com/dashboards/disk",` +
+		`"message":"95% used","state":"alerting","title":"Disk full on /var"}`
+	if string(data) != expected {
+		t.Errorf("Unexpected body:\n%s", data)
+	}
+	if sentMsg.GetMessageID() != "disk-api-1" || sentMsg.GetInfo("state") != StateAlerting {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo("state"))
+	}
+}
+
+func TestTransportResolve(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := newTransport(&req, &body)
+
+	msg := notifier.NewChatMessage("Disk cleaned up").WithOptions("grafanaoncall", NewOptions().Resolve("disk-api-1"))
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if body["state"] != StateOK || body["alert_uid"] != "disk-api-1" {
+		t.Errorf("Unexpected body: %v", body)
+	}
+}
+
+func TestTransportErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusNotFound, `{"detail":"Not found."}`), nil
+	})
+
+	transport := NewTransport("wrong", client)
+	transport.SetHost("oncall.example.com")
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Alert"))
+	if err == nil || err.Error() != `grafanaoncall: API error (status 404): {"detail":"Not found."}` {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, "Too many requests"), nil
+	})
+
+	transport = NewTransport("abc123", client)
+	transport.SetHost("oncall.example.com")
+	_, err = transport.Send(context.Background(), notifier.NewChatMessage("Alert"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("grafanaoncall://abc123@oncall-prod-us-central-0.grafana.net/oncall/")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support grafanaoncall DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "grafanaoncall://oncall-prod-us-central-0.grafana.net/oncall" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	for _, invalid := range []string{"grafanaoncall://oncall.example.com", "grafanaoncall://abc123@default"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+			t.Errorf("Expected incomplete DSN error for %s, got: %v", invalid, err)
+		}
+	}
+}
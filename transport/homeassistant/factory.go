@@ -0,0 +1,89 @@
+package homeassistant
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("homeassistant", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Home Assistant transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Home Assistant transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Home Assistant transport from a DSN.
+// DSN format: homeassistant://<token>@<host>[:<port>][?service=<service>]
+// Example: homeassistant://eyJhbGciOi...@homeassistant.local:8123?service=mobile_app_pixel_7
+//
+// Plain HTTP servers can be reached with homeassistant+http://<token>@<host> or ?scheme=http.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	token := dsn.GetUser()
+	if token == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+
+	transport := NewTransport(token, dsn.GetOption("service"), f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	urlScheme := dsn.GetOption("scheme", "https")
+	if scheme == "homeassistant+http" {
+		urlScheme = "http"
+	}
+	if urlScheme != "https" && urlScheme != "http" {
+		return nil, fmt.Errorf("invalid DSN: Unsupported URL scheme \"%s\" (supported: https, http). DSN: %s", urlScheme, dsn.GetOriginalDSN())
+	}
+	transport.SetScheme(urlScheme)
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"homeassistant", "homeassistant+http"}
+}
@@ -0,0 +1,106 @@
+package homeassistant
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Home Assistant.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Service calls notify.<service> instead of the configured service.
+func (o *Options) Service(service string) *Options {
+	o.options["service"] = service
+	return o
+}
+
+// Title sets the notification title.
+func (o *Options) Title(title string) *Options {
+	o.options["title"] = title
+	return o
+}
+
+// Target adds a notification target, its meaning depends on the notify platform.
+func (o *Options) Target(targets ...string) *Options {
+	existing, _ := o.options["target"].([]string)
+	o.options["target"] = append(existing, targets...)
+	return o
+}
+
+// Data sets a key of the platform specific data payload.
+func (o *Options) Data(key string, value any) *Options {
+	o.data()[key] = value
+	return o
+}
+
+// Image attaches an image to mobile app notifications.
+func (o *Options) Image(url string) *Options {
+	return o.Data("image", url)
+}
+
+// Tag replaces an earlier mobile app notification with the same tag.
+func (o *Options) Tag(tag string) *Options {
+	return o.Data("tag", tag)
+}
+
+// ClickAction opens the URL or dashboard path when the mobile app notification is tapped
+// (url on iOS, clickAction on Android).
+func (o *Options) ClickAction(url string) *Options {
+	o.data()["url"] = url
+	o.data()["clickAction"] = url
+	return o
+}
+
+// Action adds an actionable notification button to mobile app notifications.
+// The action identifier is sent back in a mobile_app_notification_action event.
+// When uri is set, the button opens it instead.
+func (o *Options) Action(action, title, uri string) *Options {
+	button := map[string]any{
+		"action": action,
+		"title":  title,
+	}
+	if uri != "" {
+		button["action"] = "URI"
+		button["uri"] = uri
+	}
+	actions, _ := o.data()["actions"].([]map[string]any)
+	o.data()["actions"] = append(actions, button)
+	return o
+}
+
+func (o *Options) data() map[string]any {
+	data, ok := o.options["data"].(map[string]any)
+	if !ok {
+		data = make(map[string]any)
+		o.options["data"] = data
+	}
+	return data
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"target": []string(nil),
+}
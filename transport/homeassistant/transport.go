@@ -0,0 +1,141 @@
+package homeassistant
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the default port of the Home Assistant HTTP API.
+const DefaultPort = 8123
+
+// DefaultService is the notify service used when none is configured, which notifies all default targets.
+const DefaultService = "notify"
+
+// Transport sends messages by calling a notify service of the Home Assistant REST API.
+type Transport struct {
+	*notifier.AbstractTransport
+	token   string
+	service string
+	scheme  string
+}
+
+// NewTransport creates a new Home Assistant transport calling notify.<service>
+// (e.g., "mobile_app_pixel_7") with a long-lived access token.
+func NewTransport(token, service string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if service == "" {
+		service = DefaultService
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		service:           service,
+		scheme:            "https",
+	}
+}
+
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("homeassistant://%s?service=%s", t.getEndpoint(), t.service)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("homeassistant: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("homeassistant").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	service := t.service
+	if value, ok := options["service"].(string); ok && value != "" {
+		service = value
+	}
+
+	body := map[string]any{
+		"message": chatMsg.GetSubject(),
+	}
+	if title, ok := options["title"].(string); ok && title != "" {
+		body["title"] = title
+		body["message"] = chatMsg.GetText()
+	} else if content := chatMsg.GetContent(); content != "" {
+		body["title"] = chatMsg.GetSubject()
+		body["message"] = content
+	}
+	if targets, ok := options["target"].([]string); ok && len(targets) > 0 {
+		body["target"] = targets
+	}
+
+	data, _ := options["data"].(map[string]any)
+	// Urgent and high importance are delivered immediately by the companion apps
+	if importance := chatMsg.GetImportance(); importance == notifier.ImportanceUrgent || importance == notifier.ImportanceHigh {
+		if data == nil {
+			data = make(map[string]any)
+		}
+		if _, ok := data["priority"]; !ok {
+			data["priority"] = "high"
+			data["ttl"] = 0
+		}
+	}
+	if len(data) > 0 {
+		body["data"] = data
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("homeassistant: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s://%s/api/services/notify/%s", t.scheme, t.getEndpoint(), service)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("homeassistant: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("homeassistant: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("homeassistant: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("service", "notify."+service)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return fmt.Sprintf("homeassistant.local:%d", DefaultPort)
+	}
+	return endpoint
+}
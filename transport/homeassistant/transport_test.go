@@ -0,0 +1,177 @@
+package homeassistant
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "http://ha.local:8123/api/services/notify/mobile_app_pixel_7" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "Bearer token" {
+			t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(200, `[]`), nil
+	})
+
+	transport := NewTransport("token", "mobile_app_pixel_7", client).SetScheme("http")
+	transport.SetHost("ha.local")
+	transport.SetPort(8123)
+
+	msg := notifier.NewChatMessage("Front door opened").
+		Importance(notifier.ImportanceUrgent).
+		WithOptions("homeassistant", NewOptions().
+			Title("Security").
+			Tag("front-door").
+			Action("ALARM", "Sound alarm", "").
+			Action("OPEN_CAMERA", "Camera", "/lovelace/cameras"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetInfo("service") != "notify.mobile_app_pixel_7" {
+		t.Errorf("Unexpected info: %v", sentMsg.GetInfo())
+	}
+
+	if capturedBody["message"] != "Front door opened" || capturedBody["title"] != "Security" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	data, _ := capturedBody["data"].(map[string]any)
+	if data["tag"] != "front-door" || data["priority"] != "high" || data["ttl"] != float64(0) {
+		t.Errorf("Unexpected data: %v", data)
+	}
+	actions, _ := data["actions"].([]any)
+	if len(actions) != 2 {
+		t.Fatalf("Expected 2 actions, got %v", data["actions"])
+	}
+	if uriAction, _ := actions[1].(map[string]any); uriAction["action"] != "URI" || uriAction["uri"] != "/lovelace/cameras" {
+		t.Errorf("Unexpected URI action: %v", uriAction)
+	}
+}
+
+func TestTransportSendTargets(t *testing.T) {
+	var capturedURL string
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedURL = req.URL.String()
+		_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(200, `[]`), nil
+	})
+
+	transport := NewTransport("token", "", client)
+	transport.SetHost("ha.example.com")
+
+	msg := notifier.NewChatMessage("Dinner is ready").
+		WithOptions("homeassistant", NewOptions().Service("alexa_media").Target("media_player.kitchen", "media_player.office"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedURL != "https://ha.example.com/api/services/notify/alexa_media" {
+		t.Errorf("Unexpected URL: %s", capturedURL)
+	}
+	targets, _ := capturedBody["target"].([]any)
+	if len(targets) != 2 || targets[0] != "media_player.kitchen" {
+		t.Errorf("Unexpected targets: %v", capturedBody["target"])
+	}
+	if _, ok := capturedBody["data"]; ok {
+		t.Errorf("Expected no data, got %v", capturedBody["data"])
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(400, `{"message":"Service notify.unknown not found."}`), nil
+	})
+
+	transport := NewTransport("token", "unknown", client)
+	transport.SetHost("ha.example.com")
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "homeassistant: API error (status 400)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	_, err = transport.Send(context.Background(), notifier.NewSmsMessage("+33612345678", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported message type") {
+		t.Errorf("Expected unsupported message error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	tests := []struct {
+		dsn      string
+		expected string
+		scheme   string
+	}{
+		{"homeassistant://token@ha.example.com", "homeassistant://ha.example.com?service=notify", "https"},
+		{"homeassistant://token@homeassistant.local:8123?service=mobile_app_pixel_7&scheme=http", "homeassistant://homeassistant.local:8123?service=mobile_app_pixel_7", "http"},
+		{"homeassistant+http://token@default?service=family", "homeassistant://homeassistant.local:8123?service=family", "http"},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+
+		if transport.String() != tt.expected {
+			t.Errorf("Expected string '%s', got '%s'", tt.expected, transport.String())
+		}
+		if transport.(*Transport).scheme != tt.scheme {
+			t.Errorf("Expected scheme %s, got %s", tt.scheme, transport.(*Transport).scheme)
+		}
+	}
+
+	for _, invalid := range []string{
+		"homeassistant://ha.example.com",
+		"homeassistant://token@ha.example.com?scheme=ftp",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package ifttt
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for IFTTT.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Event triggers the given event instead of the configured one.
+func (o *Options) Event(event string) *Options {
+	o.options["event"] = event
+	return o
+}
+
+// Value1 overrides the first ingredient, which defaults to the message subject.
+func (o *Options) Value1(value string) *Options {
+	o.options["value1"] = value
+	return o
+}
+
+// Value2 sets the second ingredient.
+func (o *Options) Value2(value string) *Options {
+	o.options["value2"] = value
+	return o
+}
+
+// Value3 sets the third ingredient.
+func (o *Options) Value3(value string) *Options {
+	o.options["value3"] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
@@ -0,0 +1,117 @@
+package ifttt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport triggers events of the IFTTT Maker Webhooks service.
+type Transport struct {
+	*notifier.AbstractTransport
+	key   string
+	event string
+}
+
+// NewTransport creates a new IFTTT transport triggering the given event with the Webhooks key.
+func NewTransport(key, event string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		key:               key,
+		event:             event,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("ifttt://%s?event=%s", t.getEndpoint(), t.event)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("ifttt: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("ifttt").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	event := t.event
+	if value, ok := options["event"].(string); ok && value != "" {
+		event = value
+	}
+
+	// value1 carries the subject and value2 the content unless overridden,
+	// applets reference them as {{Value1}} and {{Value2}}
+	body := map[string]string{
+		"value1": chatMsg.GetSubject(),
+	}
+	if content := chatMsg.GetContent(); content != "" {
+		body["value2"] = content
+	}
+	for _, key := range []string{"value1", "value2", "value3"} {
+		if value, ok := options[key].(string); ok {
+			body[key] = value
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("ifttt: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/trigger/%s/with/key/%s", t.getEndpoint(), url.PathEscape(event), url.PathEscape(t.key))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("ifttt: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ifttt: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "ifttt",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("ifttt: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("event", event)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "maker.ifttt.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,140 @@
+package ifttt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]string
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://maker.ifttt.com/trigger/server_alert/with/key/secret-key" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(200, "Congratulations! You've fired the server_alert event"), nil
+	})
+
+	transport := NewTransport("secret-key", "server_alert", client)
+
+	msg := notifier.NewChatMessage("Disk almost full").
+		WithOptions("ifttt", NewOptions().Value2("web-1").Value3("95%"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetInfo("event") != "server_alert" {
+		t.Errorf("Unexpected info: %v", sentMsg.GetInfo())
+	}
+	if capturedBody["value1"] != "Disk almost full" || capturedBody["value2"] != "web-1" || capturedBody["value3"] != "95%" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+}
+
+func TestTransportSendEventOverride(t *testing.T) {
+	var capturedPath string
+	var capturedBody map[string]string
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedPath = req.URL.Path
+		_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(200, "Congratulations!"), nil
+	})
+
+	transport := NewTransport("secret-key", "server_alert", client)
+
+	msg := notifier.NewChatMessage("Hello").
+		WithOptions("ifttt", NewOptions().Event("doorbell").Value1("Someone is at the door"))
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedPath != "/trigger/doorbell/with/key/secret-key" {
+		t.Errorf("Unexpected path: %s", capturedPath)
+	}
+	if capturedBody["value1"] != "Someone is at the door" {
+		t.Errorf("Expected value1 override, got %v", capturedBody)
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(401, `{"errors":[{"message":"You sent an invalid key."}]}`), nil
+	})
+
+	_, err := NewTransport("wrong", "server_alert", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "ifttt: API error (status 401)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(429, "Too Many Requests")
+		resp.Header.Set("Retry-After", "60")
+		return resp, nil
+	})
+
+	_, err = NewTransport("key", "server_alert", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	dsn, _ := notifier.NewDSN("ifttt://secret-key@default?event=server_alert")
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support ifttt scheme")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	expected := "ifttt://maker.ifttt.com?event=server_alert"
+	if transport.String() != expected {
+		t.Errorf("Expected string '%s', got '%s'", expected, transport.String())
+	}
+
+	for _, invalid := range []string{
+		"ifttt://default?event=server_alert",
+		"ifttt://secret-key@default",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
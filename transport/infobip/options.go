@@ -0,0 +1,67 @@
+package infobip
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Infobip.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the WhatsApp phone number to send a ChatMessage to.
+func (o *Options) Recipient(phone string) *Options {
+	o.options["recipient_id"] = phone
+	return o
+}
+
+// NotifyURL sets the URL delivery reports are sent to.
+func (o *Options) NotifyURL(url string) *Options {
+	o.options["notifyUrl"] = url
+	return o
+}
+
+// CallbackData sets custom data that is returned in delivery reports.
+func (o *Options) CallbackData(data string) *Options {
+	o.options["callbackData"] = data
+	return o
+}
+
+// Template sends an approved WhatsApp template instead of free-form text, which is
+// required outside the 24 hour customer service window. The placeholders fill
+// the template body in order.
+func (o *Options) Template(name, language string, placeholders ...string) *Options {
+	if placeholders == nil {
+		placeholders = []string{}
+	}
+	o.options["template"] = map[string]any{
+		"templateName": name,
+		"language":     language,
+		"templateData": map[string]any{
+			"body": map[string]any{"placeholders": placeholders},
+		},
+	}
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
@@ -0,0 +1,226 @@
+package infobip
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends SMS and WhatsApp messages via the Infobip API.
+// SmsMessage is sent as SMS, ChatMessage as WhatsApp message.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey       string
+	from         string
+	whatsappFrom string
+}
+
+// NewTransport creates a new Infobip transport. from is the default SMS sender.
+func NewTransport(apiKey, from string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		from:              from,
+		whatsappFrom:      from,
+	}
+}
+
+// SetWhatsAppFrom sets the registered WhatsApp sender number, if it differs from the SMS sender.
+func (t *Transport) SetWhatsAppFrom(from string) *Transport {
+	t.whatsappFrom = from
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("infobip://%s?from=%s", t.getEndpoint(), t.from)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	switch message.(type) {
+	case *notifier.SmsMessage, *notifier.ChatMessage:
+		return true
+	}
+	return false
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	switch msg := message.(type) {
+	case *notifier.SmsMessage:
+		return t.sendSMS(ctx, msg)
+	case *notifier.ChatMessage:
+		return t.sendWhatsApp(ctx, msg)
+	}
+	return nil, fmt.Errorf("infobip: unsupported message type %T, expected SmsMessage or ChatMessage", message)
+}
+
+func (t *Transport) sendSMS(ctx context.Context, message *notifier.SmsMessage) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("infobip").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	from := message.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+
+	sms := map[string]any{
+		"destinations": []map[string]string{{"to": strings.TrimPrefix(message.GetPhone(), "+")}},
+		"text":         message.GetSubject(),
+	}
+	if from != "" {
+		sms["from"] = from
+	}
+	for _, key := range []string{"notifyUrl", "callbackData"} {
+		if value, ok := options[key]; ok {
+			sms[key] = value
+		}
+	}
+
+	var result struct {
+		BulkID   string `json:"bulkId"`
+		Messages []struct {
+			MessageID string `json:"messageId"`
+			Status    struct {
+				GroupName string `json:"groupName"`
+			} `json:"status"`
+		} `json:"messages"`
+	}
+	raw, err := t.post(ctx, "/sms/2/text/advanced", map[string]any{"messages": []any{sms}}, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Messages) == 0 {
+		return nil, fmt.Errorf("infobip: API error: empty response")
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Messages[0].MessageID)
+	sentMessage.SetInfo("bulk_id", result.BulkID)
+	sentMessage.SetInfo("status", result.Messages[0].Status.GroupName)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) sendWhatsApp(ctx context.Context, message *notifier.ChatMessage) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("infobip").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	to := message.GetRecipientId()
+	if to == "" {
+		return nil, fmt.Errorf("infobip: missing WhatsApp recipient")
+	}
+	if t.whatsappFrom == "" {
+		return nil, fmt.Errorf("infobip: missing WhatsApp sender")
+	}
+
+	payload := map[string]any{
+		"from": t.whatsappFrom,
+		"to":   strings.TrimPrefix(to, "+"),
+	}
+	for _, key := range []string{"notifyUrl", "callbackData"} {
+		if value, ok := options[key]; ok {
+			payload[key] = value
+		}
+	}
+
+	path := "/whatsapp/1/message/text"
+	var body any = payload
+	if template, ok := options["template"]; ok {
+		// Templates are sent through the bulk endpoint
+		path = "/whatsapp/1/message/template"
+		payload["content"] = template
+		body = map[string]any{"messages": []any{payload}}
+	} else {
+		payload["content"] = map[string]any{"text": message.GetText()}
+	}
+
+	var result struct {
+		MessageID string `json:"messageId"`
+		Status    struct {
+			GroupName string `json:"groupName"`
+		} `json:"status"`
+		Messages []struct {
+			MessageID string `json:"messageId"`
+			Status    struct {
+				GroupName string `json:"groupName"`
+			} `json:"status"`
+		} `json:"messages"`
+	}
+	raw, err := t.post(ctx, path, body, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	messageID, status := result.MessageID, result.Status.GroupName
+	if len(result.Messages) > 0 {
+		messageID, status = result.Messages[0].MessageID, result.Messages[0].Status.GroupName
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(messageID)
+	sentMessage.SetInfo("status", status)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) post(ctx context.Context, path string, payload any, result any) (any, error) {
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("infobip: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", t.getEndpoint(), path)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("infobip: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "App "+t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("infobip: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "infobip",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("infobip: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, result)
+	if err != nil {
+		return nil, fmt.Errorf("infobip: decode response: %w", err)
+	}
+
+	return raw, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.infobip.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,232 @@
+package infobip
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSendSMS(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://xyz.api.infobip.com/sms/2/text/advanced" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "App api-key" {
+			t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(200, `{"bulkId":"bulk-1","messages":[{"messageId":"msg-1","status":{"groupId":1,"groupName":"PENDING"},"to":"41793026727"}]}`), nil
+	})
+
+	transport := NewTransport("api-key", "ACME", client)
+	transport.SetHost("xyz.api.infobip.com")
+
+	msg := notifier.NewSmsMessage("+41793026727", "Your code is 1234").
+		WithOptions("infobip", NewOptions().NotifyURL("https://example.com/reports").CallbackData("order-1"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sentMsg.GetMessageID() != "msg-1" || sentMsg.GetInfo("bulk_id") != "bulk-1" || sentMsg.GetInfo("status") != "PENDING" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	sms := capturedBody["messages"].([]any)[0].(map[string]any)
+	if sms["from"] != "ACME" || sms["text"] != "Your code is 1234" {
+		t.Errorf("Unexpected SMS: %v", sms)
+	}
+	if sms["destinations"].([]any)[0].(map[string]any)["to"] != "41793026727" {
+		t.Errorf("Unexpected destinations: %v", sms["destinations"])
+	}
+	if sms["notifyUrl"] != "https://example.com/reports" || sms["callbackData"] != "order-1" {
+		t.Errorf("Unexpected options: %v", sms)
+	}
+}
+
+func TestTransportSendWhatsApp(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/whatsapp/1/message/text" {
+			t.Errorf("Unexpected path: %s", req.URL.Path)
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(200, `{"to":"441134960001","messageCount":1,"messageId":"wa-1","status":{"groupName":"PENDING"}}`), nil
+	})
+
+	transport := NewTransport("api-key", "ACME", client).SetWhatsAppFrom("441134960000")
+
+	msg := notifier.NewChatMessage("Deploy finished").
+		WithOptions("infobip", NewOptions().Recipient("+441134960001"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sentMsg.GetMessageID() != "wa-1" {
+		t.Errorf("Expected message ID 'wa-1', got '%s'", sentMsg.GetMessageID())
+	}
+	if capturedBody["from"] != "441134960000" || capturedBody["to"] != "441134960001" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if capturedBody["content"].(map[string]any)["text"] != "Deploy finished" {
+		t.Errorf("Unexpected content: %v", capturedBody["content"])
+	}
+}
+
+func TestTransportSendWhatsAppTemplate(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path != "/whatsapp/1/message/template" {
+			t.Errorf("Unexpected path: %s", req.URL.Path)
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(200, `{"messages":[{"to":"441134960001","messageId":"wa-2","status":{"groupName":"PENDING"}}],"bulkId":"bulk-2"}`), nil
+	})
+
+	transport := NewTransport("api-key", "441134960000", client)
+
+	msg := notifier.NewChatMessage("").
+		WithOptions("infobip", NewOptions().Recipient("441134960001").Template("deploy_finished", "en", "api", "v1.2.3"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sentMsg.GetMessageID() != "wa-2" {
+		t.Errorf("Expected message ID 'wa-2', got '%s'", sentMsg.GetMessageID())
+	}
+
+	message := capturedBody["messages"].([]any)[0].(map[string]any)
+	content := message["content"].(map[string]any)
+	if content["templateName"] != "deploy_finished" || content["language"] != "en" {
+		t.Errorf("Unexpected template: %v", content)
+	}
+	placeholders := content["templateData"].(map[string]any)["body"].(map[string]any)["placeholders"].([]any)
+	if len(placeholders) != 2 || placeholders[1] != "v1.2.3" {
+		t.Errorf("Unexpected placeholders: %v", placeholders)
+	}
+}
+
+func TestTransportSendWhatsAppMissingRecipient(t *testing.T) {
+	transport := NewTransport("api-key", "ACME", nil)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "missing WhatsApp recipient") {
+		t.Errorf("Expected missing recipient error, got: %v", err)
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(401, `{"requestError":{"serviceException":{"messageId":"UNAUTHORIZED","text":"Invalid login details"}}}`), nil
+	})
+
+	transport := NewTransport("wrong", "ACME", client)
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("41793026727", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 401)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendRateLimited(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(429, `{"requestError":{"serviceException":{"messageId":"TOO_MANY_REQUESTS"}}}`), nil
+	})
+
+	transport := NewTransport("api-key", "ACME", client)
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("41793026727", "Hello"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestTransportSupports(t *testing.T) {
+	transport := NewTransport("api-key", "ACME", nil)
+
+	if !transport.Supports(notifier.NewSmsMessage("41793026727", "Hello")) {
+		t.Error("Expected SmsMessage to be supported")
+	}
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Expected ChatMessage to be supported")
+	}
+	if transport.Supports(notifier.NewEmailMessage("Hello")) {
+		t.Error("Expected EmailMessage not to be supported")
+	}
+
+	_, err := transport.Send(context.Background(), notifier.NewEmailMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported message type") {
+		t.Errorf("Expected unsupported message error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("infobip://api-key@xyz.api.infobip.com?from=ACME&whatsapp_from=441134960000")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support infobip DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	infobipTransport := transport.(*Transport)
+	if infobipTransport.whatsappFrom != "441134960000" {
+		t.Errorf("Expected WhatsApp sender '441134960000', got '%s'", infobipTransport.whatsappFrom)
+	}
+	if transport.String() != "infobip://xyz.api.infobip.com?from=ACME" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	dsn, _ = notifier.NewDSN("infobip://default?from=ACME")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Expected error for missing API key")
+	}
+}
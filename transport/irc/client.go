@@ -0,0 +1,229 @@
+package irc
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"unicode/utf8"
+)
+
+// maxMessageLength is the maximum length of an IRC message including the trailing CRLF (RFC 2812).
+const maxMessageLength = 512
+
+// maxHostLength is the longest host name servers prepend when relaying a message.
+const maxHostLength = 63
+
+// message is a line received from the server.
+type message struct {
+	prefix  string
+	command string
+	params  []string
+}
+
+// parseMessage parses a line into prefix, command and parameters.
+func parseMessage(line string) message {
+	var m message
+	if strings.HasPrefix(line, ":") {
+		m.prefix, line, _ = strings.Cut(line[1:], " ")
+	}
+	line, trailing, hasTrailing := strings.Cut(line, " :")
+	fields := strings.Fields(line)
+	if len(fields) > 0 {
+		m.command, m.params = strings.ToUpper(fields[0]), fields[1:]
+	}
+	if hasTrailing {
+		m.params = append(m.params, trailing)
+	}
+	return m
+}
+
+// param returns the parameter at index i, or "" if there is none.
+func (m message) param(i int) string {
+	if i < len(m.params) {
+		return m.params[i]
+	}
+	return ""
+}
+
+// text returns the last parameter, usually the human readable reply.
+func (m message) text() string {
+	return m.param(len(m.params) - 1)
+}
+
+// isError reports whether the message is an error numeric reply.
+func (m message) isError() bool {
+	return len(m.command) == 3 && (m.command[0] == '4' || m.command[0] == '5')
+}
+
+// conn is a minimal IRC client connection, sufficient to send messages.
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+	nick    string
+}
+
+// register registers the connection with the nickname, appending underscores while it is in use.
+func (c *conn) register(nick, serverPassword string) error {
+	if serverPassword != "" {
+		if err := c.send("PASS " + serverPassword); err != nil {
+			return err
+		}
+	}
+	c.nick = nick
+	if err := c.send("NICK " + nick); err != nil {
+		return err
+	}
+	if err := c.send(fmt.Sprintf("USER %s 0 * :%s", nick, nick)); err != nil {
+		return err
+	}
+
+	for attempts := 0; ; {
+		m, err := c.next()
+		if err != nil {
+			return err
+		}
+		switch m.command {
+		case "ERROR":
+			return fmt.Errorf("register: %s", m.text())
+		case "001":
+			// The server confirms the nickname it registered
+			if accepted := m.param(0); accepted != "" {
+				c.nick = accepted
+			}
+			return nil
+		case "433":
+			if attempts++; attempts > 3 {
+				return fmt.Errorf("nickname %s is already in use", nick)
+			}
+			c.nick += "_"
+			if err := c.send("NICK " + c.nick); err != nil {
+				return err
+			}
+		default:
+			if m.isError() {
+				return fmt.Errorf("register: %s", m.text())
+			}
+		}
+	}
+}
+
+// join joins the channel and waits until the server confirms it with the end of the names list.
+func (c *conn) join(channel, key string) error {
+	command := "JOIN " + channel
+	if key != "" {
+		command += " " + key
+	}
+	if err := c.send(command); err != nil {
+		return err
+	}
+
+	for {
+		m, err := c.next()
+		if err != nil {
+			return err
+		}
+		switch {
+		case m.command == "ERROR":
+			return fmt.Errorf("join %s: %s", channel, m.text())
+		case m.command == "366" && strings.EqualFold(m.param(1), channel):
+			return nil
+		case m.isError() && strings.EqualFold(m.param(1), channel):
+			return fmt.Errorf("join %s: %s", channel, m.text())
+		}
+	}
+}
+
+// quit ends the session and waits for the server to close the connection. Errors replied to
+// the messages sent to the target before, such as a channel that cannot be sent to, are returned.
+func (c *conn) quit(target string) error {
+	if err := c.send("QUIT"); err != nil {
+		return err
+	}
+
+	var replyErr error
+	for {
+		m, err := c.next()
+		if err == io.EOF {
+			return replyErr
+		}
+		if err != nil {
+			return err
+		}
+		// The closing link error is the expected reply to QUIT
+		if m.command == "ERROR" {
+			return replyErr
+		}
+		if m.isError() && strings.EqualFold(m.param(1), target) && replyErr == nil {
+			replyErr = fmt.Errorf("%s: %s", m.param(1), m.text())
+		}
+	}
+}
+
+// send writes a single line to the server.
+func (c *conn) send(line string) error {
+	_, err := io.WriteString(c.netConn, line+"\r\n")
+	return err
+}
+
+// next returns the next message, answering pings.
+func (c *conn) next() (message, error) {
+	for {
+		line, err := c.reader.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line == "" {
+				return message{}, io.EOF
+			}
+			if err != io.EOF {
+				return message{}, err
+			}
+		}
+		m := parseMessage(strings.TrimRight(line, "\r\n"))
+		switch m.command {
+		case "":
+			continue
+		case "PING":
+			if err := c.send("PONG :" + m.text()); err != nil {
+				return message{}, err
+			}
+			continue
+		}
+		return m, nil
+	}
+}
+
+func (c *conn) close() error {
+	return c.netConn.Close()
+}
+
+// maxTextLength returns the longest text that fits into a message to the target, including
+// the prefix servers prepend when relaying it.
+func maxTextLength(command, nick, target string) int {
+	prefix := fmt.Sprintf(":%s!%s@%s %s %s :\r\n", nick, nick, strings.Repeat("x", maxHostLength), command, target)
+	return maxMessageLength - len(prefix)
+}
+
+// splitText splits the text into lines of at most max bytes, breaking long lines at spaces
+// where possible. Empty lines are dropped, IRC cannot send them.
+func splitText(text string, max int) []string {
+	lines := make([]string, 0)
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRight(line, " \r")
+		for len(line) > max {
+			cut := strings.LastIndex(line[:max+1], " ")
+			if cut <= 0 {
+				cut = max
+				for cut > 0 && !utf8.RuneStart(line[cut]) {
+					cut--
+				}
+			}
+			lines = append(lines, line[:cut])
+			line = strings.TrimLeft(line[cut:], " ")
+		}
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
@@ -0,0 +1,50 @@
+package irc
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for IRC.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the channel (e.g., #ops) or nickname to send to.
+func (o *Options) Recipient(target string) *Options {
+	o.options["recipient_id"] = target
+	return o
+}
+
+// ChannelKey sets the key to join a channel protected with a password.
+func (o *Options) ChannelKey(key string) *Options {
+	o.options["channel_key"] = key
+	return o
+}
+
+// Notice sends the message as NOTICE instead of PRIVMSG, which bots must not reply to.
+func (o *Options) Notice(notice bool) *Options {
+	o.options["notice"] = notice
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
@@ -0,0 +1,182 @@
+package irc
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the IRC port with TLS.
+const DefaultPort = 6697
+
+// DefaultPlainPort is the IRC port without TLS.
+const DefaultPlainPort = 6667
+
+// Transport sends chat messages to IRC channels or users.
+// Each Send opens a short-lived connection, joins the channel and quits after sending.
+type Transport struct {
+	*notifier.AbstractTransport
+	nick               string
+	to                 string
+	channelKey         string
+	serverPassword     string
+	nickServPassword   string
+	useTLS             bool
+	insecureSkipVerify bool
+}
+
+// NewTransport creates a new IRC transport connecting with the nickname and sending to
+// the channel (e.g., #ops) or nickname.
+func NewTransport(nick, to string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		nick:              nick,
+		to:                to,
+		useTLS:            true,
+	}
+}
+
+// SetChannelKey sets the key to join a channel protected with a password.
+func (t *Transport) SetChannelKey(key string) *Transport {
+	t.channelKey = key
+	return t
+}
+
+// SetServerPassword sets the password sent with PASS before registration, e.g. for bouncers.
+func (t *Transport) SetServerPassword(password string) *Transport {
+	t.serverPassword = password
+	return t
+}
+
+// SetNickServPassword identifies the nickname with NickServ after connecting. Identification
+// completes asynchronously, channels restricted to identified users may reject early messages.
+func (t *Transport) SetNickServPassword(password string) *Transport {
+	t.nickServPassword = password
+	return t
+}
+
+// SetTLS enables or disables TLS. Disable it only for servers without TLS support.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed servers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("irc://%s?to=%s", t.getAddress(), t.to)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("irc: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("irc").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	to, channelKey := t.to, t.channelKey
+	if recipient := chatMsg.GetRecipientId(); recipient != "" {
+		to = recipient
+	}
+	if value, ok := options["channel_key"].(string); ok && value != "" {
+		channelKey = value
+	}
+	if to == "" {
+		return nil, fmt.Errorf("irc: missing recipient, set a channel or nickname")
+	}
+
+	command := "PRIVMSG"
+	if notice, _ := options["notice"].(bool); notice {
+		command = "NOTICE"
+	}
+
+	text := chatMsg.GetText()
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("irc: message has no content")
+	}
+
+	address := t.getAddress()
+	var netConn net.Conn
+	var err error
+	if t.useTLS {
+		host, _, _ := net.SplitHostPort(address)
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}}
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		var dialer net.Dialer
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("irc: connect: %w", err)
+	}
+	stop := notifier.BindConnContext(ctx, netConn)
+	defer stop()
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn)}
+	defer func() { _ = c.close() }()
+
+	if err := c.register(t.nick, t.serverPassword); err != nil {
+		return nil, fmt.Errorf("irc: %w", err)
+	}
+	if t.nickServPassword != "" {
+		if err := c.send(fmt.Sprintf("PRIVMSG NickServ :IDENTIFY %s %s", t.nick, t.nickServPassword)); err != nil {
+			return nil, fmt.Errorf("irc: identify: %w", err)
+		}
+	}
+	if isChannel(to) {
+		if err := c.join(to, channelKey); err != nil {
+			return nil, fmt.Errorf("irc: %w", err)
+		}
+	}
+
+	lines := splitText(text, maxTextLength(command, c.nick, to))
+	for _, line := range lines {
+		if err := c.send(fmt.Sprintf("%s %s :%s", command, to, line)); err != nil {
+			return nil, fmt.Errorf("irc: send message: %w", err)
+		}
+	}
+	if err := c.quit(to); err != nil {
+		return nil, fmt.Errorf("irc: send message: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("nick", c.nick)
+	sentMessage.SetInfo("lines", len(lines))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getAddress() string {
+	host, port, err := net.SplitHostPort(t.GetEndpoint())
+	if err != nil {
+		host, port = t.GetEndpoint(), strconv.Itoa(DefaultPort)
+		if !t.useTLS {
+			port = strconv.Itoa(DefaultPlainPort)
+		}
+	}
+	return net.JoinHostPort(host, port)
+}
+
+// isChannel reports whether the target is a channel, which has to be joined before sending.
+func isChannel(target string) bool {
+	return strings.HasPrefix(target, "#") || strings.HasPrefix(target, "&")
+}
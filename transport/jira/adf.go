@@ -0,0 +1,136 @@
+package jira
+
+import (
+	"regexp"
+	"strings"
+)
+
+// adfNode is a node of the Atlassian Document Format used by rich text fields of the v3 API.
+type adfNode struct {
+	Type    string         `json:"type"`
+	Version int            `json:"version,omitempty"`
+	Attrs   map[string]any `json:"attrs,omitempty"`
+	Content []adfNode      `json:"content,omitempty"`
+	Text    string         `json:"text,omitempty"`
+	Marks   []adfMark      `json:"marks,omitempty"`
+}
+
+type adfMark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+var urlPattern = regexp.MustCompile(`https?://[^\s<>"]+[^\s<>".,;:!?)]`)
+
+// newDocument converts plain text into an ADF document: blank lines separate paragraphs,
+// lines starting with "- " or "* " become bullet lists and ``` fences become code blocks.
+// URLs are linked and a non-empty heading is rendered in bold as the first paragraph.
+func newDocument(heading, text string) adfNode {
+	doc := adfNode{Type: "doc", Version: 1}
+	if heading != "" {
+		doc.Content = append(doc.Content, adfNode{
+			Type:    "paragraph",
+			Content: []adfNode{{Type: "text", Text: heading, Marks: []adfMark{{Type: "strong"}}}},
+		})
+	}
+
+	var paragraph []string
+	var list []adfNode
+	var code []string
+	inCode := false
+	codeLanguage := ""
+
+	flushParagraph := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		var content []adfNode
+		for i, line := range paragraph {
+			if i > 0 {
+				content = append(content, adfNode{Type: "hardBreak"})
+			}
+			content = append(content, inlineNodes(line)...)
+		}
+		doc.Content = append(doc.Content, adfNode{Type: "paragraph", Content: content})
+		paragraph = nil
+	}
+	flushList := func() {
+		if len(list) == 0 {
+			return
+		}
+		doc.Content = append(doc.Content, adfNode{Type: "bulletList", Content: list})
+		list = nil
+	}
+
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		if inCode {
+			if trimmed == "```" {
+				node := adfNode{Type: "codeBlock"}
+				if codeLanguage != "" {
+					node.Attrs = map[string]any{"language": codeLanguage}
+				}
+				if len(code) > 0 {
+					node.Content = []adfNode{{Type: "text", Text: strings.Join(code, "\n")}}
+				}
+				doc.Content = append(doc.Content, node)
+				inCode, code = false, nil
+				continue
+			}
+			code = append(code, line)
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(trimmed, "```"):
+			flushParagraph()
+			flushList()
+			inCode = true
+			codeLanguage = strings.TrimPrefix(trimmed, "```")
+		case strings.HasPrefix(trimmed, "- ") || strings.HasPrefix(trimmed, "* "):
+			flushParagraph()
+			list = append(list, adfNode{
+				Type:    "listItem",
+				Content: []adfNode{{Type: "paragraph", Content: inlineNodes(trimmed[2:])}},
+			})
+		case trimmed == "":
+			flushParagraph()
+			flushList()
+		default:
+			flushList()
+			paragraph = append(paragraph, line)
+		}
+	}
+
+	// An unterminated fence keeps its content as code
+	if inCode && len(code) > 0 {
+		doc.Content = append(doc.Content, adfNode{Type: "codeBlock", Content: []adfNode{{Type: "text", Text: strings.Join(code, "\n")}}})
+	}
+	flushParagraph()
+	flushList()
+
+	// The document schema requires at least one block
+	if len(doc.Content) == 0 {
+		doc.Content = append(doc.Content, adfNode{Type: "paragraph"})
+	}
+
+	return doc
+}
+
+// inlineNodes splits a line into text nodes, linking URLs.
+func inlineNodes(line string) []adfNode {
+	var nodes []adfNode
+	last := 0
+	for _, match := range urlPattern.FindAllStringIndex(line, -1) {
+		if match[0] > last {
+			nodes = append(nodes, adfNode{Type: "text", Text: line[last:match[0]]})
+		}
+		link := line[match[0]:match[1]]
+		nodes = append(nodes, adfNode{Type: "text", Text: link, Marks: []adfMark{{Type: "link", Attrs: map[string]any{"href": link}}}})
+		last = match[1]
+	}
+	if last < len(line) {
+		nodes = append(nodes, adfNode{Type: "text", Text: line[last:]})
+	}
+	return nodes
+}
@@ -0,0 +1,33 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewDocument(t *testing.T) {
+	text := "Backup of db-1 failed.\nSee https://status.example.com/db-1.\n\n- disk full\n- retry at 03:00\n\n```sh\ndf -h\n```"
+
+	encoded, err := json.Marshal(newDocument("Backup failed", text))
+	if err != nil {
+		t.Fatalf("Failed to encode document: %v", err)
+	}
+
+	expected := `{"type":"doc","version":1,"content":[` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Backup failed","marks":[{"type":"strong"}]}]},` +
+		`{"type":"paragraph","content":[{"type":"text","text":"Backup of db-1 failed."},{"type":"hardBreak"},{"type":"text","text":"See "},` +
+		`{"type":"text","text":"https://status.example.com/db-1","marks":[{"type":"link","attrs":{"href":"https://status.example.com/db-1"}}]},{"type":"text","text":"."}]},` +
+		`{"type":"bulletList","content":[{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"disk full"}]}]},` +
+		`{"type":"listItem","content":[{"type":"paragraph","content":[{"type":"text","text":"retry at 03:00"}]}]}]},` +
+		`{"type":"codeBlock","attrs":{"language":"sh"},"content":[{"type":"text","text":"df -h"}]}]}`
+	if string(encoded) != expected {
+		t.Errorf("Unexpected document:\n%s\nexpected:\n%s", encoded, expected)
+	}
+}
+
+func TestNewDocumentEmpty(t *testing.T) {
+	encoded, _ := json.Marshal(newDocument("", ""))
+	if string(encoded) != `{"type":"doc","version":1,"content":[{"type":"paragraph"}]}` {
+		t.Errorf("Unexpected document: %s", encoded)
+	}
+}
@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Jira.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Description sets the issue description, or the comment text below the subject.
+// Blank lines separate paragraphs, "- " starts a list item and ``` fences code blocks.
+func (o *Options) Description(description string) *Options {
+	o.options["description"] = description
+	return o
+}
+
+// Issue adds the message as a comment to the issue with the given key.
+func (o *Options) Issue(key string) *Options {
+	o.options["issue"] = key
+	return o
+}
+
+// Project creates the issue in the project with the given key, even if an issue is configured.
+func (o *Options) Project(key string) *Options {
+	o.options["project"] = key
+	return o
+}
+
+// IssueType sets the issue type name of the created issue (e.g., "Bug").
+func (o *Options) IssueType(issueType string) *Options {
+	o.options["issue_type"] = issueType
+	return o
+}
+
+// Labels sets the labels of the created issue, replacing the configured labels.
+func (o *Options) Labels(labels ...string) *Options {
+	o.options["labels"] = labels
+	return o
+}
+
+// Priority sets the priority name of the created issue (e.g., "High").
+func (o *Options) Priority(priority string) *Options {
+	o.options["priority"] = priority
+	return o
+}
+
+// Field sets a raw issue field, such as a custom field (e.g., "customfield_10010").
+func (o *Options) Field(key string, value any) *Options {
+	fields, ok := o.options["fields"].(map[string]any)
+	if !ok {
+		fields = make(map[string]any)
+		o.options["fields"] = fields
+	}
+	fields[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"labels": []string(nil),
+}
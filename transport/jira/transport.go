@@ -0,0 +1,252 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultIssueType is the issue type of created issues when none is configured.
+const DefaultIssueType = "Task"
+
+// Transport adds comments to Jira issues or creates issues.
+//
+// With an email and API token, Jira Cloud is used through the v3 API and bodies are sent
+// in the Atlassian Document Format. Without an email, the token is sent as a personal access
+// token to the v2 API of Jira Data Center and Server, which takes plain text bodies.
+type Transport struct {
+	*notifier.AbstractTransport
+	email     string
+	token     string
+	scheme    string
+	project   string
+	issueType string
+	issue     string
+	labels    []string
+}
+
+// NewTransport creates a new Jira transport. Use SetProject to create issues or SetIssue to comment on an issue.
+func NewTransport(email, token string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		email:             email,
+		token:             token,
+		scheme:            "https",
+		issueType:         DefaultIssueType,
+	}
+}
+
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+// SetProject sets the key of the project issues are created in.
+func (t *Transport) SetProject(key string) *Transport {
+	t.project = key
+	return t
+}
+
+// SetIssueType sets the issue type name of created issues.
+func (t *Transport) SetIssueType(issueType string) *Transport {
+	t.issueType = issueType
+	return t
+}
+
+// SetIssue adds messages as comments to the issue with the given key (e.g., "OPS-123") instead of creating issues.
+func (t *Transport) SetIssue(key string) *Transport {
+	t.issue = key
+	return t
+}
+
+// SetLabels sets the default labels of created issues.
+func (t *Transport) SetLabels(labels ...string) *Transport {
+	t.labels = labels
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.issue != "" {
+		return fmt.Sprintf("jira://%s?issue=%s", t.getEndpoint(), t.issue)
+	}
+	return fmt.Sprintf("jira://%s?project=%s", t.getEndpoint(), t.project)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("jira: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("jira").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	issue, project := t.issue, t.project
+	if value, ok := options["issue"].(string); ok && value != "" {
+		issue = value
+	}
+	if value, ok := options["project"].(string); ok && value != "" {
+		project, issue = value, ""
+	}
+	description, _ := options["description"].(string)
+	if description == "" {
+		description = chatMsg.GetContent()
+	}
+
+	var path string
+	var body map[string]any
+	if issue != "" {
+		path = fmt.Sprintf("issue/%s/comment", url.PathEscape(issue))
+		body = map[string]any{"body": t.richText(chatMsg.GetSubject(), description)}
+	} else {
+		if project == "" {
+			return nil, fmt.Errorf("jira: a project or an issue is required")
+		}
+
+		issueType := t.issueType
+		if value, ok := options["issue_type"].(string); ok && value != "" {
+			issueType = value
+		}
+		fields := map[string]any{
+			"project":   map[string]string{"key": project},
+			"issuetype": map[string]string{"name": issueType},
+			"summary":   summary(chatMsg.GetSubject()),
+		}
+		if description != "" {
+			fields["description"] = t.richText("", description)
+		}
+		labels := t.labels
+		if value, ok := options["labels"].([]string); ok {
+			labels = value
+		}
+		if len(labels) > 0 {
+			fields["labels"] = labels
+		}
+		if priority, ok := options["priority"].(string); ok && priority != "" {
+			fields["priority"] = map[string]string{"name": priority}
+		}
+		if extra, ok := options["fields"].(map[string]any); ok {
+			for key, value := range extra {
+				fields[key] = value
+			}
+		}
+		path = "issue"
+		body = map[string]any{"fields": fields}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("jira: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s://%s/rest/api/%s/%s", t.scheme, t.getEndpoint(), t.apiVersion(), path)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("jira: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if t.email != "" {
+		req.SetBasicAuth(t.email, t.token)
+	} else {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("jira: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "jira",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("jira: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ID  string `json:"id"`
+		Key string `json:"key"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("jira: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	if result.Key != "" {
+		issue = result.Key
+	}
+	sentMessage.SetInfo("issue", issue)
+	sentMessage.SetInfo("url", fmt.Sprintf("%s://%s/browse/%s", t.scheme, t.getEndpoint(), issue))
+
+	return sentMessage, nil
+}
+
+// richText renders the optional bold heading and text as ADF for Jira Cloud, and as wiki markup otherwise.
+func (t *Transport) richText(heading, text string) any {
+	if t.email != "" {
+		return newDocument(heading, text)
+	}
+	if heading == "" {
+		return text
+	}
+	if text == "" {
+		return heading
+	}
+	return fmt.Sprintf("*%s*\n\n%s", heading, text)
+}
+
+func (t *Transport) apiVersion() string {
+	if t.email != "" {
+		return "3"
+	}
+	return "2"
+}
+
+// summary keeps the first line of the subject within the 255 characters allowed for issue summaries.
+func summary(subject string) string {
+	subject, _, _ = strings.Cut(subject, "\n")
+	if runes := []rune(subject); len(runes) > 255 {
+		return string(runes[:254]) + "…"
+	}
+	return subject
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		// No default - Jira sites have their own host
+		return "jira-site-required.atlassian.net"
+	}
+	return endpoint
+}
@@ -0,0 +1,234 @@
+package kafka
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+// maxResponseSize limits the response size a broker may announce, so a corrupt or hostile
+// size prefix can't make the client allocate gigabytes.
+const maxResponseSize = 100 << 20
+
+// partitionMetadata describes a partition of a topic.
+type partitionMetadata struct {
+	id     int32
+	leader int32
+	err    int16
+}
+
+// topicMetadata describes the partitions of a topic and the addresses of their leaders.
+type topicMetadata struct {
+	brokers    map[int32]string
+	partitions []partitionMetadata
+}
+
+// conn is a minimal Kafka client connection able to authenticate, fetch metadata and produce.
+type conn struct {
+	netConn       net.Conn
+	reader        *bufio.Reader
+	clientID      string
+	correlationID int32
+	stop          func() bool
+}
+
+// roundTrip sends a request and, unless noResponse is set, returns a decoder for the response body.
+func (c *conn) roundTrip(apiKey, version int16, body []byte, noResponse bool) (*decoder, error) {
+	c.correlationID++
+
+	var request encoder
+	request.int32(0) // size, filled in below
+	request.int16(apiKey)
+	request.int16(version)
+	request.int32(c.correlationID)
+	request.string(c.clientID)
+	request.buf = append(request.buf, body...)
+	binary.BigEndian.PutUint32(request.buf, uint32(len(request.buf)-4))
+
+	if _, err := c.netConn.Write(request.buf); err != nil {
+		return nil, err
+	}
+	if noResponse {
+		return nil, nil
+	}
+
+	size := make([]byte, 4)
+	if _, err := io.ReadFull(c.reader, size); err != nil {
+		return nil, err
+	}
+	responseSize := binary.BigEndian.Uint32(size)
+	if responseSize > maxResponseSize {
+		return nil, fmt.Errorf("response of %d bytes exceeds the limit of %d bytes", responseSize, maxResponseSize)
+	}
+	response := make([]byte, responseSize)
+	if _, err := io.ReadFull(c.reader, response); err != nil {
+		return nil, err
+	}
+
+	d := &decoder{buf: response}
+	if correlationID := d.int32(); d.err == nil && correlationID != c.correlationID {
+		return nil, fmt.Errorf("unexpected correlation ID %d, expected %d", correlationID, c.correlationID)
+	}
+	return d, d.err
+}
+
+// authenticate performs SASL authentication with the given mechanism.
+func (c *conn) authenticate(mechanism, username, password string) error {
+	var body encoder
+	body.string(mechanism)
+	d, err := c.roundTrip(apiSaslHandshake, 1, body.buf, false)
+	if err != nil {
+		return err
+	}
+	errorCode := d.int16()
+	var enabled []string
+	for range d.arrayLength() {
+		enabled = append(enabled, d.string())
+	}
+	if d.err != nil {
+		return d.err
+	}
+	if errorCode != 0 {
+		return fmt.Errorf("%w, enabled mechanisms: %v", kafkaError(errorCode), enabled)
+	}
+
+	if mechanism == MechanismPlain {
+		_, err := c.saslAuthenticate([]byte("\x00" + username + "\x00" + password))
+		return err
+	}
+
+	scram, err := newScramClient(mechanism, username, password)
+	if err != nil {
+		return err
+	}
+	serverFirst, err := c.saslAuthenticate(scram.firstMessage())
+	if err != nil {
+		return err
+	}
+	clientFinal, err := scram.finalMessage(serverFirst)
+	if err != nil {
+		return err
+	}
+	serverFinal, err := c.saslAuthenticate(clientFinal)
+	if err != nil {
+		return err
+	}
+	return scram.verify(serverFinal)
+}
+
+func (c *conn) saslAuthenticate(authBytes []byte) ([]byte, error) {
+	var body encoder
+	body.bytes(authBytes)
+	d, err := c.roundTrip(apiSaslAuthenticate, 0, body.buf, false)
+	if err != nil {
+		return nil, err
+	}
+	errorCode := d.int16()
+	errorMessage := d.string()
+	response := d.bytes()
+	if d.err != nil {
+		return nil, d.err
+	}
+	if errorCode != 0 {
+		return nil, fmt.Errorf("%w: %s", kafkaError(errorCode), errorMessage)
+	}
+	return response, nil
+}
+
+// metadata fetches the partitions of a topic and the broker addresses.
+func (c *conn) metadata(topic string) (*topicMetadata, error) {
+	var body encoder
+	body.int32(1)
+	body.string(topic)
+	d, err := c.roundTrip(apiMetadata, 1, body.buf, false)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &topicMetadata{brokers: make(map[int32]string)}
+	for range d.arrayLength() {
+		nodeID := d.int32()
+		host := d.string()
+		port := d.int32()
+		d.string() // rack
+		metadata.brokers[nodeID] = net.JoinHostPort(host, strconv.Itoa(int(port)))
+	}
+	d.int32() // controller ID
+
+	var topicError int16 = -1
+	for range d.arrayLength() {
+		errorCode := d.int16()
+		name := d.string()
+		d.int8() // is internal
+		partitionCount := d.arrayLength()
+		if name != topic {
+			topicError = 3
+			continue
+		}
+		topicError = errorCode
+		for range partitionCount {
+			var partition partitionMetadata
+			partition.err = d.int16()
+			partition.id = d.int32()
+			partition.leader = d.int32()
+			for range d.arrayLength() {
+				d.int32() // replicas
+			}
+			for range d.arrayLength() {
+				d.int32() // in-sync replicas
+			}
+			metadata.partitions = append(metadata.partitions, partition)
+		}
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	if topicError != 0 {
+		return nil, fmt.Errorf("topic %q: %w", topic, kafkaError(topicError))
+	}
+	return metadata, nil
+}
+
+// produce appends a record batch to a partition and returns its offset, or -1 without acknowledgement.
+func (c *conn) produce(topic string, partition int32, acks int16, timeoutMs int32, batch []byte) (int64, error) {
+	var body encoder
+	body.nullableString(nil) // transactional ID
+	body.int16(acks)
+	body.int32(timeoutMs)
+	body.int32(1)
+	body.string(topic)
+	body.int32(1)
+	body.int32(partition)
+	body.bytes(batch)
+
+	d, err := c.roundTrip(apiProduce, 3, body.buf, acks == 0)
+	if err != nil || d == nil {
+		return -1, err
+	}
+
+	offset, errorCode := int64(-1), int16(0)
+	for range d.arrayLength() {
+		d.string() // topic
+		for range d.arrayLength() {
+			d.int32() // partition
+			errorCode = d.int16()
+			offset = d.int64()
+			d.int64() // log append time
+		}
+	}
+	if d.err != nil {
+		return -1, d.err
+	}
+	if errorCode != 0 {
+		return -1, kafkaError(errorCode)
+	}
+	return offset, nil
+}
+
+func (c *conn) close() error {
+	c.stop()
+	return c.netConn.Close()
+}
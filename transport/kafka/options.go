@@ -0,0 +1,79 @@
+package kafka
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Kafka.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Topic overrides the topic the record is produced to.
+func (o *Options) Topic(topic string) *Options {
+	o.options["topic"] = topic
+	return o
+}
+
+// Key sets the record key. Records with the same key go to the same partition.
+func (o *Options) Key(key string) *Options {
+	o.options["key"] = key
+	return o
+}
+
+// Partition produces the record to a specific partition.
+func (o *Options) Partition(partition int32) *Options {
+	o.options["partition"] = partition
+	return o
+}
+
+// Header adds a record header.
+func (o *Options) Header(key, value string) *Options {
+	headers, ok := o.options["headers"].(map[string]string)
+	if !ok {
+		headers = make(map[string]string)
+		o.options["headers"] = headers
+	}
+	headers[key] = value
+	return o
+}
+
+// Metadata adds a key-value pair to the metadata of the produced payload.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"partition": int32(0),
+	"headers":   map[string]string(nil),
+	"metadata":  map[string]string(nil),
+}
@@ -0,0 +1,264 @@
+package kafka
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"time"
+)
+
+// Kafka API keys used by the producer.
+const (
+	apiProduce          = 0
+	apiMetadata         = 3
+	apiSaslHandshake    = 17
+	apiSaslAuthenticate = 36
+)
+
+// errorNames describes the Kafka error codes most likely returned to a producer.
+var errorNames = map[int16]string{
+	-1: "UNKNOWN_SERVER_ERROR",
+	2:  "CORRUPT_MESSAGE",
+	3:  "UNKNOWN_TOPIC_OR_PARTITION",
+	5:  "LEADER_NOT_AVAILABLE",
+	6:  "NOT_LEADER_OR_FOLLOWER",
+	7:  "REQUEST_TIMED_OUT",
+	10: "MESSAGE_TOO_LARGE",
+	17: "INVALID_TOPIC_EXCEPTION",
+	19: "NOT_ENOUGH_REPLICAS",
+	20: "NOT_ENOUGH_REPLICAS_AFTER_APPEND",
+	21: "INVALID_REQUIRED_ACKS",
+	29: "TOPIC_AUTHORIZATION_FAILED",
+	31: "CLUSTER_AUTHORIZATION_FAILED",
+	33: "UNSUPPORTED_SASL_MECHANISM",
+	34: "ILLEGAL_SASL_STATE",
+	58: "SASL_AUTHENTICATION_FAILED",
+}
+
+// kafkaError is an error code returned by a broker.
+type kafkaError int16
+
+func (e kafkaError) Error() string {
+	if name, ok := errorNames[int16(e)]; ok {
+		return fmt.Sprintf("%s (%d)", name, int16(e))
+	}
+	return fmt.Sprintf("error code %d", int16(e))
+}
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// encoder builds request bodies in the Kafka wire format.
+type encoder struct {
+	buf []byte
+}
+
+func (e *encoder) int8(v int8)   { e.buf = append(e.buf, byte(v)) }
+func (e *encoder) int16(v int16) { e.buf = binary.BigEndian.AppendUint16(e.buf, uint16(v)) }
+func (e *encoder) int32(v int32) { e.buf = binary.BigEndian.AppendUint32(e.buf, uint32(v)) }
+func (e *encoder) int64(v int64) { e.buf = binary.BigEndian.AppendUint64(e.buf, uint64(v)) }
+func (e *encoder) varint(v int64) {
+	e.buf = binary.AppendVarint(e.buf, v)
+}
+
+func (e *encoder) string(s string) {
+	e.int16(int16(len(s)))
+	e.buf = append(e.buf, s...)
+}
+
+func (e *encoder) nullableString(s *string) {
+	if s == nil {
+		e.int16(-1)
+		return
+	}
+	e.string(*s)
+}
+
+func (e *encoder) bytes(b []byte) {
+	e.int32(int32(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// varbytes writes a varint-length prefixed byte slice, -1 for nil.
+func (e *encoder) varbytes(b []byte) {
+	if b == nil {
+		e.varint(-1)
+		return
+	}
+	e.varint(int64(len(b)))
+	e.buf = append(e.buf, b...)
+}
+
+// decoder reads response bodies in the Kafka wire format.
+// The first error is kept and all following reads return zero values.
+type decoder struct {
+	buf []byte
+	err error
+}
+
+var errShortBuffer = errors.New("malformed response")
+
+func (d *decoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || len(d.buf) < n {
+		d.err = errShortBuffer
+		return nil
+	}
+	b := d.buf[:n]
+	d.buf = d.buf[n:]
+	return b
+}
+
+func (d *decoder) int8() int8 {
+	if b := d.take(1); b != nil {
+		return int8(b[0])
+	}
+	return 0
+}
+
+func (d *decoder) int16() int16 {
+	if b := d.take(2); b != nil {
+		return int16(binary.BigEndian.Uint16(b))
+	}
+	return 0
+}
+
+func (d *decoder) int32() int32 {
+	if b := d.take(4); b != nil {
+		return int32(binary.BigEndian.Uint32(b))
+	}
+	return 0
+}
+
+func (d *decoder) int64() int64 {
+	if b := d.take(8); b != nil {
+		return int64(binary.BigEndian.Uint64(b))
+	}
+	return 0
+}
+
+func (d *decoder) string() string {
+	length := d.int16()
+	if length < 0 {
+		return ""
+	}
+	return string(d.take(int(length)))
+}
+
+func (d *decoder) bytes() []byte {
+	length := d.int32()
+	if length < 0 {
+		return nil
+	}
+	return d.take(int(length))
+}
+
+// arrayLength reads an array length, treating null arrays as empty.
+func (d *decoder) arrayLength() int {
+	length := d.int32()
+	if length < 0 || int(length) > len(d.buf) {
+		if length > 0 {
+			d.err = errShortBuffer
+		}
+		return 0
+	}
+	return int(length)
+}
+
+// header is a Kafka record header.
+type header struct {
+	key   string
+	value []byte
+}
+
+// record is a single message produced to a partition.
+type record struct {
+	key       []byte
+	value     []byte
+	headers   []header
+	timestamp time.Time
+}
+
+// encodeRecordBatch encodes a single record as a v2 record batch (magic 2) without compression.
+func encodeRecordBatch(r record) []byte {
+	var rec encoder
+	rec.int8(0)   // attributes
+	rec.varint(0) // timestamp delta
+	rec.varint(0) // offset delta
+	rec.varbytes(r.key)
+	rec.varbytes(r.value)
+	rec.varint(int64(len(r.headers)))
+	for _, h := range r.headers {
+		rec.varbytes([]byte(h.key))
+		rec.varbytes(h.value)
+	}
+
+	// The CRC covers everything from the attributes to the end of the batch
+	var body encoder
+	timestamp := r.timestamp.UnixMilli()
+	body.int16(0) // attributes
+	body.int32(0) // last offset delta
+	body.int64(timestamp)
+	body.int64(timestamp)
+	body.int64(-1) // producer ID
+	body.int16(-1) // producer epoch
+	body.int32(-1) // base sequence
+	body.int32(1)  // record count
+	body.varint(int64(len(rec.buf)))
+	body.buf = append(body.buf, rec.buf...)
+
+	var batch encoder
+	batch.int64(0) // base offset
+	batch.int32(int32(4 + 1 + 4 + len(body.buf)))
+	batch.int32(-1) // partition leader epoch
+	batch.int8(2)   // magic
+	batch.int32(int32(crc32.Checksum(body.buf, castagnoli)))
+	batch.buf = append(batch.buf, body.buf...)
+	return batch.buf
+}
+
+// murmur2 is the hash used by the Java client's default partitioner, so keyed
+// messages land on the same partition as with other producers.
+func murmur2(data []byte) int32 {
+	const (
+		seed uint32 = 0x9747b28c
+		m    uint32 = 0x5bd1e995
+		r           = 24
+	)
+
+	length := len(data)
+	h := seed ^ uint32(length)
+	for i := 0; i+4 <= length; i += 4 {
+		k := binary.LittleEndian.Uint32(data[i:])
+		k *= m
+		k ^= k >> r
+		k *= m
+		h *= m
+		h ^= k
+	}
+
+	tail := data[length&^3:]
+	switch len(tail) {
+	case 3:
+		h ^= uint32(tail[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(tail[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(tail[0])
+		h *= m
+	}
+
+	h ^= h >> 13
+	h *= m
+	h ^= h >> 15
+	return int32(h)
+}
+
+// partitionForKey returns the partition for a key like the Java default partitioner.
+func partitionForKey(key []byte, partitions int) int {
+	return int(murmur2(key)&0x7fffffff) % partitions
+}
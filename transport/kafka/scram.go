@@ -0,0 +1,117 @@
+package kafka
+
+import (
+	"crypto/hmac"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"strconv"
+	"strings"
+)
+
+// scramClient implements the client side of SCRAM authentication (RFC 5802).
+type scramClient struct {
+	newHash         func() hash.Hash
+	username        string
+	password        string
+	nonce           string
+	clientFirstBare string
+	serverSignature []byte
+}
+
+func newScramClient(mechanism, username, password string) (*scramClient, error) {
+	var newHash func() hash.Hash
+	switch mechanism {
+	case MechanismScramSHA256:
+		newHash = sha256.New
+	case MechanismScramSHA512:
+		newHash = sha512.New
+	default:
+		return nil, fmt.Errorf("unsupported SCRAM mechanism %q", mechanism)
+	}
+
+	nonce := make([]byte, 24)
+	_, _ = rand.Read(nonce)
+	return &scramClient{
+		newHash:  newHash,
+		username: username,
+		password: password,
+		nonce:    base64.RawStdEncoding.EncodeToString(nonce),
+	}, nil
+}
+
+// firstMessage returns the client-first-message.
+func (s *scramClient) firstMessage() []byte {
+	escaper := strings.NewReplacer("=", "=3D", ",", "=2C")
+	s.clientFirstBare = "n=" + escaper.Replace(s.username) + ",r=" + s.nonce
+	return []byte("n,," + s.clientFirstBare)
+}
+
+// finalMessage computes the client-final-message from the server-first-message.
+func (s *scramClient) finalMessage(serverFirst []byte) ([]byte, error) {
+	var nonce, salt string
+	iterations := 0
+	for _, attribute := range strings.Split(string(serverFirst), ",") {
+		key, value, _ := strings.Cut(attribute, "=")
+		switch key {
+		case "r":
+			nonce = value
+		case "s":
+			salt = value
+		case "i":
+			iterations, _ = strconv.Atoi(value)
+		case "e":
+			return nil, fmt.Errorf("server error: %s", value)
+		}
+	}
+	if !strings.HasPrefix(nonce, s.nonce) || iterations <= 0 {
+		return nil, errors.New("invalid server-first-message")
+	}
+	saltBytes, err := base64.StdEncoding.DecodeString(salt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid salt: %w", err)
+	}
+
+	saltedPassword, err := pbkdf2.Key(s.newHash, s.password, saltBytes, iterations, s.newHash().Size())
+	if err != nil {
+		return nil, err
+	}
+	clientKey := s.hmac(saltedPassword, "Client Key")
+	storedKey := s.newHash()
+	storedKey.Write(clientKey)
+
+	withoutProof := "c=biws,r=" + nonce
+	authMessage := s.clientFirstBare + "," + string(serverFirst) + "," + withoutProof
+	clientSignature := s.hmac(storedKey.Sum(nil), authMessage)
+	proof := make([]byte, len(clientKey))
+	for i := range clientKey {
+		proof[i] = clientKey[i] ^ clientSignature[i]
+	}
+	s.serverSignature = s.hmac(s.hmac(saltedPassword, "Server Key"), authMessage)
+
+	return []byte(withoutProof + ",p=" + base64.StdEncoding.EncodeToString(proof)), nil
+}
+
+// verify checks the server-final-message.
+func (s *scramClient) verify(serverFinal []byte) error {
+	key, value, _ := strings.Cut(string(serverFinal), "=")
+	if key == "e" {
+		return fmt.Errorf("server error: %s", value)
+	}
+	signature, err := base64.StdEncoding.DecodeString(value)
+	if key != "v" || err != nil || !hmac.Equal(signature, s.serverSignature) {
+		return errors.New("invalid server signature")
+	}
+	return nil
+}
+
+func (s *scramClient) hmac(key []byte, message string) []byte {
+	mac := hmac.New(s.newHash, key)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
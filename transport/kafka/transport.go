@@ -0,0 +1,294 @@
+package kafka
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the Kafka broker port.
+const DefaultPort = 9092
+
+// SASL mechanisms.
+const (
+	MechanismPlain       = "PLAIN"
+	MechanismScramSHA256 = "SCRAM-SHA-256"
+	MechanismScramSHA512 = "SCRAM-SHA-512"
+)
+
+// Required acknowledgements.
+const (
+	// AcksAll waits for all in-sync replicas to store the message.
+	AcksAll int16 = -1
+	// AcksLeader waits for the partition leader only.
+	AcksLeader int16 = 1
+	// AcksNone does not wait for any acknowledgement.
+	AcksNone int16 = 0
+)
+
+// DefaultClientID identifies the producer to the brokers.
+const DefaultClientID = "go-notifier"
+
+// Transport produces messages as JSON records to a Kafka topic.
+// The transport and recipient of the message are sent as record headers.
+// Each Send opens short-lived connections to a bootstrap broker and the partition leader.
+type Transport struct {
+	*notifier.AbstractTransport
+	brokers            []string
+	topic              string
+	clientID           string
+	mechanism          string
+	username           string
+	password           string
+	acks               int16
+	useTLS             bool
+	insecureSkipVerify bool
+	now                func() time.Time
+}
+
+// NewTransport creates a new Kafka transport producing to topic, using brokers (host:port)
+// to bootstrap the cluster metadata.
+func NewTransport(topic string, brokers ...string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		brokers:           brokers,
+		topic:             topic,
+		clientID:          DefaultClientID,
+		acks:              AcksAll,
+		now:               time.Now,
+	}
+}
+
+// SetSASL enables SASL authentication with MechanismPlain, MechanismScramSHA256 or MechanismScramSHA512.
+func (t *Transport) SetSASL(mechanism, username, password string) *Transport {
+	t.mechanism = mechanism
+	t.username = username
+	t.password = password
+	return t
+}
+
+// SetAcks sets the required acknowledgements, AcksAll by default.
+func (t *Transport) SetAcks(acks int16) *Transport {
+	t.acks = acks
+	return t
+}
+
+// SetClientID sets the client ID reported to the brokers.
+func (t *Transport) SetClientID(clientID string) *Transport {
+	t.clientID = clientID
+	return t
+}
+
+// SetTLS enables TLS for the broker connections.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed brokers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("kafka://%s?topic=%s", strings.Join(t.brokers, ","), t.topic)
+}
+
+// Supports returns true for all message types, they are produced as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("kafka").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	topic := t.topic
+	if value, ok := options["topic"].(string); ok && value != "" {
+		topic = value
+	}
+	if topic == "" {
+		return nil, fmt.Errorf("kafka: missing topic")
+	}
+	if len(t.brokers) == 0 {
+		return nil, fmt.Errorf("kafka: missing brokers")
+	}
+
+	payload := notifier.NewPayload(message)
+	if metadata, ok := options["metadata"].(map[string]string); ok {
+		payload.Metadata = metadata
+	}
+	value, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: marshal payload: %w", err)
+	}
+
+	rec := record{value: value, timestamp: t.now()}
+	if key, ok := options["key"].(string); ok {
+		rec.key = []byte(key)
+	}
+	for _, h := range []header{
+		{key: "type", value: []byte(payload.Type)},
+		{key: "transport", value: []byte(payload.Transport)},
+		{key: "recipient", value: []byte(payload.Recipient)},
+	} {
+		if len(h.value) > 0 {
+			rec.headers = append(rec.headers, h)
+		}
+	}
+	if headers, ok := options["headers"].(map[string]string); ok {
+		for _, key := range slices.Sorted(maps.Keys(headers)) {
+			rec.headers = append(rec.headers, header{key: key, value: []byte(headers[key])})
+		}
+	}
+
+	bootstrap, err := t.connectAny(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: %w", err)
+	}
+	defer func() { _ = bootstrap.close() }()
+
+	metadata, err := bootstrap.metadata(topic)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: metadata: %w", err)
+	}
+
+	partition, err := choosePartition(metadata, rec.key, options)
+	if err != nil {
+		return nil, fmt.Errorf("kafka: %w", err)
+	}
+
+	leaderAddress, ok := metadata.brokers[partition.leader]
+	if !ok {
+		return nil, fmt.Errorf("kafka: unknown leader %d for partition %d", partition.leader, partition.id)
+	}
+	leader := bootstrap
+	if leaderAddress != bootstrap.netConn.RemoteAddr().String() {
+		leader, err = t.connect(ctx, leaderAddress)
+		if err != nil {
+			return nil, fmt.Errorf("kafka: leader %s: %w", leaderAddress, err)
+		}
+		defer func() { _ = leader.close() }()
+	}
+
+	timeout := int32(30000)
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = int32(time.Until(deadline).Milliseconds())
+	}
+	offset, err := leader.produce(topic, partition.id, t.acks, timeout, encodeRecordBatch(rec))
+	if err != nil {
+		return nil, fmt.Errorf("kafka: produce: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	if offset >= 0 {
+		sentMessage.SetMessageID(fmt.Sprintf("%s-%d@%d", topic, partition.id, offset))
+	}
+	sentMessage.SetInfo("topic", topic)
+	sentMessage.SetInfo("partition", partition.id)
+	sentMessage.SetInfo("offset", offset)
+
+	return sentMessage, nil
+}
+
+// choosePartition returns the explicitly requested partition, the partition for the
+// record key, or a random available partition.
+func choosePartition(metadata *topicMetadata, key []byte, options map[string]any) (partitionMetadata, error) {
+	if len(metadata.partitions) == 0 {
+		return partitionMetadata{}, errors.New("topic has no partitions")
+	}
+
+	var chosen partitionMetadata
+	switch id, ok := options["partition"].(int32); {
+	case ok:
+		found := false
+		for _, partition := range metadata.partitions {
+			if partition.id == id {
+				chosen, found = partition, true
+			}
+		}
+		if !found {
+			return partitionMetadata{}, fmt.Errorf("partition %d does not exist", id)
+		}
+	case key != nil:
+		id := int32(partitionForKey(key, len(metadata.partitions)))
+		for _, partition := range metadata.partitions {
+			if partition.id == id {
+				chosen = partition
+			}
+		}
+	default:
+		var available []partitionMetadata
+		for _, partition := range metadata.partitions {
+			if partition.leader >= 0 {
+				available = append(available, partition)
+			}
+		}
+		if len(available) == 0 {
+			return partitionMetadata{}, kafkaError(5)
+		}
+		chosen = available[rand.IntN(len(available))]
+	}
+
+	if chosen.leader < 0 {
+		return partitionMetadata{}, fmt.Errorf("partition %d: %w", chosen.id, kafkaError(5))
+	}
+	return chosen, nil
+}
+
+// connectAny connects to the first reachable bootstrap broker.
+func (t *Transport) connectAny(ctx context.Context) (*conn, error) {
+	var errs []error
+	for _, broker := range t.brokers {
+		c, err := t.connect(ctx, broker)
+		if err == nil {
+			return c, nil
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", broker, err))
+	}
+	return nil, errors.Join(errs...)
+}
+
+// connect dials a broker and authenticates if SASL is enabled.
+func (t *Transport) connect(ctx context.Context, address string) (*conn, error) {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, strconv.Itoa(DefaultPort))
+	}
+
+	var netConn net.Conn
+	var err error
+	if t.useTLS {
+		host, _, _ := net.SplitHostPort(address)
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}}
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		var dialer net.Dialer
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn), clientID: t.clientID, stop: notifier.BindConnContext(ctx, netConn)}
+	if t.mechanism != "" {
+		if err := c.authenticate(t.mechanism, t.username, t.password); err != nil {
+			_ = c.close()
+			return nil, fmt.Errorf("authenticate: %w", err)
+		}
+	}
+	return c, nil
+}
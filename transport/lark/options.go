@@ -0,0 +1,230 @@
+package lark
+
+import (
+	"encoding/json"
+)
+
+// Message types.
+const (
+	MsgTypeText        = "text"
+	MsgTypePost        = "post"
+	MsgTypeInteractive = "interactive"
+)
+
+// Options implements MessageOptionsInterface for Lark and Feishu.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Post sends a rich text message with the subject as title. Without paragraphs, every line
+// of the content becomes a text paragraph.
+func (o *Options) Post(paragraphs ...[]PostElement) *Options {
+	o.options["msg_type"] = MsgTypePost
+	o.options["paragraphs"] = paragraphs
+	return o
+}
+
+// Locale sets the language of rich text messages, e.g. "en_us" (default "zh_cn").
+func (o *Options) Locale(locale string) *Options {
+	o.options["locale"] = locale
+	return o
+}
+
+// Card sends an interactive message card.
+func (o *Options) Card(card *Card) *Options {
+	o.options["msg_type"] = MsgTypeInteractive
+	o.options["card"] = card
+	return o
+}
+
+// Mention mentions group members by open ID, user ID or email. Text and rich text messages only.
+func (o *Options) Mention(userIDs ...string) *Options {
+	mentions, _ := o.options["mentions"].([]string)
+	o.options["mentions"] = append(mentions, userIDs...)
+	return o
+}
+
+// MentionAll mentions everyone in the group. Text and rich text messages only.
+func (o *Options) MentionAll() *Options {
+	return o.Mention("all")
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"paragraphs": [][]PostElement(nil),
+	"card":       (*Card)(nil),
+	"mentions":   []string(nil),
+}
+
+// PostElement is an element of a rich text paragraph.
+type PostElement map[string]any
+
+// PostText creates a plain text element.
+func PostText(text string) PostElement {
+	return PostElement{"tag": "text", "text": text}
+}
+
+// PostLink creates a hyperlink element.
+func PostLink(text, href string) PostElement {
+	return PostElement{"tag": "a", "text": text, "href": href}
+}
+
+// PostAt creates a mention of a user ID, or everyone with "all".
+func PostAt(userID string) PostElement {
+	return PostElement{"tag": "at", "user_id": userID}
+}
+
+// PostImage creates an image element from an uploaded image key.
+func PostImage(imageKey string) PostElement {
+	return PostElement{"tag": "img", "image_key": imageKey}
+}
+
+// Card is an interactive message card.
+type Card struct {
+	title    string
+	template string
+	elements []map[string]any
+}
+
+// NewCard creates a card. The title defaults to the subject of the message.
+func NewCard(title string) *Card {
+	return &Card{
+		title:    title,
+		elements: make([]map[string]any, 0),
+	}
+}
+
+// Template sets the header color, e.g. "blue", "green" or "red". Defaults to the color of the
+// message importance.
+func (c *Card) Template(template string) *Card {
+	c.template = template
+	return c
+}
+
+// Markdown adds a markdown text block.
+func (c *Card) Markdown(content string) *Card {
+	c.elements = append(c.elements, map[string]any{"tag": "markdown", "content": content})
+	return c
+}
+
+// Divider adds a horizontal rule.
+func (c *Card) Divider() *Card {
+	c.elements = append(c.elements, map[string]any{"tag": "hr"})
+	return c
+}
+
+// Buttons adds a row of buttons.
+func (c *Card) Buttons(buttons ...CardButton) *Card {
+	actions := make([]map[string]any, len(buttons))
+	for i, btn := range buttons {
+		actions[i] = btn.ToMap()
+	}
+	c.elements = append(c.elements, map[string]any{"tag": "action", "actions": actions})
+	return c
+}
+
+// Note adds a footnote in small grey text.
+func (c *Card) Note(text string) *Card {
+	c.elements = append(c.elements, map[string]any{
+		"tag":      "note",
+		"elements": []map[string]any{{"tag": "plain_text", "content": text}},
+	})
+	return c
+}
+
+func (c *Card) ToMap() map[string]any {
+	card := map[string]any{
+		"config":   map[string]any{"wide_screen_mode": true},
+		"elements": c.elements,
+	}
+	if c.title != "" {
+		header := map[string]any{"title": map[string]any{"tag": "plain_text", "content": c.title}}
+		if c.template != "" {
+			header["template"] = c.template
+		}
+		card["header"] = header
+	}
+	return card
+}
+
+// cardJSON is the JSON representation of a Card, restored by decodeOptions.
+type cardJSON struct {
+	Title    string           `json:"title,omitempty"`
+	Template string           `json:"template,omitempty"`
+	Elements []map[string]any `json:"elements"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (c *Card) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cardJSON{Title: c.title, Template: c.template, Elements: c.elements})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Card) UnmarshalJSON(data []byte) error {
+	var card cardJSON
+	if err := json.Unmarshal(data, &card); err != nil {
+		return err
+	}
+	c.title, c.template, c.elements = card.Title, card.Template, card.Elements
+	return nil
+}
+
+// CardButton is a button of a card opening a URL.
+type CardButton struct {
+	text       string
+	url        string
+	buttonType string
+}
+
+func NewCardButton(text, url string) CardButton {
+	return CardButton{
+		text: text,
+		url:  url,
+	}
+}
+
+// Primary highlights the button.
+func (b CardButton) Primary() CardButton {
+	b.buttonType = "primary"
+	return b
+}
+
+// Danger styles the button for destructive actions.
+func (b CardButton) Danger() CardButton {
+	b.buttonType = "danger"
+	return b
+}
+
+func (b *CardButton) ToMap() map[string]any {
+	m := map[string]any{
+		"tag":  "button",
+		"text": map[string]any{"tag": "plain_text", "content": b.text},
+		"url":  b.url,
+	}
+	if b.buttonType != "" {
+		m["type"] = b.buttonType
+	}
+	return m
+}
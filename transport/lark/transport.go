@@ -0,0 +1,215 @@
+package lark
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Hosts of the international Lark and the Chinese Feishu open platform.
+const (
+	LarkHost   = "open.larksuite.com"
+	FeishuHost = "open.feishu.cn"
+)
+
+// codeRateLimited is returned when a bot exceeds 100 messages per minute or 5 per second.
+const codeRateLimited = 11232
+
+// importanceTemplates maps the importance to the card header color.
+var importanceTemplates = map[string]string{
+	notifier.ImportanceUrgent: "red",
+	notifier.ImportanceHigh:   "orange",
+	notifier.ImportanceMedium: "yellow",
+	notifier.ImportanceLow:    "blue",
+}
+
+// Transport sends messages to a Lark or Feishu custom bot webhook.
+type Transport struct {
+	*notifier.AbstractTransport
+	token  string
+	secret string
+	now    func() time.Time
+}
+
+// NewTransport creates a new Lark custom bot transport. token is the last path segment of the
+// webhook URL. Use SetHost(FeishuHost) for Feishu bots.
+func NewTransport(token string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		now:               time.Now,
+	}
+}
+
+// SetSecret sets the secret of bots secured with signature verification.
+func (t *Transport) SetSecret(secret string) *Transport {
+	t.secret = secret
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("lark://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("lark: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("lark").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	payload := buildMessage(chatMsg, options)
+	if t.secret != "" {
+		timestamp := strconv.FormatInt(t.now().Unix(), 10)
+		payload["timestamp"] = timestamp
+		payload["sign"] = sign(timestamp, t.secret)
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("lark: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/open-apis/bot/v2/hook/%s", t.getEndpoint(), url.PathEscape(t.token))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("lark: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("lark: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "lark",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	// Errors are reported with a non-zero code, with HTTP status 200 or 400
+	var result struct {
+		Code int    `json:"code"`
+		Msg  string `json:"msg"`
+	}
+	raw, err := t.DecodeResponse(bytes.NewReader(respBody), &result)
+	if err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("lark: API error (status %d): %s", resp.StatusCode, string(respBody))
+		}
+		return nil, fmt.Errorf("lark: decode response: %w", err)
+	}
+	switch result.Code {
+	case 0:
+	case codeRateLimited:
+		return nil, &notifier.RateLimitError{Transport: "lark", Message: result.Msg}
+	default:
+		return nil, fmt.Errorf("lark: API error (code %d): %s", result.Code, result.Msg)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return LarkHost
+	}
+	return endpoint
+}
+
+// sign returns the signature of the timestamp in seconds. The timestamp and secret are the
+// HMAC key, the signed data is empty.
+func sign(timestamp, secret string) string {
+	mac := hmac.New(sha256.New, []byte(timestamp+"\n"+secret))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func buildMessage(chatMsg *notifier.ChatMessage, options map[string]any) map[string]any {
+	msgType, _ := options["msg_type"].(string)
+	if msgType == "" {
+		msgType = MsgTypeText
+	}
+	mentions, _ := options["mentions"].([]string)
+
+	message := map[string]any{"msg_type": msgType}
+	switch msgType {
+	case MsgTypeText:
+		text := chatMsg.GetText()
+		for _, userID := range mentions {
+			text += fmt.Sprintf(` <at user_id="%s"></at>`, userID)
+		}
+		message["content"] = map[string]any{"text": text}
+	case MsgTypePost:
+		paragraphs, _ := options["paragraphs"].([][]PostElement)
+		if len(paragraphs) == 0 {
+			for _, line := range strings.Split(chatMsg.GetContent(), "\n") {
+				paragraphs = append(paragraphs, []PostElement{PostText(line)})
+			}
+		}
+		if len(mentions) > 0 {
+			paragraph := make([]PostElement, len(mentions))
+			for i, userID := range mentions {
+				paragraph[i] = PostAt(userID)
+			}
+			paragraphs = append(paragraphs, paragraph)
+		}
+		locale, _ := options["locale"].(string)
+		if locale == "" {
+			locale = "zh_cn"
+		}
+		message["content"] = map[string]any{
+			"post": map[string]any{
+				locale: map[string]any{"title": chatMsg.GetSubject(), "content": paragraphs},
+			},
+		}
+	case MsgTypeInteractive:
+		card := NewCard("")
+		if value, ok := options["card"].(*Card); ok && value != nil {
+			copied := *value
+			card = &copied
+		}
+		if card.title == "" {
+			card.title = chatMsg.GetSubject()
+		}
+		if card.template == "" {
+			card.template = importanceTemplates[chatMsg.GetImportance()]
+		}
+		if len(card.elements) == 0 && chatMsg.GetContent() != "" {
+			card.Markdown(chatMsg.GetContent())
+		}
+		message["card"] = card.ToMap()
+	}
+
+	return message
+}
@@ -0,0 +1,217 @@
+package lark
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		*body = nil
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusOK, `{"code":0,"data":{},"msg":"success"}`), nil
+	})
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("hook-token", captureBody(&req, &body))
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Deploy finished").Content("Version 1.2.3 is live")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://open.larksuite.com/open-apis/bot/v2/hook/hook-token" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+
+	data, _ := json.Marshal(body)
+	if string(data) != `{"content":{"text":"Deploy finished\n\nVersion 1.2.3 is live"},"msg_type":"text"}` {
+		t.Errorf("Unexpected body: %s", data)
+	}
+}
+
+func TestTransportSendSigned(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("hook-token", captureBody(&req, &body)).SetSecret("secret")
+	transport.now = func() time.Time { return time.Unix(1700000000, 0) }
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if body["timestamp"] != "1700000000" || body["sign"] != "fiWS2+gh28DOydAv7hzONH/mDn9+b1Y4Y5ivXWXy8vA=" {
+		t.Errorf("Unexpected signature: %v %v", body["timestamp"], body["sign"])
+	}
+}
+
+func TestTransportOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  *notifier.ChatMessage
+		expected string
+	}{
+		{
+			"mentions",
+			notifier.NewChatMessage("Disk full").WithOptions("lark", NewOptions().Mention("ou_7d8a6e6df7621556ce0d21922b676706").MentionAll()),
+			`{"content":{"text":"Disk full \u003cat user_id=\"ou_7d8a6e6df7621556ce0d21922b676706\"\u003e\u003c/at\u003e \u003cat user_id=\"all\"\u003e\u003c/at\u003e"},"msg_type":"text"}`,
+		},
+		{
+			"post from content",
+			notifier.NewChatMessage("Deploy finished").Content("api 1.2.3\nweb 4.5.6").WithOptions("lark", NewOptions().Post().Mention("ou_1")),
+			`{"content":{"post":{"zh_cn":{"content":[[{"tag":"text","text":"api 1.2.3"}],[{"tag":"text","text":"web 4.5.6"}],[{"tag":"at","user_id":"ou_1"}]],"title":"Deploy finished"}}},"msg_type":"post"}`,
+		},
+		{
+			"post paragraphs",
+			notifier.NewChatMessage("Release notes").WithOptions("lark", NewOptions().Locale("en_us").Post(
+				[]PostElement{PostText("Version 1.2.3 is out, "), PostLink("read more", "https://example.com/releases/1.2.3")},
+				[]PostElement{PostImage("img_v2_041b28e3"), PostAt("all")},
+			)),
+			`{"content":{"post":{"en_us":{"content":[[{"tag":"text","text":"Version 1.2.3 is out, "},{"href":"https://example.com/releases/1.2.3","tag":"a","text":"read more"}],[{"image_key":"img_v2_041b28e3","tag":"img"},{"tag":"at","user_id":"all"}]],"title":"Release notes"}}},"msg_type":"post"}`,
+		},
+		{
+			"card from content",
+			notifier.NewChatMessage("Database down").Content("**db-1** is unreachable").Importance(notifier.ImportanceUrgent).WithOptions("lark", NewOptions().Card(NewCard(""))),
+			`{"card":{"config":{"wide_screen_mode":true},"elements":[{"content":"**db-1** is unreachable","tag":"markdown"}],"header":{"template":"red","title":{"content":"Database down","tag":"plain_text"}}},"msg_type":"interactive"}`,
+		},
+		{
+			"card",
+			notifier.NewChatMessage("Approve release?").WithOptions("lark", NewOptions().Card(NewCard("Release 1.2.3").
+				Template("green").
+				Markdown("Ready to ship").
+				Divider().
+				Buttons(NewCardButton("Approve", "https://ci.example.com/approve").Primary(), NewCardButton("Reject", "https://ci.example.com/reject").Danger()).
+				Note("Requested by CI"))),
+			`{"card":{"config":{"wide_screen_mode":true},"elements":[{"content":"Ready to ship","tag":"markdown"},{"tag":"hr"},{"actions":[{"tag":"button","text":{"content":"Approve","tag":"plain_text"},"type":"primary","url":"https://ci.example.com/approve"},{"tag":"button","text":{"content":"Reject","tag":"plain_text"},"type":"danger","url":"https://ci.example.com/reject"}],"tag":"action"},{"elements":[{"content":"Requested by CI","tag":"plain_text"}],"tag":"note"}],"header":{"template":"green","title":{"content":"Release 1.2.3","tag":"plain_text"}}},"msg_type":"interactive"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var body map[string]any
+			transport := NewTransport("hook-token", captureBody(&req, &body))
+
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			data, _ := json.Marshal(body)
+			if string(data) != tt.expected {
+				t.Errorf("Unexpected body: %s", data)
+			}
+		})
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	tests := []struct {
+		name     string
+		response *http.Response
+		expected string
+	}{
+		{"code", newResponse(http.StatusOK, `{"code":19021,"data":{},"msg":"sign match fail or timestamp is not within one hour from current time"}`), "lark: API error (code 19021): sign match fail or timestamp is not within one hour from current time"},
+		{"status", newResponse(http.StatusBadGateway, `Bad Gateway`), "lark: API error (status 502): Bad Gateway"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMockClient(func(req *http.Request) (*http.Response, error) {
+				return tt.response, nil
+			})
+
+			_, err := NewTransport("hook-token", client).Send(context.Background(), notifier.NewChatMessage("Hi"))
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("Expected %q, got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestTransportSendRateLimited(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `{"code":11232,"data":{},"msg":"frequency limited psm[lark.oapi.app_platform_runtime]appID[1]"}`), nil
+	})
+
+	_, err := NewTransport("hook-token", client).Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	factory := NewTransportFactory(captureBody(&req, &body))
+
+	tests := []struct {
+		dsn      string
+		expected string
+	}{
+		{"lark://hook-token@default", "https://open.larksuite.com/open-apis/bot/v2/hook/hook-token"},
+		{"feishu://hook-token@default?secret=secret", "https://open.feishu.cn/open-apis/bot/v2/hook/hook-token"},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport: %v", err)
+		}
+		if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi")); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if req.URL.String() != tt.expected {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+	}
+	if _, ok := body["sign"]; !ok {
+		t.Error("Expected signed request for DSN with secret")
+	}
+
+	dsn, _ := notifier.NewDSN("lark://default")
+	if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+		t.Errorf("Expected incomplete DSN error, got: %v", err)
+	}
+}
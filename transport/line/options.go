@@ -0,0 +1,90 @@
+package line
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for LINE.
+type Options struct {
+	options  map[string]any
+	messages []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options:  make(map[string]any),
+		messages: make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.messages) > 0 {
+		o.options["messages"] = o.messages
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the user, group or room ID to push to.
+func (o *Options) Recipient(id string) *Options {
+	o.options["recipient_id"] = id
+	return o
+}
+
+// NotificationDisabled delivers the message without a push notification.
+func (o *Options) NotificationDisabled(disabled bool) *Options {
+	o.options["notificationDisabled"] = disabled
+	return o
+}
+
+// Sticker appends a sticker message.
+func (o *Options) Sticker(packageID, stickerID string) *Options {
+	return o.AddMessage(map[string]any{
+		"type":      "sticker",
+		"packageId": packageID,
+		"stickerId": stickerID,
+	})
+}
+
+// Image appends an image message. Both URLs must use HTTPS, the preview is
+// shown in the chat and the original when the image is opened.
+func (o *Options) Image(originalURL, previewURL string) *Options {
+	return o.AddMessage(map[string]any{
+		"type":               "image",
+		"originalContentUrl": originalURL,
+		"previewImageUrl":    previewURL,
+	})
+}
+
+// Flex appends a flex message. altText is shown in notifications and on
+// devices that cannot render flex messages.
+func (o *Options) Flex(altText string, contents map[string]any) *Options {
+	return o.AddMessage(map[string]any{
+		"type":     "flex",
+		"altText":  altText,
+		"contents": contents,
+	})
+}
+
+// AddMessage appends a raw message object. LINE accepts up to 5 messages per request,
+// including the text message built from the subject.
+func (o *Options) AddMessage(message map[string]any) *Options {
+	o.messages = append(o.messages, message)
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"messages": []map[string]any(nil),
+}
@@ -0,0 +1,139 @@
+package line
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// maxMessages is the number of messages LINE accepts per push request.
+const maxMessages = 5
+
+// Transport sends messages via LINE Messaging API.
+type Transport struct {
+	*notifier.AbstractTransport
+	token string
+	to    string
+}
+
+// NewTransport creates a new LINE transport.
+func NewTransport(token, to string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		to:                to,
+	}
+}
+
+func (t *Transport) String() string {
+	endpoint := t.getEndpoint()
+	if t.to != "" {
+		return fmt.Sprintf("line://%s?to=%s", endpoint, t.to)
+	}
+	return fmt.Sprintf("line://%s", endpoint)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("line: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("line").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	to := chatMsg.GetRecipientId()
+	if to == "" {
+		to = t.to
+	}
+	if to == "" {
+		return nil, fmt.Errorf("line: missing recipient")
+	}
+	delete(options, "recipient_id")
+
+	messages := make([]map[string]any, 0)
+	if subject := chatMsg.GetText(); subject != "" {
+		messages = append(messages, map[string]any{"type": "text", "text": subject})
+	}
+	if extra, ok := options["messages"].([]map[string]any); ok {
+		messages = append(messages, extra...)
+	}
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("line: message has no content")
+	}
+	if len(messages) > maxMessages {
+		return nil, fmt.Errorf("line: too many messages (%d), at most %d are allowed", len(messages), maxMessages)
+	}
+
+	options["to"] = to
+	options["messages"] = messages
+
+	jsonBody, err := notifier.JSONMarshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("line: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v2/bot/message/push", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("line: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("line: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("line: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		SentMessages []struct {
+			ID string `json:"id"`
+		} `json:"sentMessages"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("line: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	if len(result.SentMessages) > 0 {
+		sentMessage.SetMessageID(result.SentMessages[0].ID)
+	}
+	sentMessage.SetInfo("request_id", resp.Header.Get("X-Line-Request-Id"))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.line.me"
+	}
+	return endpoint
+}
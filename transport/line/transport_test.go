@@ -0,0 +1,190 @@
+package line
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSupports(t *testing.T) {
+	transport := NewTransport("token", "U123", nil)
+
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should support ChatMessage")
+	}
+}
+
+func TestTransportString(t *testing.T) {
+	tests := []struct {
+		to       string
+		expected string
+	}{
+		{"", "line://api.line.me"},
+		{"U123", "line://api.line.me?to=U123"},
+	}
+
+	for _, tt := range tests {
+		transport := NewTransport("token", tt.to, nil)
+		if transport.String() != tt.expected {
+			t.Errorf("Expected %s, got %s", tt.expected, transport.String())
+		}
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("line://token@default?to=U123")
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support line DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	lineTransport := transport.(*Transport)
+	if lineTransport.token != "token" || lineTransport.to != "U123" {
+		t.Error("Token or recipient not set correctly")
+	}
+
+	dsn, _ = notifier.NewDSN("line://default?to=U123")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Factory should return error for missing token")
+	}
+}
+
+func TestHTTPSuccess(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		resp := newResponse(http.StatusOK, `{"sentMessages":[{"id":"461230966842064897","quoteToken":"q"}]}`)
+		resp.Header.Set("X-Line-Request-Id", "req-1")
+		return resp, nil
+	})
+
+	transport := NewTransport("token", "U123", client)
+	msg := notifier.NewChatMessage("Hello").
+		WithOptions("line", NewOptions().
+			Sticker("446", "1988").
+			Image("https://example.com/full.jpg", "https://example.com/preview.jpg").
+			Flex("Status", map[string]any{"type": "bubble"}).
+			NotificationDisabled(true))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.URL.String() != "https://api.line.me/v2/bot/message/push" {
+		t.Errorf("Unexpected URL: %s", capturedRequest.URL.String())
+	}
+	if capturedRequest.Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Unexpected Authorization: %s", capturedRequest.Header.Get("Authorization"))
+	}
+	if capturedBody["to"] != "U123" || capturedBody["notificationDisabled"] != true {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+
+	messages := capturedBody["messages"].([]any)
+	if len(messages) != 4 {
+		t.Fatalf("Expected 4 messages, got %d", len(messages))
+	}
+	types := []string{"text", "sticker", "image", "flex"}
+	for i, m := range messages {
+		if m.(map[string]any)["type"] != types[i] {
+			t.Errorf("Expected message %d to be %s, got %v", i, types[i], m)
+		}
+	}
+	image := messages[2].(map[string]any)
+	if image["originalContentUrl"] != "https://example.com/full.jpg" || image["previewImageUrl"] != "https://example.com/preview.jpg" {
+		t.Errorf("Unexpected image message: %v", image)
+	}
+
+	if sentMsg.GetMessageID() != "461230966842064897" || sentMsg.GetInfo("request_id") != "req-1" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo("request_id"))
+	}
+}
+
+func TestHTTPValidation(t *testing.T) {
+	opts := NewOptions()
+	for i := 0; i < maxMessages; i++ {
+		opts.Sticker("446", "1988")
+	}
+
+	tests := []struct {
+		name           string
+		transport      *Transport
+		message        *notifier.ChatMessage
+		expectedErrMsg string
+	}{
+		{"missing recipient", NewTransport("token", "", nil), notifier.NewChatMessage("Hi"), "missing recipient"},
+		{"no content", NewTransport("token", "U123", nil), notifier.NewChatMessage(""), "no content"},
+		{"too many messages", NewTransport("token", "U123", nil), notifier.NewChatMessage("Hi").WithOptions("line", opts), "too many messages"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.transport.Send(context.Background(), tt.message)
+			if err == nil || !strings.Contains(err.Error(), tt.expectedErrMsg) {
+				t.Errorf("Expected error containing '%s', got: %v", tt.expectedErrMsg, err)
+			}
+		})
+	}
+}
+
+func TestHTTPAPIError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusBadRequest, `{"message":"The request body has 1 error(s)"}`), nil
+	})
+
+	transport := NewTransport("token", "U123", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 400)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestHTTPNetworkError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	transport := NewTransport("token", "U123", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "line: send request") {
+		t.Errorf("Expected send request error, got: %v", err)
+	}
+}
@@ -0,0 +1,94 @@
+package mailgun
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for Mailgun.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Tag adds tags used to group statistics (up to 3 per message).
+func (o *Options) Tag(tags ...string) *Options {
+	existing, _ := o.options["o:tag"].([]string)
+	o.options["o:tag"] = append(existing, tags...)
+	return o
+}
+
+// Tracking enables or disables both click and open tracking, overriding the domain settings.
+func (o *Options) Tracking(enabled bool) *Options {
+	o.options["o:tracking"] = yesNo(enabled)
+	return o
+}
+
+// TrackingClicks enables or disables click tracking.
+func (o *Options) TrackingClicks(enabled bool) *Options {
+	o.options["o:tracking-clicks"] = yesNo(enabled)
+	return o
+}
+
+// TrackingOpens enables or disables open tracking.
+func (o *Options) TrackingOpens(enabled bool) *Options {
+	o.options["o:tracking-opens"] = yesNo(enabled)
+	return o
+}
+
+// DeliveryTime schedules the email (up to 3 days in advance).
+func (o *Options) DeliveryTime(at time.Time) *Options {
+	o.options["o:deliverytime"] = at.UTC().Format(time.RFC1123Z)
+	return o
+}
+
+// Template sends the email with a stored template.
+func (o *Options) Template(name string) *Options {
+	o.options["template"] = name
+	return o
+}
+
+// Variable attaches a custom variable, included in webhooks and template variables.
+func (o *Options) Variable(key, value string) *Options {
+	o.options["v:"+key] = value
+	return o
+}
+
+// TestMode accepts the email without delivering it.
+func (o *Options) TestMode() *Options {
+	o.options["o:testmode"] = "yes"
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"o:tag": []string(nil),
+}
+
+func yesNo(enabled bool) string {
+	if enabled {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,224 @@
+package mailgun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Regions maps region names to their API hosts.
+var Regions = map[string]string{
+	"us": "api.mailgun.net",
+	"eu": "api.eu.mailgun.net",
+}
+
+// Transport sends emails via the Mailgun Messages API.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey string
+	domain string
+	from   string
+	region string
+}
+
+// NewTransport creates a new Mailgun transport sending from the domain. from is the default
+// sender address.
+func NewTransport(apiKey, domain, from string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		domain:            domain,
+		from:              from,
+		region:            "us",
+	}
+}
+
+// SetRegion sets the region ("us" or "eu") of the domain. Domains are only known in the
+// region they were created in.
+func (t *Transport) SetRegion(region string) *Transport {
+	t.region = region
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("mailgun://%s?domain=%s", t.getEndpoint(), t.domain)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.EmailMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	emailMsg, ok := message.(*notifier.EmailMessage)
+	if !ok {
+		return nil, fmt.Errorf("mailgun: unsupported message type %T, expected EmailMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := emailMsg.GetOptions("mailgun").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	if len(emailMsg.GetTo()) == 0 {
+		return nil, fmt.Errorf("mailgun: missing recipient")
+	}
+
+	from := emailMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+
+	fields := url.Values{}
+	fields.Set("from", from)
+	fields["to"] = emailMsg.GetTo()
+	if cc := emailMsg.GetCc(); len(cc) > 0 {
+		fields["cc"] = cc
+	}
+	if bcc := emailMsg.GetBcc(); len(bcc) > 0 {
+		fields["bcc"] = bcc
+	}
+	fields.Set("subject", emailMsg.GetSubject())
+	if text := emailMsg.GetText(); text != "" {
+		fields.Set("text", text)
+	}
+	if html := emailMsg.GetHTML(); html != "" {
+		fields.Set("html", html)
+	}
+	if replyTo := emailMsg.GetReplyTo(); replyTo != "" {
+		fields.Set("h:Reply-To", replyTo)
+	}
+	for name, value := range emailMsg.GetHeaders() {
+		fields.Set("h:"+name, value)
+	}
+	for key, value := range options {
+		if values, ok := value.([]string); ok {
+			fields[key] = values
+			continue
+		}
+		fields.Set(key, fmt.Sprint(value))
+	}
+
+	body, contentType, err := createMultipartBody(fields, emailMsg.GetAttachments())
+	if err != nil {
+		return nil, fmt.Errorf("mailgun: create multipart body: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v3/%s/messages", t.getEndpoint(), url.PathEscape(t.domain))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("mailgun: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.SetBasicAuth("api", t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("mailgun: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Message string `json:"message"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Message != "" {
+			errMessage = apiResp.Message
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "mailgun",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("mailgun: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		ID      string `json:"id"`
+		Message string `json:"message"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("mailgun: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	// The ID is the Message-ID header, without angle brackets in event webhooks
+	sentMessage.SetMessageID(strings.Trim(result.ID, "<>"))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		if host, ok := Regions[t.region]; ok {
+			return host
+		}
+		return Regions["us"]
+	}
+	return endpoint
+}
+
+func createMultipartBody(fields url.Values, attachments []notifier.Attachment) (io.Reader, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, value := range fields[k] {
+			if err := writer.WriteField(k, value); err != nil {
+				return nil, "", fmt.Errorf("write field %s: %w", k, err)
+			}
+		}
+	}
+
+	for _, attachment := range attachments {
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="attachment"; filename="%s"`, strings.ReplaceAll(attachment.Filename, `"`, `\"`)))
+		contentType := attachment.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header.Set("Content-Type", contentType)
+
+		part, err := writer.CreatePart(header)
+		if err != nil {
+			return nil, "", fmt.Errorf("create attachment part: %w", err)
+		}
+		if _, err := part.Write(attachment.Data); err != nil {
+			return nil, "", fmt.Errorf("write attachment: %w", err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("close multipart writer: %w", err)
+	}
+
+	return &body, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,84 @@
+package mastodon
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("mastodon", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Mastodon transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Mastodon transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Mastodon transport from a DSN.
+// DSN format: mastodon://<access_token>@<instance>[?visibility=public|unlisted|private]
+// Example: mastodon://abc123@mastodon.social?visibility=unlisted
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "mastodon" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	accessToken := dsn.GetUser()
+	if accessToken == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing access token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	// There is no central Mastodon server, so the instance is required
+	host := dsn.GetHost()
+	if host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing instance host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	visibility := dsn.GetOption("visibility")
+	switch visibility {
+	case "", VisibilityPublic, VisibilityUnlisted, VisibilityPrivate, VisibilityDirect:
+	default:
+		return nil, fmt.Errorf("invalid DSN: Unsupported visibility \"%s\". DSN: %s", visibility, dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(accessToken, f.client).SetVisibility(visibility)
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"mastodon"}
+}
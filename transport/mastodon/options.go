@@ -0,0 +1,117 @@
+package mastodon
+
+import (
+	"encoding/json"
+)
+
+// Status visibilities.
+const (
+	VisibilityPublic   = "public"
+	VisibilityUnlisted = "unlisted"
+	VisibilityPrivate  = "private"
+	VisibilityDirect   = "direct"
+)
+
+// Media is a local file uploaded as attachment of a status.
+type Media struct {
+	Path        string
+	Description string
+}
+
+// Options implements MessageOptionsInterface for Mastodon.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sends the status as a direct message to the given account (e.g., "alice@mastodon.social").
+func (o *Options) Recipient(account string) *Options {
+	o.options["recipient_id"] = account
+	return o
+}
+
+// Visibility sets the status visibility (VisibilityPublic, VisibilityUnlisted, VisibilityPrivate or VisibilityDirect).
+func (o *Options) Visibility(visibility string) *Options {
+	o.options["visibility"] = visibility
+	return o
+}
+
+// Mention mentions the given accounts at the beginning of the status.
+func (o *Options) Mention(accounts ...string) *Options {
+	mentions, _ := o.options["mentions"].([]string)
+	o.options["mentions"] = append(mentions, accounts...)
+	return o
+}
+
+// SpoilerText hides the status behind a content warning.
+func (o *Options) SpoilerText(text string) *Options {
+	o.options["spoiler_text"] = text
+	return o
+}
+
+// Sensitive marks attached media as sensitive.
+func (o *Options) Sensitive(sensitive bool) *Options {
+	o.options["sensitive"] = sensitive
+	return o
+}
+
+// Media uploads a local image, video or audio file and attaches it to the status. The
+// description is shown as alt text. At most 4 media can be attached.
+func (o *Options) Media(path, description string) *Options {
+	media, _ := o.options["media"].([]Media)
+	o.options["media"] = append(media, Media{Path: path, Description: description})
+	return o
+}
+
+// MediaIDs attaches media that was already uploaded to the instance.
+func (o *Options) MediaIDs(ids ...string) *Options {
+	mediaIDs, _ := o.options["media_ids"].([]string)
+	o.options["media_ids"] = append(mediaIDs, ids...)
+	return o
+}
+
+// Language sets the ISO 639 language code of the status.
+func (o *Options) Language(language string) *Options {
+	o.options["language"] = language
+	return o
+}
+
+// InReplyTo posts the status as a reply to the given status ID, e.g. to build a thread of updates.
+func (o *Options) InReplyTo(statusID string) *Options {
+	o.options["in_reply_to_id"] = statusID
+	return o
+}
+
+// IdempotencyKey prevents duplicate statuses when a send is retried.
+func (o *Options) IdempotencyKey(key string) *Options {
+	o.options["idempotency_key"] = key
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"mentions":  []string(nil),
+	"media":     []Media(nil),
+	"media_ids": []string(nil),
+}
@@ -0,0 +1,280 @@
+package mastodon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts statuses to a Mastodon instance.
+type Transport struct {
+	*notifier.AbstractTransport
+	accessToken string
+	visibility  string
+}
+
+// NewTransport creates a new Mastodon transport. The instance is set with SetHost.
+func NewTransport(accessToken string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		accessToken:       accessToken,
+	}
+}
+
+// SetVisibility sets the default status visibility. The account's default is used if empty.
+func (t *Transport) SetVisibility(visibility string) *Transport {
+	t.visibility = visibility
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("mastodon://%s", t.GetEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("mastodon: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("mastodon").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	mentions, _ := options["mentions"].([]string)
+	visibility := t.visibility
+	if value, ok := options["visibility"].(string); ok && value != "" {
+		visibility = value
+	}
+	// Direct messages are statuses only visible to the mentioned accounts
+	if recipient, ok := options["recipient_id"].(string); ok && recipient != "" {
+		mentions = append([]string{recipient}, mentions...)
+		visibility = VisibilityDirect
+	}
+	if visibility == VisibilityDirect && len(mentions) == 0 {
+		return nil, fmt.Errorf("mastodon: direct statuses require a recipient")
+	}
+
+	status := chatMsg.GetText()
+	if len(mentions) > 0 {
+		handles := make([]string, len(mentions))
+		for i, mention := range mentions {
+			handles[i] = "@" + strings.TrimPrefix(mention, "@")
+		}
+		status = strings.Join(handles, " ") + " " + status
+	}
+
+	payload := map[string]any{"status": status}
+	if visibility != "" {
+		payload["visibility"] = visibility
+	}
+	for _, key := range []string{"spoiler_text", "sensitive", "language", "in_reply_to_id"} {
+		if value, ok := options[key]; ok {
+			payload[key] = value
+		}
+	}
+
+	mediaIDs, _ := options["media_ids"].([]string)
+	mediaIDs = append([]string(nil), mediaIDs...)
+	if media, ok := options["media"].([]Media); ok {
+		for _, m := range media {
+			id, err := t.uploadMedia(ctx, m)
+			if err != nil {
+				return nil, fmt.Errorf("mastodon: upload media %s: %w", m.Path, err)
+			}
+			mediaIDs = append(mediaIDs, id)
+		}
+	}
+	if len(mediaIDs) > 0 {
+		payload["media_ids"] = mediaIDs
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("mastodon: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/api/v1/statuses", t.GetEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("mastodon: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.accessToken)
+	if key, ok := options["idempotency_key"].(string); ok && key != "" {
+		req.Header.Set("Idempotency-Key", key)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("mastodon: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		var retryAfter time.Duration
+		if reset, err := time.Parse(time.RFC3339, resp.Header.Get("X-RateLimit-Reset")); err == nil {
+			retryAfter = max(time.Until(reset), 0)
+		}
+		return nil, &notifier.RateLimitError{
+			Transport:  "mastodon",
+			RetryAfter: retryAfter,
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("mastodon: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ID  string `json:"id"`
+		URL string `json:"url"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("mastodon: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	sentMessage.SetInfo("url", result.URL)
+
+	return sentMessage, nil
+}
+
+// mediaPollInterval is the delay between checks whether an uploaded file has been processed.
+var mediaPollInterval = time.Second
+
+// uploadMedia uploads the file and waits until the instance processed it, statuses cannot
+// attach media that is still being processed.
+func (t *Transport) uploadMedia(ctx context.Context, media Media) (string, error) {
+	body, contentType, err := createMediaBody(media)
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("https://%s/api/v2/media", t.GetEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+t.accessToken)
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	status, err := t.doMediaRequest(req, &result)
+	if err != nil {
+		return "", err
+	}
+
+	// Large files are processed asynchronously, the media answers 206 until it is ready
+	for status == http.StatusAccepted || status == http.StatusPartialContent {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(mediaPollInterval):
+		}
+
+		endpoint := fmt.Sprintf("https://%s/api/v1/media/%s", t.GetEndpoint(), url.PathEscape(result.ID))
+		req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+		if err != nil {
+			return "", fmt.Errorf("create request: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+t.accessToken)
+
+		if status, err = t.doMediaRequest(req, &result); err != nil {
+			return "", err
+		}
+	}
+
+	return result.ID, nil
+}
+
+// doMediaRequest sends a media request and decodes the media attachment.
+func (t *Transport) doMediaRequest(req *http.Request, result any) (int, error) {
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusAccepted, http.StatusPartialContent:
+	default:
+		respBody, _ := io.ReadAll(resp.Body)
+		return 0, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return 0, fmt.Errorf("decode response: %w", err)
+	}
+	return resp.StatusCode, nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+func createMediaBody(media Media) (io.Reader, string, error) {
+	file, err := os.Open(media.Path)
+	if err != nil {
+		return nil, "", fmt.Errorf("open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	if media.Description != "" {
+		if err := writer.WriteField("description", media.Description); err != nil {
+			return nil, "", fmt.Errorf("write field description: %w", err)
+		}
+	}
+
+	// Instances validate the declared content type of the file
+	fileType := mime.TypeByExtension(filepath.Ext(media.Path))
+	if fileType == "" {
+		fileType = "application/octet-stream"
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename="%s"`, quoteEscaper.Replace(filepath.Base(media.Path))))
+	header.Set("Content-Type", fileType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, "", fmt.Errorf("create form file: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, "", fmt.Errorf("copy file: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("close multipart writer: %w", err)
+	}
+
+	return &body, writer.FormDataContentType(), nil
+}
@@ -0,0 +1,295 @@
+package mastodon
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func newTestTransport(client *http.Client) *Transport {
+	transport := NewTransport("access-token", client)
+	transport.SetHost("mastodon.example")
+	return transport
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://mastodon.example/api/v1/statuses" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "Bearer access-token" {
+			t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+		}
+		if req.Header.Get("Idempotency-Key") != "release-1.2.3" {
+			t.Errorf("Unexpected Idempotency-Key header: %s", req.Header.Get("Idempotency-Key"))
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(200, `{"id":"109372843234390073","url":"https://mastodon.example/@status/109372843234390073"}`), nil
+	})
+
+	transport := newTestTransport(client).SetVisibility(VisibilityPublic)
+
+	msg := notifier.NewChatMessage("Version 1.2.3 released").
+		WithOptions("mastodon", NewOptions().
+			Visibility(VisibilityUnlisted).
+			SpoilerText("Release").
+			Language("en").
+			InReplyTo("109372843234390000").
+			IdempotencyKey("release-1.2.3"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sentMsg.GetMessageID() != "109372843234390073" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+	if sentMsg.GetInfo("url") != "https://mastodon.example/@status/109372843234390073" {
+		t.Errorf("Unexpected URL info: %v", sentMsg.GetInfo("url"))
+	}
+
+	expected := map[string]any{
+		"status":         "Version 1.2.3 released",
+		"visibility":     "unlisted",
+		"spoiler_text":   "Release",
+		"language":       "en",
+		"in_reply_to_id": "109372843234390000",
+	}
+	for key, value := range expected {
+		if capturedBody[key] != value {
+			t.Errorf("Expected %s '%v', got '%v'", key, value, capturedBody[key])
+		}
+	}
+	if _, ok := capturedBody["idempotency_key"]; ok {
+		t.Error("Expected idempotency key not to be sent in the body")
+	}
+}
+
+func TestTransportSendDirect(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+		return newResponse(200, `{"id":"1"}`), nil
+	})
+
+	transport := newTestTransport(client).SetVisibility(VisibilityPublic)
+
+	msg := notifier.NewChatMessage("Your export is ready").
+		WithOptions("mastodon", NewOptions().Recipient("alice@mastodon.social").Mention("@bob"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedBody["status"] != "@alice@mastodon.social @bob Your export is ready" {
+		t.Errorf("Unexpected status: %v", capturedBody["status"])
+	}
+	if capturedBody["visibility"] != "direct" {
+		t.Errorf("Expected visibility 'direct', got '%v'", capturedBody["visibility"])
+	}
+}
+
+func TestTransportSendDirectWithoutRecipient(t *testing.T) {
+	transport := newTestTransport(nil).SetVisibility(VisibilityDirect)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "require a recipient") {
+		t.Errorf("Expected recipient error, got: %v", err)
+	}
+}
+
+func TestTransportSendMedia(t *testing.T) {
+	mediaPollInterval = time.Millisecond
+	t.Cleanup(func() { mediaPollInterval = time.Second })
+
+	path := filepath.Join(t.TempDir(), "graph.png")
+	if err := os.WriteFile(path, []byte("png"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var requests []string
+	var capturedBody map[string]any
+	polls := 0
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		switch req.URL.Path {
+		case "/api/v2/media":
+			if err := req.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("Failed to parse upload: %v", err)
+			}
+			file, header, _ := req.FormFile("file")
+			content, _ := io.ReadAll(file)
+			if header.Filename != "graph.png" || header.Header.Get("Content-Type") != "image/png" || string(content) != "png" {
+				t.Errorf("Unexpected file: %s %v %s", header.Filename, header.Header, content)
+			}
+			if req.FormValue("description") != "CPU usage" {
+				t.Errorf("Unexpected description: %s", req.FormValue("description"))
+			}
+			return newResponse(202, `{"id":"22348641","type":"image","url":null}`), nil
+		case "/api/v1/media/22348641":
+			if polls++; polls < 2 {
+				return newResponse(206, `{"id":"22348641","type":"image","url":null}`), nil
+			}
+			return newResponse(200, `{"id":"22348641","type":"image","url":"https://files.mastodon.example/graph.png"}`), nil
+		default:
+			bodyBytes, _ := io.ReadAll(req.Body)
+			json.Unmarshal(bodyBytes, &capturedBody)
+			return newResponse(200, `{"id":"109372843234390073","url":"https://mastodon.example/@status/109372843234390073"}`), nil
+		}
+	})
+
+	msg := notifier.NewChatMessage("CPU usage").
+		WithOptions("mastodon", NewOptions().
+			MediaIDs("22348600").
+			Media(path, "CPU usage").
+			Sensitive(true))
+
+	if _, err := newTestTransport(client).Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	expected := "POST /api/v2/media|GET /api/v1/media/22348641|GET /api/v1/media/22348641|POST /api/v1/statuses"
+	if strings.Join(requests, "|") != expected {
+		t.Errorf("Unexpected requests: %v", requests)
+	}
+	mediaIDs, _ := json.Marshal(capturedBody["media_ids"])
+	if string(mediaIDs) != `["22348600","22348641"]` || capturedBody["sensitive"] != true {
+		t.Errorf("Unexpected status: %v", capturedBody)
+	}
+}
+
+func TestTransportSendMediaError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(422, `{"error":"Validation failed: File content type is invalid"}`), nil
+	})
+
+	msg := notifier.NewChatMessage("Hello").
+		WithOptions("mastodon", NewOptions().Media(filepath.Join(t.TempDir(), "missing.png"), ""))
+	_, err := newTestTransport(client).Send(context.Background(), msg)
+	if err == nil || !strings.Contains(err.Error(), "open file") {
+		t.Errorf("Expected open error, got: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "report.exe")
+	os.WriteFile(path, []byte("exe"), 0o644)
+	msg = notifier.NewChatMessage("Hello").WithOptions("mastodon", NewOptions().Media(path, ""))
+	_, err = newTestTransport(client).Send(context.Background(), msg)
+	if err == nil || !strings.Contains(err.Error(), "mastodon: upload media "+path+": API error (status 422)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(422, `{"error":"Validation failed: Text can't be blank"}`), nil
+	})
+
+	transport := newTestTransport(client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage(""))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 422)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendRateLimited(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(429, `{"error":"Too many requests"}`)
+		resp.Header.Set("X-RateLimit-Reset", time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+		return resp, nil
+	})
+
+	transport := newTestTransport(client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+	if rateLimitErr.RetryAfter < 59*time.Minute || rateLimitErr.RetryAfter > time.Hour {
+		t.Errorf("Expected retry after about an hour, got %s", rateLimitErr.RetryAfter)
+	}
+}
+
+func TestTransportUnsupportedMessage(t *testing.T) {
+	transport := newTestTransport(nil)
+
+	if transport.Supports(notifier.NewSmsMessage("+15551234567", "Hello")) {
+		t.Error("Expected SmsMessage not to be supported")
+	}
+
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("+15551234567", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported message type") {
+		t.Errorf("Expected unsupported message error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("mastodon://access-token@mastodon.social?visibility=unlisted")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support mastodon DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	mastodonTransport := transport.(*Transport)
+	if mastodonTransport.visibility != "unlisted" {
+		t.Errorf("Expected visibility 'unlisted', got '%s'", mastodonTransport.visibility)
+	}
+	if transport.String() != "mastodon://mastodon.social" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	for _, invalid := range []string{
+		"mastodon://mastodon.social",
+		"mastodon://access-token@default",
+		"mastodon://access-token@mastodon.social?visibility=secret",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package matrix
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("matrix", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates Matrix transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Matrix transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Matrix transport from a DSN.
+// DSN format: matrix://<access_token>@<homeserver>?room=<room_id_or_alias>
+// Example: matrix://syt_abc123@matrix.example.org?room=!abc:example.org
+//
+// Room aliases start with "#", which must be written as "%23" (e.g., room=%23ops:example.org).
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "matrix" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	accessToken := dsn.GetUser()
+	if accessToken == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing access token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	room := dsn.GetOption("room")
+	if room != "" && !strings.HasPrefix(room, "!") && !strings.HasPrefix(room, "#") {
+		return nil, fmt.Errorf("invalid DSN: Room must be an ID (\"!...\") or alias (\"#...\"). DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+
+	transport := NewTransport(accessToken, room, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"matrix"}
+}
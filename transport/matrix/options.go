@@ -0,0 +1,66 @@
+package matrix
+
+import (
+	"encoding/json"
+)
+
+// Message types of m.room.message events.
+const (
+	MsgTypeText   = "m.text"
+	MsgTypeNotice = "m.notice"
+	MsgTypeEmote  = "m.emote"
+)
+
+// Options implements MessageOptionsInterface for Matrix.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the room to send to, by ID ("!abc:example.org") or alias ("#ops:example.org").
+func (o *Options) Recipient(room string) *Options {
+	o.options["recipient_id"] = room
+	return o
+}
+
+// MsgType sets the message type (MsgTypeText by default). Bots should use MsgTypeNotice.
+func (o *Options) MsgType(msgType string) *Options {
+	o.options["msgtype"] = msgType
+	return o
+}
+
+// HTML sets the HTML formatted body. The message text is used as plain text fallback.
+func (o *Options) HTML(html string) *Options {
+	o.options["format"] = "org.matrix.custom.html"
+	o.options["formatted_body"] = html
+	return o
+}
+
+// ReplyTo sends the message as reply to the event.
+func (o *Options) ReplyTo(eventID string) *Options {
+	o.options["m.relates_to"] = map[string]any{
+		"m.in_reply_to": map[string]any{"event_id": eventID},
+	}
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
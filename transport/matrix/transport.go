@@ -0,0 +1,205 @@
+package matrix
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends m.room.message events via the Matrix client-server API.
+type Transport struct {
+	*notifier.AbstractTransport
+	accessToken string
+	room        string
+
+	mu      sync.Mutex
+	aliases map[string]string
+}
+
+// NewTransport creates a new Matrix transport. The homeserver is set with SetHost and defaults
+// to matrix.org. The room is given by ID or alias; aliases are resolved once and cached.
+func NewTransport(accessToken, room string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		accessToken:       accessToken,
+		room:              room,
+		aliases:           make(map[string]string),
+	}
+}
+
+func (t *Transport) String() string {
+	if t.room != "" {
+		return fmt.Sprintf("matrix://%s?room=%s", t.getEndpoint(), t.room)
+	}
+	return fmt.Sprintf("matrix://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("matrix: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("matrix").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	room := chatMsg.GetRecipientId()
+	if room == "" {
+		room = t.room
+	}
+	if room == "" {
+		return nil, fmt.Errorf("matrix: missing room")
+	}
+	roomID, err := t.roomID(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(options, "recipient_id")
+	if _, ok := options["msgtype"]; !ok {
+		options["msgtype"] = MsgTypeText
+	}
+	options["body"] = chatMsg.GetText()
+
+	// The transaction ID makes retries of the same request idempotent
+	path := fmt.Sprintf("/_matrix/client/v3/rooms/%s/send/m.room.message/%s", url.PathEscape(roomID), newTransactionID())
+	var result struct {
+		EventID string `json:"event_id"`
+	}
+	raw, err := t.request(ctx, "PUT", path, options, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.EventID)
+	sentMessage.SetInfo("room_id", roomID)
+
+	return sentMessage, nil
+}
+
+// roomID returns the ID of the room given by ID or alias, resolving and caching aliases.
+func (t *Transport) roomID(ctx context.Context, room string) (string, error) {
+	if !strings.HasPrefix(room, "#") {
+		return room, nil
+	}
+
+	t.mu.Lock()
+	id, ok := t.aliases[room]
+	t.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	var result struct {
+		RoomID string `json:"room_id"`
+	}
+	if _, err := t.request(ctx, "GET", "/_matrix/client/v3/directory/room/"+url.PathEscape(room), nil, &result); err != nil {
+		return "", err
+	}
+
+	t.mu.Lock()
+	t.aliases[room] = result.RoomID
+	t.mu.Unlock()
+	return result.RoomID, nil
+}
+
+// request sends an API request and decodes the response into result.
+func (t *Transport) request(ctx context.Context, method, path string, body any, result any) (any, error) {
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := notifier.JSONMarshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("matrix: marshal options: %w", err)
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", t.getEndpoint(), path)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("matrix: create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.accessToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("matrix: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			ErrCode      string `json:"errcode"`
+			Error        string `json:"error"`
+			RetryAfterMs int64  `json:"retry_after_ms"`
+		}
+		message := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.ErrCode != "" {
+			message = fmt.Sprintf("%s: %s", apiResp.ErrCode, apiResp.Error)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			retryAfter := notifier.ParseRetryAfter(resp.Header.Get("Retry-After"))
+			if apiResp.RetryAfterMs > 0 {
+				retryAfter = time.Duration(apiResp.RetryAfterMs) * time.Millisecond
+			}
+			return nil, &notifier.RateLimitError{
+				Transport:  "matrix",
+				RetryAfter: retryAfter,
+				Message:    message,
+			}
+		}
+		return nil, fmt.Errorf("matrix: API error (status %d): %s", resp.StatusCode, message)
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, result)
+	if err != nil {
+		return nil, fmt.Errorf("matrix: decode response: %w", err)
+	}
+	return raw, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "matrix.org"
+	}
+	return endpoint
+}
+
+// newTransactionID returns a unique transaction ID.
+func newTransactionID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%d-%s", time.Now().UnixNano(), hex.EncodeToString(b))
+}
@@ -0,0 +1,179 @@
+package matrix
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportString(t *testing.T) {
+	tests := []struct {
+		room     string
+		expected string
+	}{
+		{"", "matrix://matrix.org"},
+		{"!abc:example.org", "matrix://matrix.org?room=!abc:example.org"},
+	}
+
+	for _, tt := range tests {
+		transport := NewTransport("token", tt.room, nil)
+		if transport.String() != tt.expected {
+			t.Errorf("Expected %s, got %s", tt.expected, transport.String())
+		}
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("matrix://syt_token@matrix.example.org?room=%23ops:example.org")
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support matrix DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	matrixTransport := transport.(*Transport)
+	if matrixTransport.accessToken != "syt_token" || matrixTransport.room != "#ops:example.org" || matrixTransport.getEndpoint() != "matrix.example.org" {
+		t.Errorf("Unexpected transport: %+v", matrixTransport)
+	}
+
+	for _, invalid := range []string{"matrix://default?room=!abc:example.org", "matrix://token@default?room=ops"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+}
+
+func TestHTTPSend(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"event_id":"$event:example.org"}`), nil
+	})
+
+	transport := NewTransport("syt_token", "!abc:example.org", client)
+	msg := notifier.NewChatMessage("Deploy finished").
+		WithOptions("matrix", NewOptions().MsgType(MsgTypeNotice).HTML("<b>Deploy</b> finished").ReplyTo("$parent:example.org"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.Method != "PUT" || !strings.HasPrefix(capturedRequest.URL.EscapedPath(), "/_matrix/client/v3/rooms/%21abc:example.org/send/m.room.message/") {
+		t.Errorf("Unexpected request: %s %s", capturedRequest.Method, capturedRequest.URL.EscapedPath())
+	}
+	if capturedRequest.Header.Get("Authorization") != "Bearer syt_token" {
+		t.Errorf("Unexpected Authorization: %s", capturedRequest.Header.Get("Authorization"))
+	}
+	if capturedBody["msgtype"] != "m.notice" || capturedBody["body"] != "Deploy finished" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if capturedBody["format"] != "org.matrix.custom.html" || capturedBody["formatted_body"] != "<b>Deploy</b> finished" {
+		t.Errorf("Expected HTML body, got: %v", capturedBody)
+	}
+	if _, ok := capturedBody["m.relates_to"]; !ok {
+		t.Error("Expected reply relation")
+	}
+	if sentMsg.GetMessageID() != "$event:example.org" || sentMsg.GetInfo("room_id") != "!abc:example.org" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo("room_id"))
+	}
+}
+
+func TestHTTPRoomAlias(t *testing.T) {
+	var requests []string
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.EscapedPath())
+		if req.Method == "GET" {
+			return newResponse(http.StatusOK, `{"room_id":"!resolved:example.org","servers":["example.org"]}`), nil
+		}
+		return newResponse(http.StatusOK, `{"event_id":"$event"}`), nil
+	})
+
+	transport := NewTransport("token", "#ops:example.org", client)
+	for range 2 {
+		sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if sentMsg.GetInfo("room_id") != "!resolved:example.org" {
+			t.Errorf("Expected resolved room, got: %v", sentMsg.GetInfo("room_id"))
+		}
+	}
+
+	// The alias is resolved once
+	if len(requests) != 3 || requests[0] != "GET /_matrix/client/v3/directory/room/%23ops:example.org" {
+		t.Errorf("Unexpected requests: %v", requests)
+	}
+}
+
+func TestHTTPMissingRoom(t *testing.T) {
+	transport := NewTransport("token", "", nil)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "missing room") {
+		t.Errorf("Expected missing room error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusForbidden, `{"errcode":"M_FORBIDDEN","error":"User is not in the room"}`), nil
+	})
+
+	transport := NewTransport("token", "!abc:example.org", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || err.Error() != "matrix: API error (status 403): M_FORBIDDEN: User is not in the room" {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestHTTPRateLimit(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, `{"errcode":"M_LIMIT_EXCEEDED","error":"Too many requests","retry_after_ms":2000}`), nil
+	})
+
+	transport := NewTransport("token", "!abc:example.org", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 2*time.Second {
+		t.Errorf("Expected RateLimitError with 2s, got: %v", err)
+	}
+}
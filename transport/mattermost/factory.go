@@ -0,0 +1,77 @@
+package mattermost
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("mattermost", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Mattermost transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Mattermost transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Mattermost transport from a DSN.
+// DSN format: mattermost://<token>@<host>?channel=<channel_id_or_name>[&team=<team_name>]
+// Example: mattermost://xyz123@chat.example.com?channel=town-square
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "mattermost" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	token := dsn.GetUser()
+	if token == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing access token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	// Mattermost is self-hosted, so the server is required
+	host := dsn.GetHost()
+	if host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing server host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(token, dsn.GetOption("channel"), f.client).SetTeam(dsn.GetOption("team"))
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"mattermost"}
+}
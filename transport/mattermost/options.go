@@ -0,0 +1,176 @@
+package mattermost
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Mattermost.
+type Options struct {
+	options     map[string]any
+	props       map[string]any
+	attachments []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options:     make(map[string]any),
+		props:       make(map[string]any),
+		attachments: make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.attachments) > 0 {
+		o.props["attachments"] = o.attachments
+	}
+	if len(o.props) > 0 {
+		o.options["props"] = o.props
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the channel to post into, by ID or by name (e.g., "town-square").
+func (o *Options) Recipient(channel string) *Options {
+	o.options["recipient_id"] = channel
+	return o
+}
+
+// RootID replies in the thread of the given post.
+func (o *Options) RootID(id string) *Options {
+	o.options["root_id"] = id
+	return o
+}
+
+// FileIDs attaches files previously uploaded to the channel.
+func (o *Options) FileIDs(ids ...string) *Options {
+	o.options["file_ids"] = ids
+	return o
+}
+
+// Prop sets a post property (e.g., "from_webhook" or "card").
+func (o *Options) Prop(key string, value any) *Options {
+	o.props[key] = value
+	return o
+}
+
+// AddAttachment adds a message attachment to the post.
+func (o *Options) AddAttachment(attachment *Attachment) *Options {
+	o.attachments = append(o.attachments, attachment.ToMap())
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.ToMap())
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"file_ids": []string(nil),
+}
+
+// Attachment represents a Mattermost message attachment.
+type Attachment struct {
+	options map[string]any
+	fields  []map[string]any
+}
+
+func NewAttachment() *Attachment {
+	return &Attachment{
+		options: make(map[string]any),
+		fields:  make([]map[string]any, 0),
+	}
+}
+
+func (a *Attachment) ToMap() map[string]any {
+	if len(a.fields) > 0 {
+		a.options["fields"] = a.fields
+	}
+	return a.options
+}
+
+// Fallback sets the plain text summary shown in notifications.
+func (a *Attachment) Fallback(fallback string) *Attachment {
+	a.options["fallback"] = fallback
+	return a
+}
+
+// Color sets the color of the left border (e.g., "#FF8000").
+func (a *Attachment) Color(color string) *Attachment {
+	a.options["color"] = color
+	return a
+}
+
+// Pretext sets the text shown above the attachment.
+func (a *Attachment) Pretext(pretext string) *Attachment {
+	a.options["pretext"] = pretext
+	return a
+}
+
+// Text sets the attachment text (Markdown).
+func (a *Attachment) Text(text string) *Attachment {
+	a.options["text"] = text
+	return a
+}
+
+// Title sets the attachment title, optionally linked.
+func (a *Attachment) Title(title string, link ...string) *Attachment {
+	a.options["title"] = title
+	if len(link) > 0 {
+		a.options["title_link"] = link[0]
+	}
+	return a
+}
+
+// Author sets the attachment author, optionally linked.
+func (a *Attachment) Author(name string, link ...string) *Attachment {
+	a.options["author_name"] = name
+	if len(link) > 0 {
+		a.options["author_link"] = link[0]
+	}
+	return a
+}
+
+// AuthorIcon sets the author icon URL.
+func (a *Attachment) AuthorIcon(url string) *Attachment {
+	a.options["author_icon"] = url
+	return a
+}
+
+// Image sets the image URL.
+func (a *Attachment) Image(url string) *Attachment {
+	a.options["image_url"] = url
+	return a
+}
+
+// Thumbnail sets the thumbnail URL.
+func (a *Attachment) Thumbnail(url string) *Attachment {
+	a.options["thumb_url"] = url
+	return a
+}
+
+// Footer sets the attachment footer.
+func (a *Attachment) Footer(text string, iconUrl ...string) *Attachment {
+	a.options["footer"] = text
+	if len(iconUrl) > 0 {
+		a.options["footer_icon"] = iconUrl[0]
+	}
+	return a
+}
+
+// AddField adds a field to the attachment. Short fields are shown side by side.
+func (a *Attachment) AddField(title, value string, short ...bool) *Attachment {
+	field := map[string]any{"title": title, "value": value}
+	if len(short) > 0 {
+		field["short"] = short[0]
+	}
+	a.fields = append(a.fields, field)
+	return a
+}
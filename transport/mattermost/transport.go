@@ -0,0 +1,235 @@
+package mattermost
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts messages via the Mattermost REST API.
+type Transport struct {
+	*notifier.AbstractTransport
+	token   string
+	channel string
+	team    string
+
+	mu       sync.Mutex
+	channels map[string]string
+}
+
+// NewTransport creates a new Mattermost transport. The server is set with SetHost.
+// The channel can be given by ID or by name; names are looked up in the team set with SetTeam,
+// or in all teams of the user if no team is set.
+func NewTransport(token, channel string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		channel:           channel,
+		channels:          make(map[string]string),
+	}
+}
+
+// SetTeam sets the name of the team channel names are looked up in.
+func (t *Transport) SetTeam(team string) *Transport {
+	t.team = team
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.channel != "" {
+		return fmt.Sprintf("mattermost://%s?channel=%s", t.GetEndpoint(), t.channel)
+	}
+	return fmt.Sprintf("mattermost://%s", t.GetEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("mattermost: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("mattermost").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	channel := chatMsg.GetRecipientId()
+	if channel == "" {
+		channel = t.channel
+	}
+	if channel == "" {
+		return nil, fmt.Errorf("mattermost: missing channel")
+	}
+	channelID, err := t.channelID(ctx, channel)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(options, "recipient_id")
+	options["channel_id"] = channelID
+	options["message"] = chatMsg.GetText()
+
+	var result struct {
+		ID        string `json:"id"`
+		ChannelID string `json:"channel_id"`
+		CreateAt  int64  `json:"create_at"`
+	}
+	raw, err := t.request(ctx, "POST", "/api/v4/posts", options, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	sentMessage.SetInfo("channel_id", result.ChannelID)
+	sentMessage.SetInfo("create_at", result.CreateAt)
+
+	return sentMessage, nil
+}
+
+// channelID returns the ID of the channel given by ID or name, looking up and caching names.
+func (t *Transport) channelID(ctx context.Context, channel string) (string, error) {
+	if isID(channel) {
+		return channel, nil
+	}
+
+	t.mu.Lock()
+	id, ok := t.channels[channel]
+	t.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	teams := []string{t.team}
+	if t.team == "" {
+		var userTeams []struct {
+			Name string `json:"name"`
+		}
+		if _, err := t.request(ctx, "GET", "/api/v4/users/me/teams", nil, &userTeams); err != nil {
+			return "", err
+		}
+		teams = teams[:0]
+		for _, team := range userTeams {
+			teams = append(teams, team.Name)
+		}
+	}
+
+	for _, team := range teams {
+		var result struct {
+			ID string `json:"id"`
+		}
+		path := fmt.Sprintf("/api/v4/teams/name/%s/channels/name/%s", url.PathEscape(team), url.PathEscape(channel))
+		if _, err := t.request(ctx, "GET", path, nil, &result); err != nil {
+			var apiErr *apiError
+			if errors.As(err, &apiErr) && apiErr.status == http.StatusNotFound {
+				continue
+			}
+			return "", err
+		}
+
+		t.mu.Lock()
+		t.channels[channel] = result.ID
+		t.mu.Unlock()
+		return result.ID, nil
+	}
+
+	return "", fmt.Errorf("mattermost: channel %q not found", channel)
+}
+
+// request sends an API request and decodes the response into result.
+func (t *Transport) request(ctx context.Context, method, path string, body any, result any) (any, error) {
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := notifier.JSONMarshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("mattermost: marshal options: %w", err)
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", t.GetEndpoint(), path)
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("mattermost: create request: %w", err)
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("mattermost: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "mattermost",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("X-Ratelimit-Reset")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Message string `json:"message"`
+		}
+		message := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Message != "" {
+			message = apiResp.Message
+		}
+		return nil, &apiError{status: resp.StatusCode, message: message}
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, result)
+	if err != nil {
+		return nil, fmt.Errorf("mattermost: decode response: %w", err)
+	}
+	return raw, nil
+}
+
+// apiError is an error response of the Mattermost API.
+type apiError struct {
+	status  int
+	message string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("mattermost: API error (status %d): %s", e.status, e.message)
+}
+
+// isID reports whether the channel is a Mattermost ID: 26 lowercase alphanumeric characters.
+func isID(channel string) bool {
+	if len(channel) != 26 {
+		return false
+	}
+	for _, c := range channel {
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
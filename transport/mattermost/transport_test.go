@@ -0,0 +1,211 @@
+package mattermost
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+const channelID = "4xp9fdt7pbgium38k5bqbodbtc"
+
+func newTransport(client *http.Client, channel string) *Transport {
+	transport := NewTransport("token", channel, client)
+	transport.SetHost("chat.example.com")
+	return transport
+}
+
+func TestTransportString(t *testing.T) {
+	transport := newTransport(nil, "town-square")
+	if transport.String() != "mattermost://chat.example.com?channel=town-square" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should support ChatMessage")
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("mattermost://token@chat.example.com:8065?channel=town-square&team=engineering")
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support mattermost DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	mattermostTransport := transport.(*Transport)
+	if mattermostTransport.token != "token" || mattermostTransport.channel != "town-square" || mattermostTransport.team != "engineering" {
+		t.Error("Token, channel or team not set correctly")
+	}
+	if mattermostTransport.GetEndpoint() != "chat.example.com:8065" {
+		t.Errorf("Unexpected endpoint: %s", mattermostTransport.GetEndpoint())
+	}
+
+	for _, invalid := range []string{"mattermost://chat.example.com?channel=town-square", "mattermost://token@default?channel=town-square"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+			t.Errorf("Expected incomplete DSN error for %s, got: %v", invalid, err)
+		}
+	}
+}
+
+func TestHTTPSendToChannelID(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusCreated, `{"id":"POST","channel_id":"`+channelID+`","create_at":1700000000000}`), nil
+	})
+
+	transport := newTransport(client, channelID)
+	msg := notifier.NewChatMessage("Deploy finished").Content("v1.2.3 is live").
+		WithOptions("mattermost", NewOptions().
+			RootID("ROOT").
+			Prop("from_bot", "true").
+			AddAttachment(NewAttachment().Color("#36A64F").Title("Changelog", "https://example.com").AddField("Version", "1.2.3", true)))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.URL.String() != "https://chat.example.com/api/v4/posts" {
+		t.Errorf("Unexpected URL: %s", capturedRequest.URL.String())
+	}
+	if capturedRequest.Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Unexpected Authorization: %s", capturedRequest.Header.Get("Authorization"))
+	}
+	if capturedBody["channel_id"] != channelID || capturedBody["message"] != "Deploy finished\n\nv1.2.3 is live" || capturedBody["root_id"] != "ROOT" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	props, _ := capturedBody["props"].(map[string]any)
+	attachments, _ := props["attachments"].([]any)
+	if props["from_bot"] != "true" || len(attachments) != 1 {
+		t.Fatalf("Expected props with one attachment, got: %v", capturedBody["props"])
+	}
+	if attachment := attachments[0].(map[string]any); attachment["title_link"] != "https://example.com" || len(attachment["fields"].([]any)) != 1 {
+		t.Errorf("Unexpected attachment: %v", attachment)
+	}
+	if sentMsg.GetMessageID() != "POST" || sentMsg.GetInfo("channel_id") != channelID {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo("channel_id"))
+	}
+}
+
+func TestHTTPChannelLookup(t *testing.T) {
+	var paths []string
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.Method+" "+req.URL.Path)
+		switch req.URL.Path {
+		case "/api/v4/users/me/teams":
+			return newResponse(http.StatusOK, `[{"name":"sales"},{"name":"engineering"}]`), nil
+		case "/api/v4/teams/name/sales/channels/name/deployments":
+			return newResponse(http.StatusNotFound, `{"id":"app.channel.get_by_name.missing.app_error","message":"Channel does not exist.","status_code":404}`), nil
+		case "/api/v4/teams/name/engineering/channels/name/deployments":
+			return newResponse(http.StatusOK, `{"id":"`+channelID+`","name":"deployments"}`), nil
+		default:
+			return newResponse(http.StatusCreated, `{"id":"POST"}`), nil
+		}
+	})
+
+	transport := newTransport(client, "deployments")
+	for range 2 {
+		if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello")); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+	}
+
+	// The channel ID is cached after the first lookup
+	expected := []string{
+		"GET /api/v4/users/me/teams",
+		"GET /api/v4/teams/name/sales/channels/name/deployments",
+		"GET /api/v4/teams/name/engineering/channels/name/deployments",
+		"POST /api/v4/posts",
+		"POST /api/v4/posts",
+	}
+	if strings.Join(paths, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected requests: %v", paths)
+	}
+}
+
+func TestHTTPChannelNotFound(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusNotFound, `{"message":"Channel does not exist."}`), nil
+	})
+
+	transport := newTransport(client, "").SetTeam("engineering")
+	msg := notifier.NewChatMessage("Hello").WithOptions("mattermost", NewOptions().Recipient("missing"))
+	_, err := transport.Send(context.Background(), msg)
+	if err == nil || !strings.Contains(err.Error(), `channel "missing" not found`) {
+		t.Errorf("Expected channel not found error, got: %v", err)
+	}
+}
+
+func TestHTTPMissingChannel(t *testing.T) {
+	transport := newTransport(nil, "")
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "missing channel") {
+		t.Errorf("Expected missing channel error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusForbidden, `{"message":"You do not have the appropriate permissions.","status_code":403}`), nil
+	})
+
+	transport := newTransport(client, channelID)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || err.Error() != "mattermost: API error (status 403): You do not have the appropriate permissions." {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestHTTPRateLimit(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(http.StatusTooManyRequests, `{"message":"too many requests"}`)
+		resp.Header.Set("X-Ratelimit-Reset", "3")
+		return resp, nil
+	})
+
+	transport := newTransport(client, channelID)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter.Seconds() != 3 {
+		t.Errorf("Expected RateLimitError with 3s, got: %v", err)
+	}
+}
@@ -0,0 +1,76 @@
+package messagebird
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for MessageBird.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipients adds further phone numbers that receive the same message in a single API call.
+func (o *Options) Recipients(phones ...string) *Options {
+	recipients, _ := o.options["recipients"].([]string)
+	o.options["recipients"] = append(recipients, phones...)
+	return o
+}
+
+// Reference sets a client reference that is returned in delivery reports.
+func (o *Options) Reference(reference string) *Options {
+	o.options["reference"] = reference
+	return o
+}
+
+// ReportURL sets the URL delivery reports are sent to, overriding the account default.
+func (o *Options) ReportURL(url string) *Options {
+	o.options["reportUrl"] = url
+	return o
+}
+
+// Validity sets how long (in seconds) the message is valid for delivery.
+func (o *Options) Validity(seconds int) *Options {
+	o.options["validity"] = seconds
+	return o
+}
+
+// DataCoding sets the encoding: "plain", "unicode" or "auto" (default).
+func (o *Options) DataCoding(coding string) *Options {
+	o.options["datacoding"] = coding
+	return o
+}
+
+// Flash sends the message as a flash SMS that is shown immediately and not stored.
+func (o *Options) Flash() *Options {
+	o.options["mclass"] = 0
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"recipients": []string(nil),
+	"validity":   0,
+	"mclass":     0,
+}
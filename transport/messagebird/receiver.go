@@ -0,0 +1,84 @@
+package messagebird
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Delivery report statuses.
+const (
+	StatusScheduled      = "scheduled"
+	StatusSent           = "sent"
+	StatusBuffered       = "buffered"
+	StatusDelivered      = "delivered"
+	StatusExpired        = "expired"
+	StatusDeliveryFailed = "delivery_failed"
+)
+
+// DeliveryReport is a status report MessageBird sends to the report URL for each recipient.
+type DeliveryReport struct {
+	ID              string
+	Reference       string
+	Recipient       string
+	Status          string
+	StatusReason    string
+	StatusErrorCode string
+	StatusDatetime  time.Time
+	MCCMNC          string
+	PriceAmount     string
+	PriceCurrency   string
+}
+
+// Delivered reports whether the message reached the handset.
+func (r *DeliveryReport) Delivered() bool {
+	return r.Status == StatusDelivered
+}
+
+// ParseDeliveryReport parses a delivery report request. MessageBird sends reports
+// as GET requests with the values in the query string.
+func ParseDeliveryReport(r *http.Request) (*DeliveryReport, error) {
+	if err := r.ParseForm(); err != nil {
+		return nil, fmt.Errorf("messagebird: parse delivery report: %w", err)
+	}
+
+	report := &DeliveryReport{
+		ID:              r.Form.Get("id"),
+		Reference:       r.Form.Get("reference"),
+		Recipient:       r.Form.Get("recipient"),
+		Status:          r.Form.Get("status"),
+		StatusReason:    r.Form.Get("statusReason"),
+		StatusErrorCode: r.Form.Get("statusErrorCode"),
+		MCCMNC:          r.Form.Get("mccmnc"),
+		PriceAmount:     r.Form.Get("price[amount]"),
+		PriceCurrency:   r.Form.Get("price[currency]"),
+	}
+
+	if report.ID == "" || report.Status == "" {
+		return nil, fmt.Errorf("messagebird: invalid delivery report: missing id or status")
+	}
+
+	if value := r.Form.Get("statusDatetime"); value != "" {
+		datetime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("messagebird: invalid delivery report: %w", err)
+		}
+		report.StatusDatetime = datetime
+	}
+
+	return report, nil
+}
+
+// DeliveryReportHandler returns an http.Handler that parses delivery reports and passes
+// them to handle. MessageBird retries reports until they are answered with 200 OK.
+func DeliveryReportHandler(handle func(*DeliveryReport)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, err := ParseDeliveryReport(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		handle(report)
+		w.WriteHeader(http.StatusOK)
+	})
+}
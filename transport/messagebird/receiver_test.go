@@ -0,0 +1,63 @@
+package messagebird
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestParseDeliveryReport(t *testing.T) {
+	req := httptest.NewRequest("GET", "/reports?id=e8077d803532c0b5937c639b60216938&reference=deploy-42&recipient=31612345678&status=delivery_failed&statusReason=unknown%20subscriber&statusErrorCode=1&statusDatetime=2024-01-02T10%3A00%3A00%2B00%3A00&mccmnc=20408&price%5Bamount%5D=0.07&price%5Bcurrency%5D=EUR", nil)
+
+	report, err := ParseDeliveryReport(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if report.ID != "e8077d803532c0b5937c639b60216938" || report.Reference != "deploy-42" || report.Recipient != "31612345678" {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+	if report.Status != StatusDeliveryFailed || report.StatusReason != "unknown subscriber" || report.StatusErrorCode != "1" {
+		t.Errorf("Unexpected status: %+v", report)
+	}
+	if report.PriceAmount != "0.07" || report.PriceCurrency != "EUR" {
+		t.Errorf("Unexpected price: %+v", report)
+	}
+	if report.StatusDatetime.Year() != 2024 || report.Delivered() {
+		t.Errorf("Unexpected report: %+v", report)
+	}
+}
+
+func TestParseDeliveryReportInvalid(t *testing.T) {
+	for _, target := range []string{
+		"/reports?status=delivered",
+		"/reports?id=1&status=delivered&statusDatetime=yesterday",
+	} {
+		if _, err := ParseDeliveryReport(httptest.NewRequest("GET", target, nil)); err == nil {
+			t.Errorf("Expected error for %s", target)
+		}
+	}
+}
+
+func TestDeliveryReportHandler(t *testing.T) {
+	var received []*DeliveryReport
+	handler := DeliveryReportHandler(func(report *DeliveryReport) {
+		received = append(received, report)
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/reports?id=1&status=delivered", nil))
+	if rec.Code != http.StatusOK {
+		t.Errorf("Expected status 200, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/reports", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+
+	if len(received) != 1 || !received[0].Delivered() {
+		t.Errorf("Unexpected reports: %v", received)
+	}
+}
@@ -0,0 +1,141 @@
+package messagebird
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends SMS via the MessageBird REST API.
+type Transport struct {
+	*notifier.AbstractTransport
+	accessKey  string
+	originator string
+}
+
+// NewTransport creates a new MessageBird transport. originator is the default sender
+// (phone number or alphanumeric sender ID of up to 11 characters).
+func NewTransport(accessKey, originator string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		accessKey:         accessKey,
+		originator:        originator,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("messagebird://%s?from=%s", t.getEndpoint(), t.originator)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.SmsMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	smsMsg, ok := message.(*notifier.SmsMessage)
+	if !ok {
+		return nil, fmt.Errorf("messagebird: unsupported message type %T, expected SmsMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := smsMsg.GetOptions("messagebird").(*Options); ok {
+		for key, value := range opts.ToMap() {
+			options[key] = value
+		}
+	}
+
+	var recipients []string
+	if phone := smsMsg.GetPhone(); phone != "" {
+		recipients = append(recipients, phone)
+	}
+	if extra, ok := options["recipients"].([]string); ok {
+		recipients = append(recipients, extra...)
+	}
+	if len(recipients) == 0 {
+		return nil, fmt.Errorf("messagebird: missing recipient")
+	}
+	for i, recipient := range recipients {
+		recipients[i] = strings.TrimPrefix(recipient, "+")
+	}
+
+	originator := smsMsg.GetFrom()
+	if originator == "" {
+		originator = t.originator
+	}
+
+	options["originator"] = originator
+	options["recipients"] = recipients
+	options["body"] = smsMsg.GetSubject()
+	if _, ok := options["datacoding"]; !ok {
+		options["datacoding"] = "auto"
+	}
+
+	jsonBody, err := notifier.JSONMarshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("messagebird: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/messages", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("messagebird: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "AccessKey "+t.accessKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("messagebird: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "messagebird",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("messagebird: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ID         string `json:"id"`
+		Recipients struct {
+			TotalCount     int `json:"totalCount"`
+			TotalSentCount int `json:"totalSentCount"`
+		} `json:"recipients"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("messagebird: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	sentMessage.SetInfo("total_count", result.Recipients.TotalCount)
+	sentMessage.SetInfo("total_sent_count", result.Recipients.TotalSentCount)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "rest.messagebird.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,194 @@
+package messagebird
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://rest.messagebird.com/messages" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "AccessKey live_key" {
+			t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+		}
+
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return newResponse(201, `{"id":"e8077d803532c0b5937c639b60216938","recipients":{"totalCount":3,"totalSentCount":3}}`), nil
+	})
+
+	transport := NewTransport("live_key", "ACME", client)
+
+	msg := notifier.NewSmsMessage("+31612345678", "Deploy finished").
+		WithOptions("messagebird", NewOptions().
+			Recipients("+31687654321", "31611111111").
+			Reference("deploy-42").
+			ReportURL("https://example.com/reports"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if sentMsg.GetMessageID() != "e8077d803532c0b5937c639b60216938" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+	if sentMsg.GetInfo("total_sent_count") != 3 {
+		t.Errorf("Expected total_sent_count 3, got %v", sentMsg.GetInfo("total_sent_count"))
+	}
+
+	recipients := capturedBody["recipients"].([]any)
+	if len(recipients) != 3 || recipients[0] != "31612345678" || recipients[1] != "31687654321" || recipients[2] != "31611111111" {
+		t.Errorf("Unexpected recipients: %v", recipients)
+	}
+	if capturedBody["originator"] != "ACME" || capturedBody["body"] != "Deploy finished" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if capturedBody["reference"] != "deploy-42" || capturedBody["reportUrl"] != "https://example.com/reports" {
+		t.Errorf("Unexpected options: %v", capturedBody)
+	}
+	if capturedBody["datacoding"] != "auto" {
+		t.Errorf("Expected datacoding 'auto', got '%v'", capturedBody["datacoding"])
+	}
+
+	// Sending again must not accumulate recipients in the options
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if len(capturedBody["recipients"].([]any)) != 3 {
+		t.Errorf("Expected 3 recipients on resend, got %v", capturedBody["recipients"])
+	}
+}
+
+func TestTransportSendCustomOriginator(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+		return newResponse(201, `{"id":"1"}`), nil
+	})
+
+	transport := NewTransport("live_key", "ACME", client)
+	msg := notifier.NewSmsMessage("31612345678", "Hello").From("Alerts")
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedBody["originator"] != "Alerts" {
+		t.Errorf("Expected originator 'Alerts', got '%v'", capturedBody["originator"])
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(422, `{"errors":[{"code":9,"description":"no (correct) recipients found","parameter":"recipient"}]}`), nil
+	})
+
+	transport := NewTransport("live_key", "ACME", client)
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("invalid", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 422)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendRateLimited(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(429, `{"errors":[{"code":429,"description":"Too many requests"}]}`)
+		resp.Header.Set("Retry-After", "2")
+		return resp, nil
+	})
+
+	transport := NewTransport("live_key", "ACME", client)
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("31612345678", "Hello"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+	if rateLimitErr.RetryAfter.Seconds() != 2 {
+		t.Errorf("Expected retry after 2s, got %s", rateLimitErr.RetryAfter)
+	}
+}
+
+func TestTransportMissingRecipient(t *testing.T) {
+	transport := NewTransport("live_key", "ACME", nil)
+	_, err := transport.Send(context.Background(), notifier.NewSmsMessage("", "Hello"))
+	if err == nil || !strings.Contains(err.Error(), "missing recipient") {
+		t.Errorf("Expected missing recipient error, got: %v", err)
+	}
+}
+
+func TestTransportUnsupportedMessage(t *testing.T) {
+	transport := NewTransport("live_key", "ACME", nil)
+
+	if transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Expected ChatMessage not to be supported")
+	}
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "unsupported message type") {
+		t.Errorf("Expected unsupported message error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("messagebird://live_key@default?from=ACME")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support messagebird DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	if transport.String() != "messagebird://rest.messagebird.com?from=ACME" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	for _, invalid := range []string{
+		"messagebird://default?from=ACME",
+		"messagebird://live_key@default",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
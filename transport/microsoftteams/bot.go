@@ -0,0 +1,295 @@
+package microsoftteams
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultBotTenant is the tenant used to obtain Bot Framework tokens for multi-tenant bots.
+const DefaultBotTenant = "botframework.com"
+
+// BotTransport sends proactive messages through a registered Teams bot using the Bot Framework REST API.
+// Unlike incoming webhooks, it can deliver 1:1 messages to individual users.
+type BotTransport struct {
+	*notifier.AbstractTransport
+	appID       string
+	appPassword string
+	tenantID    string
+	serviceURL  string
+	tokenURL    string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+	tokenFetch  *tokenFetch
+}
+
+// tokenFetch is a token request in flight, shared by the sends waiting for it.
+type tokenFetch struct {
+	done  chan struct{}
+	token string
+	err   error
+}
+
+// NewBotTransport creates a new Teams bot transport.
+// serviceURL is the Bot Framework service URL of the tenant (e.g., https://smba.trafficmanager.net/emea/).
+func NewBotTransport(appID, appPassword, tenantID, serviceURL string, client *http.Client) *BotTransport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	tokenTenant := tenantID
+	if tokenTenant == "" {
+		tokenTenant = DefaultBotTenant
+	}
+	return &BotTransport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		appID:             appID,
+		appPassword:       appPassword,
+		tenantID:          tenantID,
+		serviceURL:        strings.TrimSuffix(serviceURL, "/"),
+		tokenURL:          fmt.Sprintf("https://login.microsoftonline.com/%s/oauth2/v2.0/token", tokenTenant),
+	}
+}
+
+// SetTokenURL overrides the OAuth token endpoint.
+func (t *BotTransport) SetTokenURL(tokenURL string) *BotTransport {
+	t.tokenURL = tokenURL
+	return t
+}
+
+func (t *BotTransport) String() string {
+	return fmt.Sprintf("microsoftteamsbot://%s?service_url=%s", t.appID, t.serviceURL)
+}
+
+func (t *BotTransport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *BotTransport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("microsoftteamsbot: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("microsoftteamsbot").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	token, err := t.getAccessToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conversationID, _ := options["conversation_id"].(string)
+	if conversationID == "" {
+		userID := chatMsg.GetRecipientId()
+		if userID == "" {
+			return nil, fmt.Errorf("microsoftteamsbot: missing recipient, set a user ID or conversation ID")
+		}
+		conversationID, err = t.createConversation(ctx, token, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	text := chatMsg.GetSubject()
+	if title, ok := options["title"].(string); ok && title != "" {
+		text = fmt.Sprintf("**%s**\n\n%s", title, chatMsg.GetText())
+	} else if content := chatMsg.GetContent(); content != "" {
+		text = fmt.Sprintf("**%s**\n\n%s", text, content)
+	}
+
+	activity := map[string]any{
+		"type":       "message",
+		"text":       text,
+		"textFormat": "markdown",
+	}
+	if attachments, ok := options["attachments"]; ok {
+		activity["attachments"] = attachments
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	endpoint := fmt.Sprintf("%s/v3/conversations/%s/activities", t.serviceURL, url.PathEscape(conversationID))
+	raw, err := t.doJSON(ctx, token, endpoint, activity, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	sentMessage.SetInfo("conversation_id", conversationID)
+
+	return sentMessage, nil
+}
+
+// createConversation creates (or resumes) a 1:1 conversation with the given user and returns its ID.
+func (t *BotTransport) createConversation(ctx context.Context, token, userID string) (string, error) {
+	body := map[string]any{
+		"bot":     map[string]any{"id": t.appID},
+		"members": []map[string]any{{"id": userID}},
+		"isGroup": false,
+	}
+	if t.tenantID != "" {
+		body["tenantId"] = t.tenantID
+		body["channelData"] = map[string]any{"tenant": map[string]any{"id": t.tenantID}}
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if _, err := t.doJSON(ctx, token, t.serviceURL+"/v3/conversations", body, &result); err != nil {
+		return "", err
+	}
+	if result.ID == "" {
+		return "", fmt.Errorf("microsoftteamsbot: create conversation: empty conversation ID")
+	}
+	return result.ID, nil
+}
+
+func (t *BotTransport) doJSON(ctx context.Context, token, endpoint string, payload any, result any) (any, error) {
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("microsoftteamsbot: marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("microsoftteamsbot: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("microsoftteamsbot: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "microsoftteamsbot",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("microsoftteamsbot: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("microsoftteamsbot: read response: %w", err)
+	}
+	if len(bytes.TrimSpace(respBody)) == 0 {
+		// Some endpoints answer without a body
+		return nil, nil
+	}
+	raw, err := t.DecodeResponse(bytes.NewReader(respBody), result)
+	if err != nil {
+		return nil, fmt.Errorf("microsoftteamsbot: decode response: %w", err)
+	}
+	return raw, nil
+}
+
+// getAccessToken returns a cached Bot Framework token, requesting a new one when expired.
+// Concurrent sends share a single token request, which runs without holding the lock.
+func (t *BotTransport) getAccessToken(ctx context.Context) (string, error) {
+	for {
+		t.mu.Lock()
+		if t.accessToken != "" && time.Now().Before(t.expiresAt) {
+			token := t.accessToken
+			t.mu.Unlock()
+			return token, nil
+		}
+
+		if fetch := t.tokenFetch; fetch != nil {
+			t.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return "", ctx.Err()
+			case <-fetch.done:
+			}
+			// A request given up by its caller is retried with this context
+			if errors.Is(fetch.err, context.Canceled) || errors.Is(fetch.err, context.DeadlineExceeded) {
+				continue
+			}
+			return fetch.token, fetch.err
+		}
+
+		fetch := &tokenFetch{done: make(chan struct{})}
+		t.tokenFetch = fetch
+		t.mu.Unlock()
+
+		var expiresIn time.Duration
+		fetch.token, expiresIn, fetch.err = t.fetchAccessToken(ctx)
+
+		t.mu.Lock()
+		if fetch.err == nil {
+			t.accessToken = fetch.token
+			// Refresh early to avoid using a token that expires in flight, by at most a
+			// quarter of the lifetime so short-lived tokens are still cached
+			t.expiresAt = time.Now().Add(expiresIn - min(time.Minute, expiresIn/4))
+		}
+		t.tokenFetch = nil
+		t.mu.Unlock()
+		close(fetch.done)
+
+		return fetch.token, fetch.err
+	}
+}
+
+// fetchAccessToken requests a new Bot Framework token and returns it with its lifetime.
+func (t *BotTransport) fetchAccessToken(ctx context.Context) (string, time.Duration, error) {
+	form := url.Values{}
+	form.Set("grant_type", "client_credentials")
+	form.Set("client_id", t.appID)
+	form.Set("client_secret", t.appPassword)
+	form.Set("scope", "https://api.botframework.com/.default")
+
+	req, err := http.NewRequestWithContext(ctx, "POST", t.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", 0, fmt.Errorf("microsoftteamsbot: create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return "", 0, fmt.Errorf("microsoftteamsbot: token request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return "", 0, fmt.Errorf("microsoftteamsbot: token error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", 0, fmt.Errorf("microsoftteamsbot: decode token response: %w", err)
+	}
+
+	return result.AccessToken, time.Duration(result.ExpiresIn) * time.Second, nil
+}
@@ -93,7 +93,30 @@ func (o *Options) AddHttpPostAction(name, target string, body map[string]any) *O
 	return o.PotentialAction(action)
 }
 
+// Conversation sets the conversation ID to post into (bot transport only).
+// When not set, the bot transport creates a 1:1 conversation with the recipient.
+func (o *Options) Conversation(id string) *Options {
+	o.options["conversation_id"] = id
+	return o
+}
+
+// AdaptiveCard attaches an Adaptive Card to the message (bot transport only).
+func (o *Options) AdaptiveCard(card map[string]any) *Options {
+	attachments, _ := o.options["attachments"].([]map[string]any)
+	o.options["attachments"] = append(attachments, map[string]any{
+		"contentType": "application/vnd.microsoft.card.adaptive",
+		"content":     card,
+	})
+	return o
+}
+
 // MarshalJSON implements json.Marshaler.
 func (o *Options) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.options)
 }
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"potentialAction": []map[string]any(nil),
+	"attachments":     []map[string]any(nil),
+}
@@ -3,18 +3,30 @@ package microsoftteams
 import (
 	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/shyim/go-notifier"
 )
 
-// Transport sends messages via Microsoft Teams Webhook API.
+// DefaultRequestsPerSecond is the documented request limit of Teams incoming webhooks.
+const DefaultRequestsPerSecond = 4
+
+// Transport sends messages via Microsoft Teams Webhook API. Webhooks don't return an API
+// response, so SetCaptureRawResponse has no effect.
 type Transport struct {
 	*notifier.AbstractTransport
-	webhookURL string
+	webhookURL   string
+	colorMapping map[string]int
+
+	mu          sync.Mutex
+	minInterval time.Duration
+	nextSend    time.Time
 }
 
 // NewTransport creates a new Microsoft Teams transport.
@@ -25,7 +37,27 @@ func NewTransport(webhookURL string, client *http.Client) *Transport {
 	return &Transport{
 		AbstractTransport: notifier.NewAbstractTransport(client),
 		webhookURL:        webhookURL,
+		colorMapping:      notifier.ImportanceColors,
+		minInterval:       time.Second / DefaultRequestsPerSecond,
+	}
+}
+
+// SetColorMapping overrides the importance to theme color mapping (0xRRGGBB).
+func (t *Transport) SetColorMapping(mapping map[string]int) *Transport {
+	t.colorMapping = mapping
+	return t
+}
+
+// SetRequestsPerSecond sets the client-side pacing limit. A value of 0 or less disables pacing.
+func (t *Transport) SetRequestsPerSecond(limit int) *Transport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if limit <= 0 {
+		t.minInterval = 0
+	} else {
+		t.minInterval = time.Second / time.Duration(limit)
 	}
+	return t
 }
 
 func (t *Transport) String() string {
@@ -46,20 +78,37 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 
 	options := make(map[string]any)
 	if opts, ok := chatMsg.GetOptions("microsoftteams").(*Options); ok {
-		options = opts.ToMap()
+		// Derived fields are added to a copy, the options may be reused for other messages
+		options = maps.Clone(opts.ToMap())
+	}
+
+	// An explicit theme color takes precedence over the message importance
+	if _, ok := options["themeColor"]; !ok {
+		if color, ok := t.colorMapping[chatMsg.GetImportance()]; ok {
+			options["themeColor"] = fmt.Sprintf("%06X", color)
+		}
 	}
 
 	// Teams expects "text" field for simple messages
 	// If no theme color or title is set, use simple text format
-	if _, hasTitle := options["title"]; !hasTitle {
+	_, hasTitle := options["title"]
+	if !hasTitle && chatMsg.GetContent() != "" {
+		// The subject becomes the card title
+		options["title"] = chatMsg.GetSubject()
+		options["text"] = chatMsg.GetContent()
+	} else if !hasTitle {
 		options["text"] = chatMsg.GetSubject()
 	} else {
+		text := options["text"]
+		if isEmptyValue(text) {
+			text = chatMsg.GetContent()
+		}
 		// Use MessageCard format for rich messages
 		sections := []map[string]any{
 			{
 				"activityTitle":    chatMsg.GetSubject(),
 				"activitySubtitle": options["subtitle"],
-				"activityText":     options["text"],
+				"activityText":     text,
 			},
 		}
 
@@ -78,7 +127,7 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 		}
 	}
 
-	jsonBody, err := json.Marshal(filteredOptions)
+	jsonBody, err := notifier.JSONMarshal(filteredOptions)
 	if err != nil {
 		return nil, fmt.Errorf("microsoftteams: marshal options: %w", err)
 	}
@@ -95,22 +144,101 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := t.AbstractTransport.GetClient().Do(req)
+	if err := t.wait(ctx); err != nil {
+		return nil, fmt.Errorf("microsoftteams: %w", err)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("microsoftteams: send request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
-	// Teams returns 200 on success, but body is empty
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "microsoftteams",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    fmt.Sprintf("API error (status %d): %s", resp.StatusCode, string(respBody)),
+		}
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("microsoftteams: API error (status %d): %s", resp.StatusCode, string(respBody))
 	}
 
+	// Teams returns 200 with "1" on success, but reports some failures
+	// (e.g. throttling by the downstream connector) in a 200 body as well
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("microsoftteams: read response: %w", err)
+	}
+	if err := checkResponseBody(resp, string(respBody)); err != nil {
+		return nil, err
+	}
+
 	sentMessage := notifier.NewSentMessage(message, t.String())
 	return sentMessage, nil
 }
 
+// wait blocks until the next request may be sent according to the pacing limit. A wait
+// cancelled by the context gives its slot back unless a later request reserved one since.
+func (t *Transport) wait(ctx context.Context) error {
+	t.mu.Lock()
+	now := time.Now()
+	sendAt := now
+	if t.nextSend.After(now) {
+		sendAt = t.nextSend
+	}
+	t.nextSend = sendAt.Add(t.minInterval)
+	t.mu.Unlock()
+
+	delay := sendAt.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		t.mu.Lock()
+		if t.nextSend.Equal(sendAt.Add(t.minInterval)) {
+			t.nextSend = sendAt
+		}
+		t.mu.Unlock()
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// throttledPrefix starts the body of a 200 response for a request throttled by Teams.
+const throttledPrefix = "Microsoft Teams endpoint returned HTTP error 429"
+
+// checkResponseBody detects soft failures reported with a 200 status code.
+func checkResponseBody(resp *http.Response, body string) error {
+	body = strings.TrimSpace(body)
+	if body == "" || body == "1" {
+		return nil
+	}
+
+	if strings.HasPrefix(body, throttledPrefix) || strings.Contains(strings.ToLower(body), "throttl") {
+		return &notifier.RateLimitError{
+			Transport:  "microsoftteams",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    body,
+		}
+	}
+
+	if strings.Contains(strings.ToLower(body), "error") || strings.Contains(strings.ToLower(body), "failed") {
+		return fmt.Errorf("microsoftteams: API error (status %d): %s", resp.StatusCode, body)
+	}
+
+	return nil
+}
+
 func (t *Transport) getEndpoint() string {
 	endpoint := t.GetEndpoint()
 	if endpoint == "" || endpoint == "localhost" {
@@ -147,6 +147,13 @@ func TestMissingToken(t *testing.T) {
 	}
 }
 
+func TestRawResponseRejected(t *testing.T) {
+	_, err := notifier.NewTransportFromDSN("microsoftteams://abc123@default?token=def456/ghi789&raw_response=1")
+	if err == nil || !strings.Contains(err.Error(), "raw_response is not supported") {
+		t.Errorf("Expected raw_response to be rejected, got: %v", err)
+	}
+}
+
 // HTTP Client Tests
 
 // mockRoundTripper is a custom RoundTripper for mocking HTTP requests
@@ -216,6 +223,101 @@ func TestHTTPSuccessSimpleText(t *testing.T) {
 	}
 }
 
+func TestHTTPImportanceThemeColor(t *testing.T) {
+	var receivedBody []byte
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(server.URL, server.Client())
+
+	tests := []struct {
+		name     string
+		message  *notifier.ChatMessage
+		expected string
+	}{
+		{"importance", notifier.NewChatMessage("Backup failed").Importance(notifier.ImportanceHigh), "F57C00"},
+		{"explicit color", notifier.NewChatMessage("Backup failed").Importance(notifier.ImportanceHigh).
+			WithOptions("microsoftteams", NewOptions().ThemeColor("000000")), "000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			var body map[string]any
+			if err := json.Unmarshal(receivedBody, &body); err != nil {
+				t.Fatalf("Failed to unmarshal request body: %v", err)
+			}
+			if body["themeColor"] != tt.expected {
+				t.Errorf("Expected themeColor %s, got: %v", tt.expected, body["themeColor"])
+			}
+		})
+	}
+}
+
+func TestHTTPImportanceThemeColorReusedOptions(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(server.URL, server.Client())
+	options := NewOptions().Title("Backups")
+
+	urgent := notifier.NewChatMessage("Backup failed").Importance(notifier.ImportanceUrgent).WithOptions("microsoftteams", options)
+	if _, err := transport.Send(context.Background(), urgent); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	// The urgent color doesn't stick to the shared options
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Backup done").WithOptions("microsoftteams", options)); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if body["themeColor"] != nil {
+		t.Errorf("Expected no theme color, got: %v", body["themeColor"])
+	}
+}
+
+func TestHTTPReusedOptions(t *testing.T) {
+	var receivedBody []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receivedBody, _ = io.ReadAll(r.Body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(server.URL, server.Client())
+	options := NewOptions().Subtitle("web-1")
+
+	for _, subject := range []string{"first", "second"} {
+		msg := notifier.NewChatMessage(subject).Content(subject+" content").WithOptions("microsoftteams", options)
+		if _, err := transport.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+
+		var body map[string]any
+		if err := json.Unmarshal(receivedBody, &body); err != nil {
+			t.Fatalf("Failed to unmarshal request body: %v", err)
+		}
+		if body["title"] != subject || body["text"] != subject+" content" {
+			t.Errorf("Expected the %s message, got: %v", subject, body)
+		}
+	}
+
+	if _, ok := options.ToMap()["title"]; ok {
+		t.Errorf("Expected the options not to be modified, got: %v", options.ToMap())
+	}
+}
+
 func TestHTTPSuccessMessageCard(t *testing.T) {
 	// Create a test server
 	var receivedBody []byte
@@ -910,3 +1012,106 @@ func TestHTTPLargePayload(t *testing.T) {
 		t.Error("Large text was not transmitted correctly")
 	}
 }
+
+func TestHTTPSoftFailureResponses(t *testing.T) {
+	tests := []struct {
+		name          string
+		responseBody  string
+		wantRateLimit bool
+		wantErr       bool
+	}{
+		{"success with 1", "1", false, false},
+		{"throttled", "Microsoft Teams endpoint returned HTTP error 429 with ContextId tcid=0", true, true},
+		{"connector error", "Webhook message delivery failed with error: Microsoft Teams endpoint returned HTTP error 400", false, true},
+		{"error mentioning 429", "Card payload failed validation: error at line 429", false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Retry-After", "2")
+				w.WriteHeader(http.StatusOK)
+				w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			transport := NewTransport(server.URL, server.Client())
+			_, err := transport.Send(context.Background(), notifier.NewChatMessage("Test"))
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error=%v, got: %v", tt.wantErr, err)
+			}
+
+			var rateLimitErr *notifier.RateLimitError
+			if errors.As(err, &rateLimitErr) != tt.wantRateLimit {
+				t.Fatalf("Expected rate limit error=%v, got: %v", tt.wantRateLimit, err)
+			}
+			if tt.wantRateLimit && rateLimitErr.RetryAfter != 2*time.Second {
+				t.Errorf("Expected RetryAfter 2s, got: %s", rateLimitErr.RetryAfter)
+			}
+		})
+	}
+}
+
+func TestHTTPTooManyRequestsIsRateLimitError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	transport := NewTransport(server.URL, server.Client())
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Test"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestHTTPClientSidePacing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("1"))
+	}))
+	defer server.Close()
+
+	transport := NewTransport(server.URL, server.Client()).SetRequestsPerSecond(20)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Test")); err != nil {
+			t.Fatalf("Request %d failed: %v", i, err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("Expected requests to be paced to at least 100ms, took: %s", elapsed)
+	}
+}
+
+func TestHTTPClientSidePacingCancelledWait(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("1"))
+	}))
+	defer server.Close()
+
+	transport := NewTransport(server.URL, server.Client()).SetRequestsPerSecond(10)
+
+	start := time.Now()
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Test")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := transport.Send(ctx, notifier.NewChatMessage("Test")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected context error while waiting, got: %v", err)
+	}
+
+	// The cancelled send gave its slot back, so the next one waits a single interval
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Test")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond || elapsed >= 190*time.Millisecond {
+		t.Errorf("Expected the send to wait one interval, took: %s", elapsed)
+	}
+}
@@ -0,0 +1,180 @@
+package mqtt
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+)
+
+// MQTT 3.1.1 control packet types.
+const (
+	packetConnect    = 1
+	packetConnAck    = 2
+	packetPublish    = 3
+	packetPubAck     = 4
+	packetPubRec     = 5
+	packetPubRel     = 6
+	packetPubComp    = 7
+	packetDisconnect = 14
+)
+
+// connAckErrors describes the CONNACK return codes.
+var connAckErrors = map[byte]string{
+	1: "unacceptable protocol version",
+	2: "identifier rejected",
+	3: "server unavailable",
+	4: "bad user name or password",
+	5: "not authorized",
+}
+
+// conn is a minimal MQTT 3.1.1 client able to connect and publish a single message.
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+}
+
+// connect sends CONNECT with a clean session and waits for CONNACK.
+func (c *conn) connect(clientID, username, password string) error {
+	var flags byte = 0x02
+	if username != "" {
+		flags |= 0x80
+	}
+	if password != "" {
+		flags |= 0x40
+	}
+
+	body := appendString(nil, "MQTT")
+	body = append(body, 4, flags, 0, 60)
+	body = appendString(body, clientID)
+	if username != "" {
+		body = appendString(body, username)
+	}
+	if password != "" {
+		body = appendString(body, password)
+	}
+	if err := c.write(packetConnect<<4, body); err != nil {
+		return err
+	}
+
+	packetType, payload, err := c.read()
+	if err != nil {
+		return err
+	}
+	if packetType != packetConnAck || len(payload) != 2 {
+		return fmt.Errorf("unexpected packet %d, expected CONNACK", packetType)
+	}
+	if code := payload[1]; code != 0 {
+		if reason, ok := connAckErrors[code]; ok {
+			return fmt.Errorf("connection refused: %s", reason)
+		}
+		return fmt.Errorf("connection refused: return code %d", code)
+	}
+	return nil
+}
+
+// publish sends a PUBLISH packet and completes the acknowledgement flow for the QoS level.
+func (c *conn) publish(topic string, payload []byte, qos byte, retain bool, packetID uint16) error {
+	header := byte(packetPublish<<4) | qos<<1
+	if retain {
+		header |= 0x01
+	}
+
+	body := appendString(nil, topic)
+	if qos > 0 {
+		body = binary.BigEndian.AppendUint16(body, packetID)
+	}
+	body = append(body, payload...)
+	if err := c.write(header, body); err != nil {
+		return err
+	}
+
+	switch qos {
+	case 1:
+		return c.expectAck(packetPubAck, packetID)
+	case 2:
+		if err := c.expectAck(packetPubRec, packetID); err != nil {
+			return err
+		}
+		if err := c.write(packetPubRel<<4|0x02, binary.BigEndian.AppendUint16(nil, packetID)); err != nil {
+			return err
+		}
+		return c.expectAck(packetPubComp, packetID)
+	}
+	return nil
+}
+
+// disconnect sends DISCONNECT and closes the connection.
+func (c *conn) disconnect() error {
+	_ = c.write(packetDisconnect<<4, nil)
+	return c.netConn.Close()
+}
+
+func (c *conn) expectAck(expected byte, packetID uint16) error {
+	packetType, payload, err := c.read()
+	if err != nil {
+		return err
+	}
+	if packetType != expected || len(payload) < 2 {
+		return fmt.Errorf("unexpected packet %d, expected %d", packetType, expected)
+	}
+	if id := binary.BigEndian.Uint16(payload); id != packetID {
+		return fmt.Errorf("unexpected packet identifier %d, expected %d", id, packetID)
+	}
+	return nil
+}
+
+func (c *conn) write(header byte, body []byte) error {
+	packet := []byte{header}
+	length := len(body)
+	for {
+		digit := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			digit |= 0x80
+		}
+		packet = append(packet, digit)
+		if length == 0 {
+			break
+		}
+	}
+	packet = append(packet, body...)
+	_, err := c.netConn.Write(packet)
+	return err
+}
+
+func (c *conn) read() (byte, []byte, error) {
+	header, err := c.reader.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	length, multiplier := 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return 0, nil, errors.New("malformed remaining length")
+		}
+		digit, err := c.reader.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length += int(digit&0x7f) * multiplier
+		if digit&0x80 == 0 {
+			break
+		}
+		multiplier *= 128
+	}
+
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(c.reader, payload); err != nil {
+		return 0, nil, err
+	}
+	return header >> 4, payload, nil
+}
+
+func appendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
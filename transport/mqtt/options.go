@@ -0,0 +1,67 @@
+package mqtt
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for MQTT.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Topic overrides the topic the message is published to.
+func (o *Options) Topic(topic string) *Options {
+	o.options["topic"] = topic
+	return o
+}
+
+// QoS overrides the quality of service level (0, 1 or 2).
+func (o *Options) QoS(qos byte) *Options {
+	o.options["qos"] = qos
+	return o
+}
+
+// Retain overrides whether the broker retains the message.
+func (o *Options) Retain(retain bool) *Options {
+	o.options["retain"] = retain
+	return o
+}
+
+// Metadata adds a key-value pair to the metadata of the published payload.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"qos":      byte(0),
+	"metadata": map[string]string(nil),
+}
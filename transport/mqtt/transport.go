@@ -0,0 +1,185 @@
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the MQTT port for unencrypted connections.
+const DefaultPort = 1883
+
+// DefaultTLSPort is the MQTT port for TLS connections.
+const DefaultTLSPort = 8883
+
+// Transport publishes messages as JSON to an MQTT topic.
+// Each Send opens a short-lived MQTT 3.1.1 session with a clean session flag.
+type Transport struct {
+	*notifier.AbstractTransport
+	topic              string
+	username           string
+	password           string
+	clientID           string
+	qos                byte
+	retain             bool
+	useTLS             bool
+	insecureSkipVerify bool
+}
+
+// NewTransport creates a new MQTT transport publishing to the given topic.
+func NewTransport(topic string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		topic:             topic,
+	}
+}
+
+// SetCredentials sets the user name and password used to connect to the broker.
+func (t *Transport) SetCredentials(username, password string) *Transport {
+	t.username = username
+	t.password = password
+	return t
+}
+
+// SetClientID sets the client identifier. A random identifier is used if empty.
+func (t *Transport) SetClientID(clientID string) *Transport {
+	t.clientID = clientID
+	return t
+}
+
+// SetQoS sets the default quality of service level (0, 1 or 2).
+func (t *Transport) SetQoS(qos byte) *Transport {
+	t.qos = qos
+	return t
+}
+
+// SetRetain sets whether the broker should retain the last message on the topic.
+func (t *Transport) SetRetain(retain bool) *Transport {
+	t.retain = retain
+	return t
+}
+
+// SetTLS enables TLS for the broker connection.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed brokers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	scheme := "mqtt"
+	if t.useTLS {
+		scheme = "mqtts"
+	}
+	return fmt.Sprintf("%s://%s?topic=%s", scheme, t.getAddress(), t.topic)
+}
+
+// Supports returns true for all message types, they are published as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("mqtt").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	topic, qos, retain := t.topic, t.qos, t.retain
+	if value, ok := options["topic"].(string); ok && value != "" {
+		topic = value
+	}
+	if value, ok := options["qos"].(byte); ok {
+		qos = value
+	}
+	if value, ok := options["retain"].(bool); ok {
+		retain = value
+	}
+	if topic == "" {
+		return nil, fmt.Errorf("mqtt: missing topic")
+	}
+	if qos > 2 {
+		return nil, fmt.Errorf("mqtt: invalid QoS %d, expected 0, 1 or 2", qos)
+	}
+
+	payload := notifier.NewPayload(message)
+	if metadata, ok := options["metadata"].(map[string]string); ok {
+		payload.Metadata = metadata
+	}
+	body, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("mqtt: marshal payload: %w", err)
+	}
+
+	clientID := t.clientID
+	if clientID == "" {
+		clientID = newClientID()
+	}
+
+	netConn, err := t.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("mqtt: connect: %w", err)
+	}
+	stop := notifier.BindConnContext(ctx, netConn)
+	defer stop()
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn)}
+	defer func() { _ = c.disconnect() }()
+
+	if err := c.connect(clientID, t.username, t.password); err != nil {
+		return nil, fmt.Errorf("mqtt: %w", err)
+	}
+
+	// Each session publishes a single message, so a constant packet identifier is sufficient
+	if err := c.publish(topic, body, qos, retain, 1); err != nil {
+		return nil, fmt.Errorf("mqtt: publish: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("topic", topic)
+	sentMessage.SetInfo("qos", int(qos))
+	sentMessage.SetInfo("client_id", clientID)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) dial(ctx context.Context) (net.Conn, error) {
+	address := t.getAddress()
+	if t.useTLS {
+		host, _, _ := net.SplitHostPort(address)
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}}
+		return dialer.DialContext(ctx, "tcp", address)
+	}
+	var dialer net.Dialer
+	return dialer.DialContext(ctx, "tcp", address)
+}
+
+func (t *Transport) getAddress() string {
+	endpoint := t.GetEndpoint()
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		port := DefaultPort
+		if t.useTLS {
+			port = DefaultTLSPort
+		}
+		return net.JoinHostPort(endpoint, strconv.Itoa(port))
+	}
+	return endpoint
+}
+
+func newClientID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return "go-notifier-" + hex.EncodeToString(b)
+}
@@ -0,0 +1,225 @@
+package nats
+
+import (
+	"bufio"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// serverInfo is the INFO message sent by the server when a client connects.
+type serverInfo struct {
+	TLSRequired bool `json:"tls_required"`
+	Headers     bool `json:"headers"`
+}
+
+// connectOptions is the CONNECT message sent by the client.
+type connectOptions struct {
+	Verbose      bool   `json:"verbose"`
+	Pedantic     bool   `json:"pedantic"`
+	TLSRequired  bool   `json:"tls_required"`
+	User         string `json:"user,omitempty"`
+	Pass         string `json:"pass,omitempty"`
+	AuthToken    string `json:"auth_token,omitempty"`
+	Name         string `json:"name"`
+	Lang         string `json:"lang"`
+	Version      string `json:"version"`
+	Protocol     int    `json:"protocol"`
+	Headers      bool   `json:"headers"`
+	NoResponders bool   `json:"no_responders"`
+}
+
+// reply is a message received on a subscription.
+type reply struct {
+	status  string
+	payload []byte
+}
+
+// conn is a minimal NATS client able to publish messages and wait for a reply.
+type conn struct {
+	netConn net.Conn
+	reader  *bufio.Reader
+	info    serverInfo
+}
+
+// handshake reads INFO, upgrades to TLS if requested and sends CONNECT.
+// It waits for the PONG of a PING to make sure the server accepted the connection.
+func (c *conn) handshake(options connectOptions, tlsConfig *tls.Config) error {
+	line, err := c.readLine()
+	if err != nil {
+		return err
+	}
+	info, ok := strings.CutPrefix(line, "INFO ")
+	if !ok {
+		return fmt.Errorf("unexpected greeting %q", line)
+	}
+	if err := json.Unmarshal([]byte(info), &c.info); err != nil {
+		return fmt.Errorf("decode server info: %w", err)
+	}
+
+	if c.info.TLSRequired && tlsConfig == nil {
+		return errors.New("server requires TLS, use tls=1")
+	}
+	if tlsConfig != nil {
+		tlsConn := tls.Client(c.netConn, tlsConfig)
+		if err := tlsConn.Handshake(); err != nil {
+			return fmt.Errorf("TLS handshake: %w", err)
+		}
+		c.netConn = tlsConn
+		c.reader = bufio.NewReader(tlsConn)
+		options.TLSRequired = true
+	}
+
+	options.Headers = c.info.Headers
+	options.NoResponders = c.info.Headers
+	connect, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(c.netConn, "CONNECT %s\r\n", connect); err != nil {
+		return err
+	}
+	return c.flush()
+}
+
+// subscribe subscribes to a subject, used to receive JetStream acknowledgements.
+func (c *conn) subscribe(subject, sid string) error {
+	_, err := fmt.Fprintf(c.netConn, "SUB %s %s\r\n", subject, sid)
+	return err
+}
+
+// publish publishes a payload with optional headers and reply subject.
+func (c *conn) publish(subject, replyTo string, headers map[string]string, payload []byte) error {
+	if replyTo != "" {
+		replyTo += " "
+	}
+
+	if len(headers) == 0 {
+		_, err := fmt.Fprintf(c.netConn, "PUB %s %s%d\r\n%s\r\n", subject, replyTo, len(payload), payload)
+		return err
+	}
+	if !c.info.Headers {
+		return errors.New("server does not support headers")
+	}
+
+	keys := make([]string, 0, len(headers))
+	for key := range headers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var header strings.Builder
+	header.WriteString("NATS/1.0\r\n")
+	for _, key := range keys {
+		header.WriteString(key + ": " + headers[key] + "\r\n")
+	}
+	header.WriteString("\r\n")
+
+	_, err := fmt.Fprintf(c.netConn, "HPUB %s %s%d %d\r\n%s%s\r\n", subject, replyTo, header.Len(), header.Len()+len(payload), header.String(), payload)
+	return err
+}
+
+// flush sends PING and waits for PONG, so all previous commands have been processed.
+func (c *conn) flush() error {
+	if _, err := io.WriteString(c.netConn, "PING\r\n"); err != nil {
+		return err
+	}
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return err
+		}
+		switch {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			if _, err := io.WriteString(c.netConn, "PONG\r\n"); err != nil {
+				return err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return serverError(line)
+		}
+	}
+}
+
+// nextReply waits for the next MSG or HMSG.
+func (c *conn) nextReply() (*reply, error) {
+	for {
+		line, err := c.readLine()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case line == "PING":
+			if _, err := io.WriteString(c.netConn, "PONG\r\n"); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(line, "-ERR"):
+			return nil, serverError(line)
+		case strings.HasPrefix(line, "MSG "):
+			// MSG <subject> <sid> [reply-to] <#bytes>
+			fields := strings.Fields(line)
+			size, err := strconv.Atoi(fields[len(fields)-1])
+			if err != nil {
+				return nil, fmt.Errorf("malformed message %q", line)
+			}
+			payload, err := c.readPayload(size)
+			if err != nil {
+				return nil, err
+			}
+			return &reply{payload: payload}, nil
+		case strings.HasPrefix(line, "HMSG "):
+			// HMSG <subject> <sid> [reply-to] <#header bytes> <#total bytes>
+			fields := strings.Fields(line)
+			if len(fields) < 5 {
+				return nil, fmt.Errorf("malformed message %q", line)
+			}
+			headerSize, err1 := strconv.Atoi(fields[len(fields)-2])
+			totalSize, err2 := strconv.Atoi(fields[len(fields)-1])
+			if err1 != nil || err2 != nil || headerSize > totalSize {
+				return nil, fmt.Errorf("malformed message %q", line)
+			}
+			data, err := c.readPayload(totalSize)
+			if err != nil {
+				return nil, err
+			}
+			// The status follows the version on the first header line, e.g. "NATS/1.0 503"
+			statusLine, _, _ := strings.Cut(string(data[:headerSize]), "\r\n")
+			_, status, _ := strings.Cut(statusLine, " ")
+			return &reply{status: strings.TrimSpace(status), payload: data[headerSize:]}, nil
+		}
+	}
+}
+
+func (c *conn) readPayload(size int) ([]byte, error) {
+	data := make([]byte, size+2)
+	if _, err := io.ReadFull(c.reader, data); err != nil {
+		return nil, err
+	}
+	return data[:size], nil
+}
+
+func (c *conn) readLine() (string, error) {
+	line, err := c.reader.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (c *conn) close() error {
+	return c.netConn.Close()
+}
+
+// serverError converts an -ERR line into an error.
+func serverError(line string) error {
+	message := strings.Trim(strings.TrimSpace(strings.TrimPrefix(line, "-ERR")), "'")
+	return fmt.Errorf("server error: %s", message)
+}
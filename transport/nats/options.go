@@ -0,0 +1,72 @@
+package nats
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for NATS.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Subject overrides the subject the message is published to.
+func (o *Options) Subject(subject string) *Options {
+	o.options["subject"] = subject
+	return o
+}
+
+// MessageID sets the Nats-Msg-Id header, which JetStream uses to discard duplicates.
+func (o *Options) MessageID(id string) *Options {
+	o.options["message_id"] = id
+	return o
+}
+
+// Header adds a message header.
+func (o *Options) Header(key, value string) *Options {
+	headers, ok := o.options["headers"].(map[string]string)
+	if !ok {
+		headers = make(map[string]string)
+		o.options["headers"] = headers
+	}
+	headers[key] = value
+	return o
+}
+
+// Metadata adds a key-value pair to the metadata of the published payload.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"headers":  map[string]string(nil),
+	"metadata": map[string]string(nil),
+}
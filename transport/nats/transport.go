@@ -0,0 +1,213 @@
+package nats
+
+import (
+	"bufio"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the NATS client port.
+const DefaultPort = 4222
+
+// jetStreamAck is the acknowledgement of a message published to a JetStream stream.
+type jetStreamAck struct {
+	Stream    string `json:"stream"`
+	Sequence  uint64 `json:"seq"`
+	Duplicate bool   `json:"duplicate"`
+	Error     *struct {
+		Code        int    `json:"code"`
+		Description string `json:"description"`
+	} `json:"error"`
+}
+
+// Transport publishes messages as JSON to a NATS subject.
+// With JetStream enabled, Send waits for the stream to acknowledge the message.
+// Each Send opens a short-lived connection.
+type Transport struct {
+	*notifier.AbstractTransport
+	subject            string
+	username           string
+	password           string
+	token              string
+	jetStream          bool
+	useTLS             bool
+	insecureSkipVerify bool
+}
+
+// NewTransport creates a new NATS transport publishing to the given subject.
+func NewTransport(subject string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		subject:           subject,
+	}
+}
+
+// SetCredentials sets the user name and password used to connect.
+func (t *Transport) SetCredentials(username, password string) *Transport {
+	t.username = username
+	t.password = password
+	return t
+}
+
+// SetToken sets the authentication token used to connect.
+func (t *Transport) SetToken(token string) *Transport {
+	t.token = token
+	return t
+}
+
+// SetJetStream enables publishing to a JetStream stream and waiting for its acknowledgement.
+func (t *Transport) SetJetStream(enabled bool) *Transport {
+	t.jetStream = enabled
+	return t
+}
+
+// SetTLS enables TLS for the server connection.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed servers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("nats://%s?subject=%s", t.getAddress(), t.subject)
+}
+
+// Supports returns true for all message types, they are published as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("nats").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	subject := t.subject
+	if value, ok := options["subject"].(string); ok && value != "" {
+		subject = value
+	}
+	if subject == "" {
+		return nil, fmt.Errorf("nats: missing subject")
+	}
+
+	headers := make(map[string]string)
+	if value, ok := options["headers"].(map[string]string); ok {
+		for key, header := range value {
+			headers[key] = header
+		}
+	}
+	if value, ok := options["message_id"].(string); ok && value != "" {
+		// Used by JetStream to discard duplicates
+		headers["Nats-Msg-Id"] = value
+	}
+
+	payload := notifier.NewPayload(message)
+	if metadata, ok := options["metadata"].(map[string]string); ok {
+		payload.Metadata = metadata
+	}
+	body, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("nats: marshal payload: %w", err)
+	}
+
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", t.getAddress())
+	if err != nil {
+		return nil, fmt.Errorf("nats: connect: %w", err)
+	}
+	stop := notifier.BindConnContext(ctx, netConn)
+	defer stop()
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn)}
+	defer func() { _ = c.close() }()
+
+	var tlsConfig *tls.Config
+	if t.useTLS {
+		host, _, _ := net.SplitHostPort(t.getAddress())
+		tlsConfig = &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}
+	}
+	err = c.handshake(connectOptions{
+		User:      t.username,
+		Pass:      t.password,
+		AuthToken: t.token,
+		Name:      "go-notifier",
+		Lang:      "go",
+		Version:   "1.0.0",
+		Protocol:  1,
+	}, tlsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("nats: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("subject", subject)
+
+	if !t.jetStream {
+		if err := c.publish(subject, "", headers, body); err != nil {
+			return nil, fmt.Errorf("nats: publish: %w", err)
+		}
+		if err := c.flush(); err != nil {
+			return nil, fmt.Errorf("nats: publish: %w", err)
+		}
+		return sentMessage, nil
+	}
+
+	inbox := newInbox()
+	if err := c.subscribe(inbox, "1"); err != nil {
+		return nil, fmt.Errorf("nats: subscribe: %w", err)
+	}
+	if err := c.publish(subject, inbox, headers, body); err != nil {
+		return nil, fmt.Errorf("nats: publish: %w", err)
+	}
+
+	response, err := c.nextReply()
+	if err != nil {
+		return nil, fmt.Errorf("nats: wait for acknowledgement: %w", err)
+	}
+	if response.status == "503" {
+		return nil, fmt.Errorf("nats: no JetStream stream for subject %s", subject)
+	}
+
+	var ack jetStreamAck
+	if err := json.Unmarshal(response.payload, &ack); err != nil {
+		return nil, fmt.Errorf("nats: decode acknowledgement: %w", err)
+	}
+	if ack.Error != nil {
+		return nil, fmt.Errorf("nats: JetStream error (code %d): %s", ack.Error.Code, ack.Error.Description)
+	}
+
+	sentMessage.SetMessageID(fmt.Sprintf("%s:%d", ack.Stream, ack.Sequence))
+	sentMessage.SetInfo("stream", ack.Stream)
+	sentMessage.SetInfo("sequence", ack.Sequence)
+	sentMessage.SetInfo("duplicate", ack.Duplicate)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getAddress() string {
+	endpoint := t.GetEndpoint()
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		return net.JoinHostPort(endpoint, strconv.Itoa(DefaultPort))
+	}
+	return endpoint
+}
+
+func newInbox() string {
+	b := make([]byte, 12)
+	_, _ = rand.Read(b)
+	return "_INBOX." + hex.EncodeToString(b)
+}
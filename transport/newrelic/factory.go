@@ -0,0 +1,94 @@
+package newrelic
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("newrelic", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates New Relic transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new New Relic transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a New Relic transport from a DSN.
+// DSN format: newrelic://<insert_key>@default?account_id=<id>[&event_type=<type>][&region=eu] for custom events
+// or newrelic://<user_key>@default?entity_guid=<guid>[&region=eu] for deployment markers
+// Example: newrelic://INSERT_KEY@default?account_id=1234567&event_type=Deploy
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "newrelic" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	apiKey := dsn.GetUser()
+	if apiKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing API key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	accountID := dsn.GetOption("account_id")
+	entityGUID := dsn.GetOption("entity_guid")
+	if (accountID == "") == (entityGUID == "") {
+		return nil, fmt.Errorf("invalid DSN: Exactly one of account_id or entity_guid is required. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	region := dsn.GetOption("region", RegionUS)
+	if region != RegionUS && region != RegionEU {
+		return nil, fmt.Errorf("invalid DSN: Unsupported region \"%s\" (supported: us, eu). DSN: %s", region, dsn.GetOriginalDSN())
+	}
+
+	var transport *Transport
+	if entityGUID != "" {
+		transport = NewDeploymentTransport(apiKey, entityGUID, f.client)
+	} else {
+		transport = NewTransport(apiKey, accountID, f.client)
+		transport.SetEventType(dsn.GetOption("event_type", DefaultEventType))
+	}
+	transport.SetRegion(region)
+
+	host := dsn.GetHost()
+	if host != "" && host != "default" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"newrelic"}
+}
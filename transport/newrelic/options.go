@@ -0,0 +1,91 @@
+package newrelic
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for New Relic.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// EventType sets the type of the custom event instead of the configured one.
+func (o *Options) EventType(eventType string) *Options {
+	o.options["event_type"] = eventType
+	return o
+}
+
+// Attribute adds an attribute to the custom event. Values must be strings, numbers or booleans.
+func (o *Options) Attribute(key string, value any) *Options {
+	attributes, ok := o.options["attributes"].(map[string]any)
+	if !ok {
+		attributes = make(map[string]any)
+		o.options["attributes"] = attributes
+	}
+	attributes[key] = value
+	return o
+}
+
+// Version sets the deployed version, required for deployment markers.
+func (o *Options) Version(version string) *Options {
+	o.options["version"] = version
+	return o
+}
+
+// Changelog sets the changelog of the deployment.
+func (o *Options) Changelog(changelog string) *Options {
+	o.options["changelog"] = changelog
+	return o
+}
+
+// Commit sets the commit hash of the deployment.
+func (o *Options) Commit(commit string) *Options {
+	o.options["commit"] = commit
+	return o
+}
+
+// User sets the user who triggered the deployment.
+func (o *Options) User(user string) *Options {
+	o.options["user"] = user
+	return o
+}
+
+// DeepLink sets a link to the deployment, such as a CI pipeline.
+func (o *Options) DeepLink(url string) *Options {
+	o.options["deepLink"] = url
+	return o
+}
+
+// GroupID groups deployments of several entities.
+func (o *Options) GroupID(id string) *Options {
+	o.options["groupId"] = id
+	return o
+}
+
+// DeploymentType sets the deployment strategy ("BASIC", "BLUE_GREEN", "CANARY", "OTHER", "ROLLING" or "SHADOW").
+func (o *Options) DeploymentType(deploymentType string) *Options {
+	o.options["deploymentType"] = deploymentType
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
@@ -0,0 +1,258 @@
+package newrelic
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultEventType is the event type of custom events when none is configured.
+const DefaultEventType = "Notification"
+
+// Regions of the New Relic data centers.
+const (
+	RegionUS = "us"
+	RegionEU = "eu"
+)
+
+const deploymentMutation = `mutation($deployment: ChangeTrackingDeploymentInput!) {
+  changeTrackingCreateDeployment(deployment: $deployment) { deploymentId entityGuid }
+}`
+
+// Transport records messages in New Relic, either as custom events through the Event API
+// or as deployment markers through the NerdGraph change tracking API.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey     string
+	accountID  string
+	entityGUID string
+	eventType  string
+	region     string
+}
+
+// NewTransport creates a new transport sending custom events to the account, authenticated with an insert key.
+// Events can be queried with NRQL (e.g., SELECT * FROM Notification).
+func NewTransport(apiKey, accountID string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		accountID:         accountID,
+		eventType:         DefaultEventType,
+		region:            RegionUS,
+	}
+}
+
+// NewDeploymentTransport creates a new transport recording deployment markers on the entity
+// (e.g., an APM application), authenticated with a user key. The markers annotate APM charts.
+func NewDeploymentTransport(apiKey, entityGUID string, client *http.Client) *Transport {
+	transport := NewTransport(apiKey, "", client)
+	transport.entityGUID = entityGUID
+	return transport
+}
+
+// SetEventType sets the type of custom events.
+func (t *Transport) SetEventType(eventType string) *Transport {
+	t.eventType = eventType
+	return t
+}
+
+// SetRegion sets the data center region of the account (RegionUS or RegionEU).
+func (t *Transport) SetRegion(region string) *Transport {
+	t.region = region
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.entityGUID != "" {
+		return fmt.Sprintf("newrelic://%s?entity_guid=%s", t.getEndpoint(), t.entityGUID)
+	}
+	return fmt.Sprintf("newrelic://%s?account_id=%s", t.getEndpoint(), t.accountID)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("newrelic: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("newrelic").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	if t.entityGUID != "" {
+		return t.sendDeployment(ctx, chatMsg, options)
+	}
+	return t.sendEvent(ctx, chatMsg, options)
+}
+
+func (t *Transport) sendEvent(ctx context.Context, message *notifier.ChatMessage, options map[string]any) (*notifier.SentMessage, error) {
+	event := map[string]any{
+		"eventType": t.eventType,
+		"message":   message.GetText(),
+	}
+	if importance := message.GetImportance(); importance != "" {
+		event["importance"] = importance
+	}
+	if attributes, ok := options["attributes"].(map[string]any); ok {
+		for key, value := range attributes {
+			event[key] = value
+		}
+	}
+	if eventType, ok := options["event_type"].(string); ok && eventType != "" {
+		event["eventType"] = eventType
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v1/accounts/%s/events", t.getEndpoint(), t.accountID)
+	respBody, err := t.post(ctx, endpoint, "X-Insert-Key", []any{event})
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Success bool   `json:"success"`
+		UUID    string `json:"uuid"`
+	}
+
+	raw, err := t.DecodeResponse(bytes.NewReader(respBody), &result)
+	if err != nil {
+		return nil, fmt.Errorf("newrelic: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.UUID)
+	sentMessage.SetInfo("event_type", event["eventType"])
+
+	return sentMessage, nil
+}
+
+func (t *Transport) sendDeployment(ctx context.Context, message *notifier.ChatMessage, options map[string]any) (*notifier.SentMessage, error) {
+	version, _ := options["version"].(string)
+	if version == "" {
+		return nil, fmt.Errorf("newrelic: a deployment version is required, set it with Options.Version")
+	}
+
+	deployment := map[string]any{
+		"entityGuid":  t.entityGUID,
+		"version":     version,
+		"description": message.GetSubject(),
+	}
+	for _, key := range []string{"changelog", "commit", "user", "deepLink", "groupId", "deploymentType"} {
+		if value, ok := options[key].(string); ok && value != "" {
+			deployment[key] = value
+		}
+	}
+
+	body := map[string]any{
+		"query":     deploymentMutation,
+		"variables": map[string]any{"deployment": deployment},
+	}
+
+	respBody, err := t.post(ctx, fmt.Sprintf("https://%s/graphql", t.getEndpoint()), "API-Key", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result struct {
+		Data struct {
+			Deployment *struct {
+				DeploymentID string `json:"deploymentId"`
+				EntityGUID   string `json:"entityGuid"`
+			} `json:"changeTrackingCreateDeployment"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+
+	raw, err := t.DecodeResponse(bytes.NewReader(respBody), &result)
+	if err != nil {
+		return nil, fmt.Errorf("newrelic: decode response: %w", err)
+	}
+
+	// NerdGraph reports errors with a 200 status code
+	if len(result.Errors) > 0 || result.Data.Deployment == nil {
+		messages := make([]string, 0, len(result.Errors))
+		for _, e := range result.Errors {
+			messages = append(messages, e.Message)
+		}
+		return nil, fmt.Errorf("newrelic: API error: %s", strings.Join(messages, "; "))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Data.Deployment.DeploymentID)
+	sentMessage.SetInfo("entity_guid", result.Data.Deployment.EntityGUID)
+	sentMessage.SetInfo("version", version)
+
+	return sentMessage, nil
+}
+
+// post sends the JSON body with the API key in the given header and returns the response body.
+func (t *Transport) post(ctx context.Context, endpoint, keyHeader string, body any) ([]byte, error) {
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("newrelic: marshal options: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("newrelic: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(keyHeader, t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("newrelic: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &notifier.RateLimitError{
+			Transport:  "newrelic",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("newrelic: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint != "" && endpoint != "localhost" {
+		return endpoint
+	}
+	switch {
+	case t.entityGUID != "" && t.region == RegionEU:
+		return "api.eu.newrelic.com"
+	case t.entityGUID != "":
+		return "api.newrelic.com"
+	case t.region == RegionEU:
+		return "insights-collector.eu01.nr-data.net"
+	default:
+		return "insights-collector.newrelic.com"
+	}
+}
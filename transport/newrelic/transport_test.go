@@ -0,0 +1,204 @@
+package newrelic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSendEvent(t *testing.T) {
+	var capturedBody []map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://insights-collector.eu01.nr-data.net/v1/accounts/1234567/events" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("X-Insert-Key") != "insert-key" {
+			t.Errorf("Unexpected X-Insert-Key header: %s", req.Header.Get("X-Insert-Key"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(200, `{"success":true,"uuid":"f6a5a3b6-0001-b000-0000-018f1a2b3c4d"}`), nil
+	})
+
+	transport := NewTransport("insert-key", "1234567", client).SetRegion(RegionEU)
+
+	msg := notifier.NewChatMessage("Deploy finished").
+		Importance(notifier.ImportanceHigh).
+		WithOptions("newrelic", NewOptions().Attribute("service", "api").Attribute("duration", 42))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "f6a5a3b6-0001-b000-0000-018f1a2b3c4d" || sentMsg.GetInfo("event_type") != DefaultEventType {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	if len(capturedBody) != 1 {
+		t.Fatalf("Expected one event, got %v", capturedBody)
+	}
+	event := capturedBody[0]
+	if event["eventType"] != DefaultEventType || event["message"] != "Deploy finished" || event["importance"] != notifier.ImportanceHigh {
+		t.Errorf("Unexpected event: %v", event)
+	}
+	if event["service"] != "api" || event["duration"] != float64(42) {
+		t.Errorf("Unexpected attributes: %v", event)
+	}
+}
+
+func TestTransportSendDeployment(t *testing.T) {
+	var capturedBody struct {
+		Query     string `json:"query"`
+		Variables struct {
+			Deployment map[string]any `json:"deployment"`
+		} `json:"variables"`
+	}
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://api.newrelic.com/graphql" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("API-Key") != "user-key" {
+			t.Errorf("Unexpected API-Key header: %s", req.Header.Get("API-Key"))
+		}
+		_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(200, `{"data":{"changeTrackingCreateDeployment":{"deploymentId":"d-123","entityGuid":"MXxBUE18QVBQTElDQVRJT058MQ"}}}`), nil
+	})
+
+	transport := NewDeploymentTransport("user-key", "MXxBUE18QVBQTElDQVRJT058MQ", client)
+
+	msg := notifier.NewChatMessage("Release 1.2.3").
+		WithOptions("newrelic", NewOptions().Version("1.2.3").Commit("abc123").User("ci"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "d-123" || sentMsg.GetInfo("version") != "1.2.3" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	if !strings.Contains(capturedBody.Query, "changeTrackingCreateDeployment") {
+		t.Errorf("Unexpected query: %s", capturedBody.Query)
+	}
+	deployment := capturedBody.Variables.Deployment
+	if deployment["entityGuid"] != "MXxBUE18QVBQTElDQVRJT058MQ" || deployment["version"] != "1.2.3" || deployment["description"] != "Release 1.2.3" {
+		t.Errorf("Unexpected deployment: %v", deployment)
+	}
+	if deployment["commit"] != "abc123" || deployment["user"] != "ci" {
+		t.Errorf("Unexpected deployment details: %v", deployment)
+	}
+}
+
+func TestTransportSendDeploymentErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(200, `{"data":{"changeTrackingCreateDeployment":null},"errors":[{"message":"Entity not found"}]}`), nil
+	})
+
+	transport := NewDeploymentTransport("user-key", "unknown", client)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Release"))
+	if err == nil || !strings.Contains(err.Error(), "deployment version is required") {
+		t.Errorf("Expected missing version error, got: %v", err)
+	}
+
+	msg := notifier.NewChatMessage("Release").WithOptions("newrelic", NewOptions().Version("1.0.0"))
+	_, err = transport.Send(context.Background(), msg)
+	if err == nil || !strings.Contains(err.Error(), "newrelic: API error: Entity not found") {
+		t.Errorf("Expected GraphQL error, got: %v", err)
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(403, `{"error":"invalid key"}`), nil
+	})
+
+	_, err := NewTransport("wrong", "1234567", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "newrelic: API error (status 403)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(429, "Too Many Requests"), nil
+	})
+
+	_, err = NewTransport("insert-key", "1234567", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	tests := []struct {
+		dsn       string
+		expected  string
+		eventType string
+	}{
+		{"newrelic://insert-key@default?account_id=1234567", "newrelic://insights-collector.newrelic.com?account_id=1234567", DefaultEventType},
+		{"newrelic://insert-key@default?account_id=1234567&event_type=Deploy&region=eu", "newrelic://insights-collector.eu01.nr-data.net?account_id=1234567", "Deploy"},
+		{"newrelic://user-key@default?entity_guid=MXxBUE18&region=eu", "newrelic://api.eu.newrelic.com?entity_guid=MXxBUE18", DefaultEventType},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+
+		if transport.String() != tt.expected {
+			t.Errorf("Expected string '%s', got '%s'", tt.expected, transport.String())
+		}
+		if transport.(*Transport).eventType != tt.eventType {
+			t.Errorf("Unexpected event type for %s: %s", tt.dsn, transport.(*Transport).eventType)
+		}
+	}
+
+	for _, invalid := range []string{
+		"newrelic://default?account_id=1234567",
+		"newrelic://key@default",
+		"newrelic://key@default?account_id=1&entity_guid=2",
+		"newrelic://key@default?account_id=1&region=ap",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
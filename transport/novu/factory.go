@@ -0,0 +1,93 @@
+package novu
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("novu", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates Novu transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Novu transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Novu transport from a DSN.
+// DSN format: novu://<api_key>@default?workflow=<workflow_id>[&subscriber_id=<id>][&region=eu]
+// Example: novu://API_KEY@default?workflow=deploy-alerts&subscriber_id=ops-team
+//
+// Self-hosted instances use their API host instead of default.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "novu" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	apiKey := dsn.GetUser()
+	if apiKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing API key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	workflow, err := dsn.GetRequiredOption("workflow")
+	if err != nil {
+		return nil, err
+	}
+
+	region := dsn.GetOption("region", RegionUS)
+	if region != RegionUS && region != RegionEU {
+		return nil, fmt.Errorf("invalid DSN: Unsupported region \"%s\" (supported: us, eu). DSN: %s", region, dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(apiKey, workflow, f.client)
+	transport.SetSubscriberID(dsn.GetOption("subscriber_id"))
+	transport.SetRegion(region)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"novu"}
+}
@@ -0,0 +1,78 @@
+package novu
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Novu.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["subscriber_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Workflow triggers the workflow with the given identifier instead of the configured one.
+func (o *Options) Workflow(workflow string) *Options {
+	o.options["workflow"] = workflow
+	return o
+}
+
+// SubscriberID triggers the workflow for the given subscriber.
+func (o *Options) SubscriberID(id string) *Options {
+	o.options["subscriber_id"] = id
+	return o
+}
+
+// Subscriber sets a subscriber attribute (e.g., "firstName", "email" or "phone"),
+// creating or updating the subscriber when the workflow is triggered.
+func (o *Options) Subscriber(key string, value any) *Options {
+	o.mapOption("subscriber")[key] = value
+	return o
+}
+
+// Payload sets a workflow payload variable, next to the message fields.
+func (o *Options) Payload(key string, value any) *Options {
+	o.mapOption("payload")[key] = value
+	return o
+}
+
+// Override sets the provider overrides of a channel (e.g., "email" or "fcm").
+func (o *Options) Override(channel string, values map[string]any) *Options {
+	o.mapOption("overrides")[channel] = values
+	return o
+}
+
+// TransactionID sets the transaction ID, which can be used to cancel delayed workflow steps.
+func (o *Options) TransactionID(id string) *Options {
+	o.options["transaction_id"] = id
+	return o
+}
+
+func (o *Options) mapOption(key string) map[string]any {
+	values, ok := o.options[key].(map[string]any)
+	if !ok {
+		values = make(map[string]any)
+		o.options[key] = values
+	}
+	return values
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
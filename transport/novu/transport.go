@@ -0,0 +1,208 @@
+package novu
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Regions of the Novu cloud.
+const (
+	RegionUS = "us"
+	RegionEU = "eu"
+)
+
+// Transport triggers Novu workflows, handing the delivery over to the channels and
+// preferences configured in Novu.
+//
+// The message is sent as the workflow payload (subject, content, ...), to the configured
+// subscriber or to the email address or phone number of the message.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey       string
+	workflow     string
+	subscriberID string
+	region       string
+}
+
+// NewTransport creates a new Novu transport triggering the workflow with the given identifier.
+func NewTransport(apiKey, workflow string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		workflow:          workflow,
+		region:            RegionUS,
+	}
+}
+
+// SetSubscriberID sets the default subscriber the workflow is triggered for.
+func (t *Transport) SetSubscriberID(id string) *Transport {
+	t.subscriberID = id
+	return t
+}
+
+// SetRegion sets the region of the Novu cloud (RegionUS or RegionEU).
+func (t *Transport) SetRegion(region string) *Transport {
+	t.region = region
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("novu://%s?workflow=%s", t.getEndpoint(), t.workflow)
+}
+
+// Supports returns true for all message types, they are sent as the workflow payload.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("novu").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	workflow := t.workflow
+	if value, ok := options["workflow"].(string); ok && value != "" {
+		workflow = value
+	}
+
+	to := make(map[string]any)
+	switch m := message.(type) {
+	case *notifier.EmailMessage:
+		if len(m.GetTo()) > 0 {
+			to["email"] = m.GetTo()[0]
+		}
+	case *notifier.SmsMessage:
+		to["phone"] = m.GetPhone()
+	}
+	if subscriber, ok := options["subscriber"].(map[string]any); ok {
+		for key, value := range subscriber {
+			to[key] = value
+		}
+	}
+
+	subscriberID := t.subscriberID
+	if value, ok := options["subscriber_id"].(string); ok && value != "" {
+		subscriberID = value
+	}
+	if subscriberID == "" {
+		// Fall back to the address, Novu creates the subscriber on the fly
+		subscriberID, _ = to["email"].(string)
+		if phone, ok := to["phone"].(string); ok && subscriberID == "" {
+			subscriberID = phone
+		}
+	}
+	if subscriberID == "" {
+		return nil, fmt.Errorf("novu: no subscriber ID, set it in the DSN or options")
+	}
+	to["subscriberId"] = subscriberID
+
+	payload := notifier.NewPayload(message)
+	payload.Options = nil
+	encoded, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("novu: marshal payload: %w", err)
+	}
+	var workflowPayload map[string]any
+	if err := json.Unmarshal(encoded, &workflowPayload); err != nil {
+		return nil, fmt.Errorf("novu: marshal payload: %w", err)
+	}
+	if values, ok := options["payload"].(map[string]any); ok {
+		for key, value := range values {
+			workflowPayload[key] = value
+		}
+	}
+
+	body := map[string]any{
+		"name":    workflow,
+		"to":      to,
+		"payload": workflowPayload,
+	}
+	if transactionID, ok := options["transaction_id"].(string); ok && transactionID != "" {
+		body["transactionId"] = transactionID
+	}
+	if overrides, ok := options["overrides"].(map[string]any); ok {
+		body["overrides"] = overrides
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("novu: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v1/events/trigger", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("novu: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "ApiKey "+t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("novu: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "novu",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("novu: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Data struct {
+			Status        string   `json:"status"`
+			TransactionID string   `json:"transactionId"`
+			Error         []string `json:"error"`
+		} `json:"data"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("novu: decode response: %w", err)
+	}
+
+	// Inactive or misconfigured workflows are acknowledged with another status
+	if result.Data.Status != "processed" {
+		return nil, fmt.Errorf("novu: workflow %q not triggered (status %s): %s", workflow, result.Data.Status, strings.Join(result.Data.Error, "; "))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Data.TransactionID)
+	sentMessage.SetInfo("status", result.Data.Status)
+	sentMessage.SetInfo("subscriber_id", subscriberID)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint != "" && endpoint != "localhost" {
+		return endpoint
+	}
+	if t.region == RegionEU {
+		return "eu.api.novu.co"
+	}
+	return "api.novu.co"
+}
@@ -0,0 +1,195 @@
+package novu
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://eu.api.novu.co/v1/events/trigger" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		if req.Header.Get("Authorization") != "ApiKey api-key" {
+			t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(201, `{"data":{"acknowledged":true,"status":"processed","transactionId":"tx-123"}}`), nil
+	})
+
+	transport := NewTransport("api-key", "deploy-alerts", client).SetSubscriberID("ops-team").SetRegion(RegionEU)
+
+	msg := notifier.NewChatMessage("Deploy finished").
+		Importance(notifier.ImportanceHigh).
+		WithOptions("novu", NewOptions().
+			Payload("version", "1.2.3").
+			Subscriber("firstName", "Ops").
+			Override("email", map[string]any{"replyTo": "ops@example.com"}).
+			TransactionID("deploy-42"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "tx-123" || sentMsg.GetInfo("subscriber_id") != "ops-team" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	if capturedBody["name"] != "deploy-alerts" || capturedBody["transactionId"] != "deploy-42" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	to, _ := capturedBody["to"].(map[string]any)
+	if to["subscriberId"] != "ops-team" || to["firstName"] != "Ops" {
+		t.Errorf("Unexpected subscriber: %v", to)
+	}
+	payload, _ := capturedBody["payload"].(map[string]any)
+	if payload["subject"] != "Deploy finished" || payload["importance"] != notifier.ImportanceHigh || payload["version"] != "1.2.3" {
+		t.Errorf("Unexpected payload: %v", payload)
+	}
+	if _, ok := payload["options"]; ok {
+		t.Errorf("Expected no transport options in payload, got %v", payload["options"])
+	}
+	if overrides, _ := capturedBody["overrides"].(map[string]any); overrides["email"] == nil {
+		t.Errorf("Unexpected overrides: %v", capturedBody["overrides"])
+	}
+}
+
+func TestTransportSendSubscriberFromMessage(t *testing.T) {
+	tests := []struct {
+		name         string
+		message      notifier.MessageInterface
+		subscriberID string
+		key          string
+	}{
+		{"email", notifier.NewEmailMessage("Invoice").To("billing@example.com"), "billing@example.com", "email"},
+		{"sms", notifier.NewSmsMessage("+33612345678", "Code 1234"), "+33612345678", "phone"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedBody map[string]any
+			client := newMockClient(func(req *http.Request) (*http.Response, error) {
+				_ = json.NewDecoder(req.Body).Decode(&capturedBody)
+				return newResponse(201, `{"data":{"acknowledged":true,"status":"processed","transactionId":"tx"}}`), nil
+			})
+
+			if _, err := NewTransport("api-key", "welcome", client).Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			to, _ := capturedBody["to"].(map[string]any)
+			if to["subscriberId"] != tt.subscriberID || to[tt.key] != tt.subscriberID {
+				t.Errorf("Unexpected subscriber: %v", to)
+			}
+		})
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(201, `{"data":{"acknowledged":true,"status":"trigger_not_active","error":["Workflow is not active"]}}`), nil
+	})
+	transport := NewTransport("api-key", "paused", client).SetSubscriberID("ops-team")
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), `workflow "paused" not triggered (status trigger_not_active): Workflow is not active`) {
+		t.Errorf("Expected inactive workflow error, got: %v", err)
+	}
+
+	_, err = NewTransport("api-key", "paused", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "no subscriber ID") {
+		t.Errorf("Expected missing subscriber error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(401, `{"statusCode":401,"message":"API Key not found"}`), nil
+	})
+	_, err = NewTransport("wrong", "alerts", client).SetSubscriberID("ops-team").Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "novu: API error (status 401)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(429, "Too Many Requests"), nil
+	})
+	_, err = NewTransport("api-key", "alerts", client).SetSubscriberID("ops-team").Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	tests := []struct {
+		dsn          string
+		expected     string
+		subscriberID string
+	}{
+		{"novu://api-key@default?workflow=alerts&subscriber_id=ops-team", "novu://api.novu.co?workflow=alerts", "ops-team"},
+		{"novu://api-key@default?workflow=alerts&region=eu", "novu://eu.api.novu.co?workflow=alerts", ""},
+		{"novu://api-key@novu.example.com?workflow=alerts", "novu://novu.example.com?workflow=alerts", ""},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+
+		if transport.String() != tt.expected {
+			t.Errorf("Expected string '%s', got '%s'", tt.expected, transport.String())
+		}
+		if transport.(*Transport).subscriberID != tt.subscriberID {
+			t.Errorf("Unexpected subscriber ID for %s: %s", tt.dsn, transport.(*Transport).subscriberID)
+		}
+	}
+
+	for _, invalid := range []string{
+		"novu://default?workflow=alerts",
+		"novu://api-key@default",
+		"novu://api-key@default?workflow=alerts&region=ap",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package ntfy
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("ntfy", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates ntfy transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new ntfy transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates an ntfy transport from a DSN.
+// DSN format: ntfy://[<token>@]<host>/<topic> or ntfy://<user>:<password>@<host>/<topic>
+// Example: ntfy://default/alerts or ntfy://tk_abc123@ntfy.example.com/alerts
+//
+// Plain HTTP servers can be reached with ntfy+http://<host>/<topic>.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	topic := strings.Trim(dsn.GetPath(), "/")
+	if topic == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing topic. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(topic, f.client)
+	if password := dsn.GetPassword(); password != "" {
+		transport.SetCredentials(dsn.GetUser(), password)
+	} else if token := dsn.GetUser(); token != "" {
+		transport.SetToken(token)
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+	if scheme == "ntfy+http" {
+		transport.SetScheme("http")
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"ntfy", "ntfy+http"}
+}
@@ -0,0 +1,198 @@
+package ntfy
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for ntfy.
+type Options struct {
+	options map[string]any
+	actions []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+		actions: make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.actions) > 0 {
+		o.options["actions"] = o.actions
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the topic to publish to.
+func (o *Options) Recipient(topic string) *Options {
+	o.options["recipient_id"] = topic
+	return o
+}
+
+// Title sets the notification title.
+func (o *Options) Title(title string) *Options {
+	o.options["title"] = title
+	return o
+}
+
+// Priority sets the message priority (1-5).
+// 1 = min, 3 = default, 5 = max
+func (o *Options) Priority(priority int) *Options {
+	o.options["priority"] = min(max(priority, 1), 5)
+	return o
+}
+
+// Tags sets the tags of the message. Tags matching an emoji short code (e.g., "warning") are
+// shown as emojis in front of the title.
+func (o *Options) Tags(tags ...string) *Options {
+	o.options["tags"] = tags
+	return o
+}
+
+// Click sets the URL opened when the notification is clicked.
+func (o *Options) Click(url string) *Options {
+	o.options["click"] = url
+	return o
+}
+
+// Attach attaches the file at the URL, optionally with a file name.
+func (o *Options) Attach(url string, filename ...string) *Options {
+	o.options["attach"] = url
+	if len(filename) > 0 {
+		o.options["filename"] = filename[0]
+	}
+	return o
+}
+
+// Icon sets the URL of the notification icon.
+func (o *Options) Icon(url string) *Options {
+	o.options["icon"] = url
+	return o
+}
+
+// Markdown enables Markdown formatting of the message.
+func (o *Options) Markdown(markdown bool) *Options {
+	o.options["markdown"] = markdown
+	return o
+}
+
+// Delay delivers the message after the delay (at least 10 seconds).
+func (o *Options) Delay(delay time.Duration) *Options {
+	o.options["delay"] = fmt.Sprintf("%ds", int(delay.Seconds()))
+	return o
+}
+
+// At delivers the message at the given time.
+func (o *Options) At(at time.Time) *Options {
+	o.options["delay"] = strconv.FormatInt(at.Unix(), 10)
+	return o
+}
+
+// Email forwards the message to the email address.
+func (o *Options) Email(email string) *Options {
+	o.options["email"] = email
+	return o
+}
+
+// AddAction adds an action button to the notification.
+func (o *Options) AddAction(action *Action) *Options {
+	if len(o.actions) >= 3 {
+		// ntfy limit
+		return o
+	}
+	o.actions = append(o.actions, action.ToMap())
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.ToMap())
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"priority": 0,
+	"tags":     []string(nil),
+	"actions":  []map[string]any(nil),
+}
+
+// Action represents an ntfy action button.
+type Action struct {
+	options map[string]any
+}
+
+// NewViewAction creates a button opening the URL.
+func NewViewAction(label, url string) *Action {
+	return &Action{options: map[string]any{"action": "view", "label": label, "url": url}}
+}
+
+// NewHTTPAction creates a button sending an HTTP request (POST by default) to the URL.
+func NewHTTPAction(label, url string) *Action {
+	return &Action{options: map[string]any{"action": "http", "label": label, "url": url}}
+}
+
+// NewBroadcastAction creates a button sending an Android broadcast intent.
+func NewBroadcastAction(label string) *Action {
+	return &Action{options: map[string]any{"action": "broadcast", "label": label}}
+}
+
+func (a *Action) ToMap() map[string]any {
+	return a.options
+}
+
+// Clear dismisses the notification after the button is tapped.
+func (a *Action) Clear(clear bool) *Action {
+	a.options["clear"] = clear
+	return a
+}
+
+// Method sets the HTTP method of an HTTP action.
+func (a *Action) Method(method string) *Action {
+	a.options["method"] = method
+	return a
+}
+
+// Header adds an HTTP header to an HTTP action.
+func (a *Action) Header(key, value string) *Action {
+	headers, _ := a.options["headers"].(map[string]string)
+	if headers == nil {
+		headers = make(map[string]string)
+		a.options["headers"] = headers
+	}
+	headers[key] = value
+	return a
+}
+
+// Body sets the HTTP body of an HTTP action.
+func (a *Action) Body(body string) *Action {
+	a.options["body"] = body
+	return a
+}
+
+// Intent sets the Android intent of a broadcast action.
+func (a *Action) Intent(intent string) *Action {
+	a.options["intent"] = intent
+	return a
+}
+
+// Extra adds an extra to the intent of a broadcast action.
+func (a *Action) Extra(key, value string) *Action {
+	extras, _ := a.options["extras"].(map[string]string)
+	if extras == nil {
+		extras = make(map[string]string)
+		a.options["extras"] = extras
+	}
+	extras[key] = value
+	return a
+}
@@ -0,0 +1,201 @@
+package ntfy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPriorityMapping translates message importance into ntfy priorities.
+var DefaultPriorityMapping = map[string]int{
+	notifier.ImportanceUrgent: 5,
+	notifier.ImportanceHigh:   4,
+	notifier.ImportanceMedium: 3,
+	notifier.ImportanceLow:    2,
+}
+
+// Transport publishes messages to ntfy.sh or a self-hosted ntfy server.
+type Transport struct {
+	*notifier.AbstractTransport
+	topic           string
+	token           string
+	user            string
+	password        string
+	scheme          string
+	priorityMapping map[string]int
+}
+
+// NewTransport creates a new ntfy transport publishing to the topic on ntfy.sh.
+// Self-hosted servers are set with SetHost.
+func NewTransport(topic string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		topic:             topic,
+		scheme:            "https",
+		priorityMapping:   DefaultPriorityMapping,
+	}
+}
+
+// SetToken sets the access token of protected topics.
+func (t *Transport) SetToken(token string) *Transport {
+	t.token = token
+	return t
+}
+
+// SetCredentials sets the username and password of protected topics.
+func (t *Transport) SetCredentials(user, password string) *Transport {
+	t.user = user
+	t.password = password
+	return t
+}
+
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+// SetPriorityMapping overrides the importance to priority mapping.
+func (t *Transport) SetPriorityMapping(mapping map[string]int) *Transport {
+	t.priorityMapping = mapping
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("ntfy://%s/%s", t.getEndpoint(), t.topic)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("ntfy: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("ntfy").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	topic := chatMsg.GetRecipientId()
+	if topic == "" {
+		topic = t.topic
+	}
+	if topic == "" {
+		return nil, fmt.Errorf("ntfy: missing topic")
+	}
+	delete(options, "recipient_id")
+	options["topic"] = topic
+
+	// The subject becomes the title if there is content
+	_, hasTitle := options["title"]
+	switch {
+	case hasTitle:
+		options["message"] = chatMsg.GetText()
+	case chatMsg.GetContent() != "":
+		options["title"] = chatMsg.GetSubject()
+		options["message"] = chatMsg.GetContent()
+	default:
+		options["message"] = chatMsg.GetSubject()
+	}
+
+	// An explicit priority option takes precedence over the message importance
+	if _, ok := options["priority"]; !ok {
+		if priority, ok := t.priorityMapping[chatMsg.GetImportance()]; ok {
+			options["priority"] = priority
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("ntfy: marshal options: %w", err)
+	}
+
+	// Publishing JSON requires posting to the root URL
+	endpoint := fmt.Sprintf("%s://%s/", t.scheme, t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("ntfy: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if t.token != "" {
+		req.Header.Set("Authorization", "Bearer "+t.token)
+	} else if t.user != "" {
+		req.SetBasicAuth(t.user, t.password)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ntfy: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "ntfy",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    errorMessage(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("ntfy: API error (status %d): %s", resp.StatusCode, errorMessage(respBody))
+	}
+
+	var result struct {
+		ID      string `json:"id"`
+		Time    int64  `json:"time"`
+		Expires int64  `json:"expires"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("ntfy: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	sentMessage.SetInfo("topic", topic)
+	if result.Expires > 0 {
+		sentMessage.SetInfo("expires", result.Expires)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "ntfy.sh"
+	}
+	return endpoint
+}
+
+// errorMessage returns the error of an ntfy error response, or the body if it isn't one.
+func errorMessage(body []byte) string {
+	var result struct {
+		Error string `json:"error"`
+	}
+	if json.Unmarshal(body, &result) == nil && result.Error != "" {
+		return result.Error
+	}
+	return string(body)
+}
@@ -0,0 +1,84 @@
+package opsgenie
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("opsgenie", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Opsgenie transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Opsgenie transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates an Opsgenie transport from a DSN.
+// DSN format: opsgenie://<api_key>@default[?region=us|eu]
+// Example: opsgenie://eb243592-faa2-4ba2-a551-1afdf565c889@default?region=eu
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "opsgenie" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	apiKey := dsn.GetUser()
+	if apiKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing API key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	region := dsn.GetOption("region", "us")
+	if _, ok := Regions[region]; !ok {
+		return nil, fmt.Errorf("invalid DSN: Unknown region \"%s\" (supported: us, eu). DSN: %s", region, dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+
+	transport := NewTransport(apiKey, f.client)
+	transport.SetRegion(region)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"opsgenie"}
+}
@@ -0,0 +1,132 @@
+package opsgenie
+
+import (
+	"encoding/json"
+)
+
+// Alert priorities.
+const (
+	PriorityP1 = "P1"
+	PriorityP2 = "P2"
+	PriorityP3 = "P3"
+	PriorityP4 = "P4"
+	PriorityP5 = "P5"
+)
+
+// Options implements MessageOptionsInterface for Opsgenie.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Alias sets the key identifying the alert. While an alert with the same alias is open,
+// new alerts are deduplicated into it.
+func (o *Options) Alias(alias string) *Options {
+	o.options["alias"] = alias
+	return o
+}
+
+// Priority sets the priority (PriorityP1 to PriorityP5), overriding the one derived from the
+// message importance.
+func (o *Options) Priority(priority string) *Options {
+	o.options["priority"] = priority
+	return o
+}
+
+// TeamResponder adds a team, by name, to notify.
+func (o *Options) TeamResponder(name string) *Options {
+	return o.addResponder(map[string]string{"type": "team", "name": name})
+}
+
+// UserResponder adds a user, by username (email), to notify.
+func (o *Options) UserResponder(username string) *Options {
+	return o.addResponder(map[string]string{"type": "user", "username": username})
+}
+
+// EscalationResponder adds an escalation, by name, to notify.
+func (o *Options) EscalationResponder(name string) *Options {
+	return o.addResponder(map[string]string{"type": "escalation", "name": name})
+}
+
+// ScheduleResponder adds the on-call users of a schedule, by name, to notify.
+func (o *Options) ScheduleResponder(name string) *Options {
+	return o.addResponder(map[string]string{"type": "schedule", "name": name})
+}
+
+func (o *Options) addResponder(responder map[string]string) *Options {
+	responders, _ := o.options["responders"].([]map[string]string)
+	o.options["responders"] = append(responders, responder)
+	return o
+}
+
+// Tag adds tags to the alert.
+func (o *Options) Tag(tags ...string) *Options {
+	existing, _ := o.options["tags"].([]string)
+	o.options["tags"] = append(existing, tags...)
+	return o
+}
+
+// Detail adds a custom property to the alert.
+func (o *Options) Detail(key, value string) *Options {
+	details, ok := o.options["details"].(map[string]string)
+	if !ok {
+		details = make(map[string]string)
+		o.options["details"] = details
+	}
+	details[key] = value
+	return o
+}
+
+// Action adds custom actions available on the alert.
+func (o *Options) Action(actions ...string) *Options {
+	existing, _ := o.options["actions"].([]string)
+	o.options["actions"] = append(existing, actions...)
+	return o
+}
+
+// Entity sets the entity the alert is related to (e.g., a service name).
+func (o *Options) Entity(entity string) *Options {
+	o.options["entity"] = entity
+	return o
+}
+
+// Source sets the source of the alert, defaulting to the IP address of the sender.
+func (o *Options) Source(source string) *Options {
+	o.options["source"] = source
+	return o
+}
+
+// Note adds a note to the alert.
+func (o *Options) Note(note string) *Options {
+	o.options["note"] = note
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"responders": []map[string]string(nil),
+	"tags":       []string(nil),
+	"details":    map[string]string(nil),
+	"actions":    []string(nil),
+}
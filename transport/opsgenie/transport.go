@@ -0,0 +1,178 @@
+package opsgenie
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Maximum lengths of alert fields.
+const (
+	maxMessageLength     = 130
+	maxDescriptionLength = 15000
+)
+
+// Regions maps region names to their API hosts.
+var Regions = map[string]string{
+	"us": "api.opsgenie.com",
+	"eu": "api.eu.opsgenie.com",
+}
+
+// DefaultPriorityMapping translates message importance into Opsgenie priorities.
+var DefaultPriorityMapping = map[string]string{
+	notifier.ImportanceUrgent: PriorityP1,
+	notifier.ImportanceHigh:   PriorityP2,
+	notifier.ImportanceMedium: PriorityP3,
+	notifier.ImportanceLow:    PriorityP4,
+}
+
+// Transport creates alerts via the Opsgenie Alert API.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey          string
+	region          string
+	priorityMapping map[string]string
+}
+
+// NewTransport creates a new Opsgenie transport with the API key of an API integration.
+func NewTransport(apiKey string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		region:            "us",
+		priorityMapping:   DefaultPriorityMapping,
+	}
+}
+
+// SetRegion sets the region ("us" or "eu") of the Opsgenie account.
+func (t *Transport) SetRegion(region string) *Transport {
+	t.region = region
+	return t
+}
+
+// SetPriorityMapping overrides the importance to priority mapping.
+func (t *Transport) SetPriorityMapping(mapping map[string]string) *Transport {
+	t.priorityMapping = mapping
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("opsgenie://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("opsgenie: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	alert := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("opsgenie").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			alert[k] = v
+		}
+	}
+	delete(alert, "recipient_id")
+
+	alert["message"] = truncate(chatMsg.GetSubject(), maxMessageLength)
+	if content := chatMsg.GetContent(); content != "" {
+		alert["description"] = truncate(content, maxDescriptionLength)
+	}
+	if _, ok := alert["priority"]; !ok {
+		if priority, ok := t.priorityMapping[chatMsg.GetImportance()]; ok {
+			alert["priority"] = priority
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(alert)
+	if err != nil {
+		return nil, fmt.Errorf("opsgenie: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v2/alerts", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("opsgenie: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "GenieKey "+t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("opsgenie: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusAccepted {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Message string `json:"message"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Message != "" {
+			errMessage = apiResp.Message
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "opsgenie",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("opsgenie: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		RequestID string `json:"requestId"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("opsgenie: decode response: %w", err)
+	}
+
+	// Alerts are created asynchronously, the request ID is used to query their status
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.RequestID)
+	if alias, ok := alert["alias"].(string); ok {
+		sentMessage.SetInfo("alias", alias)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		if host, ok := Regions[t.region]; ok {
+			return host
+		}
+		return Regions["us"]
+	}
+	return endpoint
+}
+
+// truncate shortens text to at most maxLength runes.
+func truncate(text string, maxLength int) string {
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+	return string(runes[:maxLength-1]) + "…"
+}
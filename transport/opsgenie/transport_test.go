@@ -0,0 +1,169 @@
+package opsgenie
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusAccepted, `{"result":"Request will be processed","took":0.302,"requestId":"43a29c5c-3dbf-4fa4-9c26-f4f71023e120"}`), nil
+	})
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("key", captureBody(&req, &body))
+	transport.SetRegion("eu")
+
+	msg := notifier.NewChatMessage("Disk full on /var").
+		Content("95% used").
+		Importance(notifier.ImportanceHigh).
+		WithOptions("opsgenie", NewOptions().Alias("disk-api-1").TeamResponder("ops").UserResponder("jane@example.com").Tag("disk", "prod").Detail("host", "api-1"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://api.eu.opsgenie.com/v2/alerts" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+	if req.Header.Get("Authorization") != "GenieKey key" {
+		t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+	}
+
+	data, _ := json.Marshal(body)
+	expected := `{"alias":"disk-api-1","description":"95% used","details":{"host":"api-1"},"message":"Disk full on /var","priority":"P2",` +
+		`"responders":[{"name":"ops","type":"team"},{"type":"user","username":"jane@example.com"}],"tags":["disk","prod"]}`
+	if string(data) != expected {
+		t.Errorf("Unexpected body:\n%s", data)
+	}
+	if sentMsg.GetMessageID() != "43a29c5c-3dbf-4fa4-9c26-f4f71023e120" || sentMsg.GetInfo("alias") != "disk-api-1" {
+		t.Errorf("Unexpected sent message: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportPriority(t *testing.T) {
+	tests := []struct {
+		importance string
+		options    *Options
+		priority   any
+	}{
+		{"", nil, nil},
+		{notifier.ImportanceUrgent, nil, PriorityP1},
+		{notifier.ImportanceLow, NewOptions().Priority(PriorityP5), PriorityP5},
+	}
+
+	for _, tt := range tests {
+		var req *http.Request
+		var body map[string]any
+		transport := NewTransport("key", captureBody(&req, &body))
+
+		msg := notifier.NewChatMessage("Alert").Importance(tt.importance)
+		if tt.options != nil {
+			msg.WithOptions("opsgenie", tt.options)
+		}
+		if _, err := transport.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if body["priority"] != tt.priority {
+			t.Errorf("Importance %q: expected priority %v, got %v", tt.importance, tt.priority, body["priority"])
+		}
+	}
+}
+
+func TestTransportTruncatesMessage(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("key", captureBody(&req, &body))
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage(strings.Repeat("ä", 200))); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if message := body["message"].(string); len([]rune(message)) != 130 || !strings.HasSuffix(message, "…") {
+		t.Errorf("Unexpected message: %s", message)
+	}
+}
+
+func TestTransportErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusUnprocessableEntity, `{"message":"Request body is not processable. Please check the errors.","errors":{"message":"Message can not be empty."},"took":0.001}`), nil
+	})
+
+	transport := NewTransport("key", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage(""))
+	if err == nil || err.Error() != "opsgenie: API error (status 422): Request body is not processable. Please check the errors." {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, `{"message":"You are making too many requests!"}`), nil
+	})
+
+	transport = NewTransport("key", client)
+	_, err = transport.Send(context.Background(), notifier.NewChatMessage("Alert"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("opsgenie://key@default?region=eu")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support opsgenie DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "opsgenie://api.eu.opsgenie.com" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	for _, invalid := range []string{"opsgenie://default", "opsgenie://key@default?region=asia"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package pagerduty
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("pagerduty", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates PagerDuty transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new PagerDuty transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a PagerDuty transport from a DSN.
+// DSN format: pagerduty://<routing_key>@default[?source=<source>]
+// Example: pagerduty://R0ABCDEFGHIJKLMNOPQRSTUVWXYZ012@default?source=api-1
+//
+// The source defaults to the hostname.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "pagerduty" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	routingKey := dsn.GetUser()
+	if routingKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing routing key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	source := dsn.GetOption("source")
+	if source == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("missing required option: source")
+		}
+		source = hostname
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+
+	transport := NewTransport(routingKey, source, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"pagerduty"}
+}
@@ -0,0 +1,151 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event actions.
+const (
+	ActionTrigger     = "trigger"
+	ActionAcknowledge = "acknowledge"
+	ActionResolve     = "resolve"
+)
+
+// Severities of triggered alerts.
+const (
+	SeverityCritical = "critical"
+	SeverityError    = "error"
+	SeverityWarning  = "warning"
+	SeverityInfo     = "info"
+)
+
+// Options implements MessageOptionsInterface for PagerDuty.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the routing key of the integration, overriding the transport's.
+func (o *Options) Recipient(routingKey string) *Options {
+	o.options["recipient_id"] = routingKey
+	return o
+}
+
+// Action sets the event action (ActionTrigger, ActionAcknowledge or ActionResolve).
+func (o *Options) Action(action string) *Options {
+	o.options["event_action"] = action
+	return o
+}
+
+// Acknowledge acknowledges the alert with the dedup key.
+func (o *Options) Acknowledge(dedupKey string) *Options {
+	return o.Action(ActionAcknowledge).DedupKey(dedupKey)
+}
+
+// Resolve resolves the alert with the dedup key.
+func (o *Options) Resolve(dedupKey string) *Options {
+	return o.Action(ActionResolve).DedupKey(dedupKey)
+}
+
+// DedupKey sets the key identifying the alert. Triggers with the same key are grouped into
+// one alert; PagerDuty generates a key if unset.
+func (o *Options) DedupKey(key string) *Options {
+	o.options["dedup_key"] = key
+	return o
+}
+
+// Severity sets the severity, overriding the one derived from the message importance.
+func (o *Options) Severity(severity string) *Options {
+	o.options["severity"] = severity
+	return o
+}
+
+// Source sets the affected system (e.g., a hostname), overriding the transport's.
+func (o *Options) Source(source string) *Options {
+	o.options["source"] = source
+	return o
+}
+
+// Component sets the affected part of the source (e.g., "database").
+func (o *Options) Component(component string) *Options {
+	o.options["component"] = component
+	return o
+}
+
+// Group sets the logical grouping of components (e.g., "prod-datapipe").
+func (o *Options) Group(group string) *Options {
+	o.options["group"] = group
+	return o
+}
+
+// Class sets the class or type of the event (e.g., "ping failure").
+func (o *Options) Class(class string) *Options {
+	o.options["class"] = class
+	return o
+}
+
+// Timestamp sets when the event was detected.
+func (o *Options) Timestamp(timestamp time.Time) *Options {
+	o.options["timestamp"] = timestamp.UTC().Format(time.RFC3339)
+	return o
+}
+
+// CustomDetail adds a custom detail shown in the alert.
+func (o *Options) CustomDetail(key string, value any) *Options {
+	details, ok := o.options["custom_details"].(map[string]any)
+	if !ok {
+		details = make(map[string]any)
+		o.options["custom_details"] = details
+	}
+	details[key] = value
+	return o
+}
+
+// Link adds a link shown in the alert.
+func (o *Options) Link(href, text string) *Options {
+	links, _ := o.options["links"].([]map[string]string)
+	o.options["links"] = append(links, map[string]string{"href": href, "text": text})
+	return o
+}
+
+// Image adds an image shown in the alert, optionally linking to href.
+func (o *Options) Image(src, href, alt string) *Options {
+	image := map[string]string{"src": src}
+	if href != "" {
+		image["href"] = href
+	}
+	if alt != "" {
+		image["alt"] = alt
+	}
+	images, _ := o.options["images"].([]map[string]string)
+	o.options["images"] = append(images, image)
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"links":  []map[string]string(nil),
+	"images": []map[string]string(nil),
+}
@@ -0,0 +1,227 @@
+package pagerduty
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// maxSummaryLength is the maximum length of alert summaries.
+const maxSummaryLength = 1024
+
+// DefaultSeverityMapping translates message importance into PagerDuty severities.
+var DefaultSeverityMapping = map[string]string{
+	notifier.ImportanceUrgent: SeverityCritical,
+	notifier.ImportanceHigh:   SeverityError,
+	notifier.ImportanceMedium: SeverityWarning,
+	notifier.ImportanceLow:    SeverityInfo,
+}
+
+// DefaultSeverity is the severity of messages without importance.
+const DefaultSeverity = SeverityError
+
+// Transport sends ChatMessages as alerts via the PagerDuty Events API v2.
+type Transport struct {
+	*notifier.AbstractTransport
+	routingKey      string
+	source          string
+	severityMapping map[string]string
+}
+
+// NewTransport creates a new PagerDuty transport. The routing key is the integration key of
+// an Events API v2 integration; source names the affected system of triggered alerts.
+func NewTransport(routingKey, source string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		routingKey:        routingKey,
+		source:            source,
+		severityMapping:   DefaultSeverityMapping,
+	}
+}
+
+// SetSeverityMapping overrides the importance to severity mapping.
+func (t *Transport) SetSeverityMapping(mapping map[string]string) *Transport {
+	t.severityMapping = mapping
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("pagerduty://%s?source=%s", t.getEndpoint(), t.source)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("pagerduty: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("pagerduty").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	routingKey := chatMsg.GetRecipientId()
+	if routingKey == "" {
+		routingKey = t.routingKey
+	}
+
+	action, _ := options["event_action"].(string)
+	if action == "" {
+		action = ActionTrigger
+	}
+	dedupKey, _ := options["dedup_key"].(string)
+
+	event := map[string]any{
+		"routing_key":  routingKey,
+		"event_action": action,
+	}
+	if dedupKey != "" {
+		event["dedup_key"] = dedupKey
+	}
+
+	switch action {
+	case ActionTrigger:
+		event["payload"] = t.payload(chatMsg, options)
+		for _, key := range []string{"links", "images"} {
+			if value, ok := options[key]; ok {
+				event[key] = value
+			}
+		}
+	case ActionAcknowledge, ActionResolve:
+		if dedupKey == "" {
+			return nil, fmt.Errorf("pagerduty: missing dedup key to %s", action)
+		}
+	default:
+		return nil, fmt.Errorf("pagerduty: unknown event action %q", action)
+	}
+
+	jsonBody, err := notifier.JSONMarshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("pagerduty: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v2/enqueue", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("pagerduty: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("pagerduty: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusAccepted {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Message string   `json:"message"`
+			Errors  []string `json:"errors"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Message != "" {
+			errMessage = apiResp.Message
+			if len(apiResp.Errors) > 0 {
+				errMessage += ": " + strings.Join(apiResp.Errors, "; ")
+			}
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "pagerduty",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("pagerduty: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		DedupKey string `json:"dedup_key"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("pagerduty: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	// The dedup key is needed to acknowledge or resolve the alert later
+	sentMessage.SetMessageID(result.DedupKey)
+	sentMessage.SetInfo("dedup_key", result.DedupKey)
+	sentMessage.SetInfo("event_action", action)
+
+	return sentMessage, nil
+}
+
+// payload builds the alert payload of a trigger event.
+func (t *Transport) payload(chatMsg *notifier.ChatMessage, options map[string]any) map[string]any {
+	summary := chatMsg.GetSubject()
+	if len(summary) > maxSummaryLength {
+		summary = summary[:maxSummaryLength-3] + "..."
+	}
+
+	severity, _ := options["severity"].(string)
+	if severity == "" {
+		severity = t.severityMapping[chatMsg.GetImportance()]
+	}
+	if severity == "" {
+		severity = DefaultSeverity
+	}
+
+	source, _ := options["source"].(string)
+	if source == "" {
+		source = t.source
+	}
+
+	payload := map[string]any{
+		"summary":  summary,
+		"source":   source,
+		"severity": severity,
+	}
+	for _, key := range []string{"component", "group", "class", "timestamp"} {
+		if value, ok := options[key]; ok {
+			payload[key] = value
+		}
+	}
+
+	details := make(map[string]any)
+	if custom, ok := options["custom_details"].(map[string]any); ok {
+		for k, v := range custom {
+			details[k] = v
+		}
+	}
+	if content := chatMsg.GetContent(); content != "" {
+		details["content"] = content
+	}
+	if len(details) > 0 {
+		payload["custom_details"] = details
+	}
+
+	return payload
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "events.pagerduty.com"
+	}
+	return endpoint
+}
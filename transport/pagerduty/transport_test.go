@@ -0,0 +1,181 @@
+package pagerduty
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusAccepted, `{"status":"success","message":"Event processed","dedup_key":"srv01/HTTP"}`), nil
+	})
+}
+
+func TestTransportTrigger(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("routing-key", "api-1", captureBody(&req, &body))
+
+	msg := notifier.NewChatMessage("Disk full on /var").
+		Content("95% used").
+		Importance(notifier.ImportanceUrgent).
+		WithOptions("pagerduty", NewOptions().Component("disk").CustomDetail("used", 95).Link("https://example.com/runbook", "Runbook"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://events.pagerduty.com/v2/enqueue" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+
+	data, _ := json.Marshal(body)
+	expected := `{"event_action":"trigger","links":[{"href":"https://example.com/runbook","text":"Runbook"}],` +
+		`"payload":{"component":"disk","custom_details":{"content":"95% used","used":95},"severity":"critical","source":"api-1","summary":"Disk full on /var"},` +
+		`"routing_key":"routing-key"}`
+	if string(data) != expected {
+		t.Errorf("Unexpected body:\n%s", data)
+	}
+	if sentMsg.GetMessageID() != "srv01/HTTP" || sentMsg.GetInfo("dedup_key") != "srv01/HTTP" {
+		t.Errorf("Unexpected sent message: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportSeverity(t *testing.T) {
+	tests := []struct {
+		importance string
+		options    *Options
+		severity   string
+	}{
+		{"", nil, SeverityError},
+		{notifier.ImportanceLow, nil, SeverityInfo},
+		{notifier.ImportanceMedium, NewOptions().Severity(SeverityCritical), SeverityCritical},
+	}
+
+	for _, tt := range tests {
+		var req *http.Request
+		var body map[string]any
+		transport := NewTransport("routing-key", "api-1", captureBody(&req, &body))
+
+		msg := notifier.NewChatMessage("Alert").Importance(tt.importance)
+		if tt.options != nil {
+			msg.WithOptions("pagerduty", tt.options)
+		}
+		if _, err := transport.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if severity := body["payload"].(map[string]any)["severity"]; severity != tt.severity {
+			t.Errorf("Importance %q: expected severity %s, got %v", tt.importance, tt.severity, severity)
+		}
+	}
+}
+
+func TestTransportResolve(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("routing-key", "api-1", captureBody(&req, &body))
+
+	msg := notifier.NewChatMessage("Disk full on /var").WithOptions("pagerduty", NewOptions().Resolve("srv01/HTTP"))
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if body["event_action"] != "resolve" || body["dedup_key"] != "srv01/HTTP" {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if _, ok := body["payload"]; ok {
+		t.Errorf("Expected no payload: %v", body)
+	}
+
+	msg = notifier.NewChatMessage("Ack").WithOptions("pagerduty", NewOptions().Action(ActionAcknowledge))
+	if _, err := transport.Send(context.Background(), msg); err == nil || !strings.Contains(err.Error(), "missing dedup key") {
+		t.Errorf("Expected missing dedup key error, got: %v", err)
+	}
+}
+
+func TestTransportErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusBadRequest, `{"status":"invalid event","message":"Event object is invalid","errors":["Length of 'routing_key' is incorrect (should be 32 characters)"]}`), nil
+	})
+
+	transport := NewTransport("short", "api-1", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Alert"))
+	if err == nil || err.Error() != "pagerduty: API error (status 400): Event object is invalid: Length of 'routing_key' is incorrect (should be 32 characters)" {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, `{"status":"throttle event","message":"Requests for this service are arriving too quickly."}`), nil
+	})
+
+	transport = NewTransport("routing-key", "api-1", client)
+	_, err = transport.Send(context.Background(), notifier.NewChatMessage("Alert"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("pagerduty://routing-key@default?source=api-1")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support pagerduty DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "pagerduty://events.pagerduty.com?source=api-1" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	dsn, _ = notifier.NewDSN("pagerduty://routing-key@default")
+	transport, err = factory.Create(dsn)
+	if hostname, _ := os.Hostname(); err != nil || transport.(*Transport).source != hostname {
+		t.Errorf("Expected hostname as source, got: %v", err)
+	}
+
+	dsn, _ = notifier.NewDSN("pagerduty://default")
+	if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+		t.Errorf("Expected incomplete DSN error, got: %v", err)
+	}
+}
@@ -0,0 +1,84 @@
+package postmark
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("postmark", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Postmark transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Postmark transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Postmark transport from a DSN.
+// DSN format: postmark://<server_token>@default?from=<address>[&message_stream=<stream>]
+// Example: postmark://abc123-def456@default?from=alerts@example.com&message_stream=outbound
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "postmark" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	serverToken := dsn.GetUser()
+	if serverToken == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing server token. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	from, err := dsn.GetRequiredOption("from")
+	if err != nil {
+		return nil, err
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+
+	transport := NewTransport(serverToken, from, f.client)
+	transport.SetMessageStream(dsn.GetOption("message_stream"))
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"postmark"}
+}
@@ -0,0 +1,106 @@
+package postmark
+
+import (
+	"encoding/json"
+)
+
+// Link tracking modes.
+const (
+	TrackLinksNone        = "None"
+	TrackLinksHTMLAndText = "HtmlAndText"
+	TrackLinksHTMLOnly    = "HtmlOnly"
+	TrackLinksTextOnly    = "TextOnly"
+)
+
+// Options implements MessageOptionsInterface for Postmark.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// MessageStream sets the message stream (e.g., "outbound" or "broadcast"), overriding the
+// message_stream DSN option.
+func (o *Options) MessageStream(stream string) *Options {
+	o.options["MessageStream"] = stream
+	return o
+}
+
+// Tag sets the tag used to group statistics.
+func (o *Options) Tag(tag string) *Options {
+	o.options["Tag"] = tag
+	return o
+}
+
+// TrackOpens enables or disables open tracking.
+func (o *Options) TrackOpens(enabled bool) *Options {
+	o.options["TrackOpens"] = enabled
+	return o
+}
+
+// TrackLinks sets the link tracking mode (e.g., TrackLinksHTMLAndText).
+func (o *Options) TrackLinks(mode string) *Options {
+	o.options["TrackLinks"] = mode
+	return o
+}
+
+// Metadata adds a key-value pair returned in webhooks.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["Metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["Metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// TemplateID sends the email with the template with the ID. The subject and bodies come
+// from the template.
+func (o *Options) TemplateID(id int) *Options {
+	o.options["TemplateId"] = id
+	return o
+}
+
+// TemplateAlias sends the email with the template with the alias.
+func (o *Options) TemplateAlias(alias string) *Options {
+	o.options["TemplateAlias"] = alias
+	return o
+}
+
+// TemplateModel sets a value of the template model.
+func (o *Options) TemplateModel(key string, value any) *Options {
+	model, ok := o.options["TemplateModel"].(map[string]any)
+	if !ok {
+		model = make(map[string]any)
+		o.options["TemplateModel"] = model
+	}
+	model[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"Metadata":   map[string]string(nil),
+	"TemplateId": 0,
+}
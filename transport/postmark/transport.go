@@ -0,0 +1,207 @@
+package postmark
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends emails via the Postmark API.
+type Transport struct {
+	*notifier.AbstractTransport
+	serverToken   string
+	from          string
+	messageStream string
+}
+
+// NewTransport creates a new Postmark transport. from is the default sender address.
+func NewTransport(serverToken, from string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		serverToken:       serverToken,
+		from:              from,
+	}
+}
+
+// SetMessageStream sets the default message stream. Postmark uses "outbound" if unset.
+func (t *Transport) SetMessageStream(stream string) *Transport {
+	t.messageStream = stream
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.messageStream != "" {
+		return fmt.Sprintf("postmark://%s?message_stream=%s", t.getEndpoint(), t.messageStream)
+	}
+	return fmt.Sprintf("postmark://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.EmailMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	emailMsg, ok := message.(*notifier.EmailMessage)
+	if !ok {
+		return nil, fmt.Errorf("postmark: unsupported message type %T, expected EmailMessage", message)
+	}
+
+	payload := make(map[string]any)
+	if opts, ok := emailMsg.GetOptions("postmark").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			payload[k] = v
+		}
+	}
+	delete(payload, "recipient_id")
+
+	if len(emailMsg.GetTo()) == 0 {
+		return nil, fmt.Errorf("postmark: missing recipient")
+	}
+
+	from := emailMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+
+	payload["From"] = from
+	payload["To"] = strings.Join(emailMsg.GetTo(), ",")
+	if cc := emailMsg.GetCc(); len(cc) > 0 {
+		payload["Cc"] = strings.Join(cc, ",")
+	}
+	if bcc := emailMsg.GetBcc(); len(bcc) > 0 {
+		payload["Bcc"] = strings.Join(bcc, ",")
+	}
+	if replyTo := emailMsg.GetReplyTo(); replyTo != "" {
+		payload["ReplyTo"] = replyTo
+	}
+
+	// Templates provide the subject and bodies
+	_, hasTemplateID := payload["TemplateId"]
+	_, hasTemplateAlias := payload["TemplateAlias"]
+	path := "/email"
+	if hasTemplateID || hasTemplateAlias {
+		path = "/email/withTemplate"
+		if _, ok := payload["TemplateModel"]; !ok {
+			payload["TemplateModel"] = map[string]any{}
+		}
+	} else {
+		payload["Subject"] = emailMsg.GetSubject()
+		if text := emailMsg.GetText(); text != "" {
+			payload["TextBody"] = text
+		}
+		if html := emailMsg.GetHTML(); html != "" {
+			payload["HtmlBody"] = html
+		}
+	}
+
+	if _, ok := payload["MessageStream"]; !ok && t.messageStream != "" {
+		payload["MessageStream"] = t.messageStream
+	}
+
+	if headers := emailMsg.GetHeaders(); len(headers) > 0 {
+		names := make([]string, 0, len(headers))
+		for name := range headers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		list := make([]map[string]string, 0, len(names))
+		for _, name := range names {
+			list = append(list, map[string]string{"Name": name, "Value": headers[name]})
+		}
+		payload["Headers"] = list
+	}
+	if attachments := emailMsg.GetAttachments(); len(attachments) > 0 {
+		list := make([]map[string]string, 0, len(attachments))
+		for _, attachment := range attachments {
+			contentType := attachment.ContentType
+			if contentType == "" {
+				contentType = "application/octet-stream"
+			}
+			list = append(list, map[string]string{
+				"Name":        attachment.Filename,
+				"Content":     base64.StdEncoding.EncodeToString(attachment.Data),
+				"ContentType": contentType,
+			})
+		}
+		payload["Attachments"] = list
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("postmark: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", t.getEndpoint(), path)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("postmark: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("X-Postmark-Server-Token", t.serverToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("postmark: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			ErrorCode int    `json:"ErrorCode"`
+			Message   string `json:"Message"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Message != "" {
+			errMessage = fmt.Sprintf("%d: %s", apiResp.ErrorCode, apiResp.Message)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "postmark",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("postmark: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		MessageID   string `json:"MessageID"`
+		SubmittedAt string `json:"SubmittedAt"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("postmark: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.MessageID)
+	sentMessage.SetInfo("submitted_at", result.SubmittedAt)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.postmarkapp.com"
+	}
+	return endpoint
+}
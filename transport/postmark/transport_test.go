@@ -0,0 +1,158 @@
+package postmark
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusOK, `{"To":"jane@example.com","SubmittedAt":"2024-01-02T10:00:00.0000000-05:00","MessageID":"b7bc2f4a-e38e-4336-af7d-e6c392c2f817","ErrorCode":0,"Message":"OK"}`), nil
+	})
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("token", "alerts@example.com", captureBody(&req, &body))
+	transport.SetMessageStream("outbound")
+
+	msg := notifier.NewEmailMessage("Deploy finished").
+		To("jane@example.com", "john@example.com").
+		Text("Version 1.2.3 is live").
+		Header("X-Deploy", "42").
+		Attach("report.txt", "text/plain", []byte("ok")).
+		WithOptions("postmark", NewOptions().Tag("deploys").TrackLinks(TrackLinksHTMLOnly).Metadata("deploy_id", "42"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://api.postmarkapp.com/email" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+	if req.Header.Get("X-Postmark-Server-Token") != "token" {
+		t.Errorf("Unexpected token header: %s", req.Header.Get("X-Postmark-Server-Token"))
+	}
+
+	data, _ := json.Marshal(body)
+	expected := `{"Attachments":[{"Content":"b2s=","ContentType":"text/plain","Name":"report.txt"}],"From":"alerts@example.com",` +
+		`"Headers":[{"Name":"X-Deploy","Value":"42"}],"MessageStream":"outbound","Metadata":{"deploy_id":"42"},"Subject":"Deploy finished",` +
+		`"Tag":"deploys","TextBody":"Version 1.2.3 is live","To":"jane@example.com,john@example.com","TrackLinks":"HtmlOnly"}`
+	if string(data) != expected {
+		t.Errorf("Unexpected body:\n%s", data)
+	}
+	if sentMsg.GetMessageID() != "b7bc2f4a-e38e-4336-af7d-e6c392c2f817" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportSendTemplate(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("token", "alerts@example.com", captureBody(&req, &body))
+
+	msg := notifier.NewEmailMessage("ignored").
+		To("jane@example.com").
+		WithOptions("postmark", NewOptions().TemplateAlias("welcome").TemplateModel("name", "Jane").MessageStream("broadcast"))
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.Path != "/email/withTemplate" {
+		t.Errorf("Unexpected path: %s", req.URL.Path)
+	}
+	if body["TemplateAlias"] != "welcome" || body["TemplateModel"].(map[string]any)["name"] != "Jane" || body["MessageStream"] != "broadcast" {
+		t.Errorf("Unexpected body: %v", body)
+	}
+	if _, ok := body["Subject"]; ok {
+		t.Errorf("Expected no subject with template: %v", body)
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusUnprocessableEntity, `{"ErrorCode":300,"Message":"Invalid 'From' address: 'invalid'."}`), nil
+	})
+
+	transport := NewTransport("token", "invalid", client)
+	_, err := transport.Send(context.Background(), notifier.NewEmailMessage("Hi").To("jane@example.com").Text("Hi"))
+	if err == nil || err.Error() != "postmark: API error (status 422): 300: Invalid 'From' address: 'invalid'." {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendRateLimit(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, `{"ErrorCode":429,"Message":"Rate limit exceeded"}`), nil
+	})
+
+	transport := NewTransport("token", "alerts@example.com", client)
+	_, err := transport.Send(context.Background(), notifier.NewEmailMessage("Hi").To("jane@example.com").Text("Hi"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("postmark://token@default?from=alerts@example.com&message_stream=outbound")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support postmark DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	postmarkTransport := transport.(*Transport)
+	if postmarkTransport.serverToken != "token" || postmarkTransport.from != "alerts@example.com" || postmarkTransport.messageStream != "outbound" {
+		t.Errorf("Unexpected transport: %+v", postmarkTransport)
+	}
+
+	for _, invalid := range []string{"postmark://default?from=alerts@example.com", "postmark://token@default"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+}
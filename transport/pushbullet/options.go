@@ -0,0 +1,66 @@
+package pushbullet
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Pushbullet.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Device sends the push to the device with the identifier, overriding the transport's.
+func (o *Options) Device(iden string) *Options {
+	o.options["device_iden"] = iden
+	return o
+}
+
+// Email sends the push to the Pushbullet user with the email, or by email to non-users.
+func (o *Options) Email(email string) *Options {
+	o.options["email"] = email
+	return o
+}
+
+// Channel sends the push to the subscribers of the channel with the tag.
+func (o *Options) Channel(tag string) *Options {
+	o.options["channel_tag"] = tag
+	return o
+}
+
+// Link sends a link push opening the URL.
+func (o *Options) Link(url string) *Options {
+	o.options["type"] = "link"
+	o.options["url"] = url
+	return o
+}
+
+// File sends a file push for a file uploaded to Pushbullet (see the upload-request API).
+func (o *Options) File(fileURL, fileName, fileType string) *Options {
+	o.options["type"] = "file"
+	o.options["file_url"] = fileURL
+	o.options["file_name"] = fileName
+	o.options["file_type"] = fileType
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
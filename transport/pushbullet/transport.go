@@ -0,0 +1,155 @@
+package pushbullet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends note, link and file pushes via the Pushbullet API.
+type Transport struct {
+	*notifier.AbstractTransport
+	accessToken string
+	device      string
+}
+
+// NewTransport creates a new Pushbullet transport. Pushes go to all devices of the account,
+// unless a device identifier is given.
+func NewTransport(accessToken, device string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		accessToken:       accessToken,
+		device:            device,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.device != "" {
+		return fmt.Sprintf("pushbullet://%s?device=%s", t.getEndpoint(), t.device)
+	}
+	return fmt.Sprintf("pushbullet://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("pushbullet: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	push := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("pushbullet").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			push[k] = v
+		}
+	}
+	delete(push, "recipient_id")
+
+	if _, ok := push["type"]; !ok {
+		push["type"] = "note"
+	}
+	if content := chatMsg.GetContent(); content != "" {
+		push["title"] = chatMsg.GetSubject()
+		push["body"] = content
+	} else {
+		push["body"] = chatMsg.GetSubject()
+	}
+
+	// Pushes have a single target
+	_, hasEmail := push["email"]
+	_, hasChannel := push["channel_tag"]
+	if _, ok := push["device_iden"]; !ok && !hasEmail && !hasChannel && t.device != "" {
+		push["device_iden"] = t.device
+	}
+
+	jsonBody, err := notifier.JSONMarshal(push)
+	if err != nil {
+		return nil, fmt.Errorf("pushbullet: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v2/pushes", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("pushbullet: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Access-Token", t.accessToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("pushbullet: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Error struct {
+				Message string `json:"message"`
+			} `json:"error"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Error.Message != "" {
+			errMessage = apiResp.Error.Message
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "pushbullet",
+				RetryAfter: retryAfter(resp.Header.Get("X-Ratelimit-Reset")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("pushbullet: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		Iden string `json:"iden"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("pushbullet: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Iden)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.pushbullet.com"
+	}
+	return endpoint
+}
+
+// retryAfter returns the time until the rate limit resets at the given unix time.
+func retryAfter(reset string) time.Duration {
+	seconds, err := strconv.ParseInt(reset, 10, 64)
+	if err != nil {
+		return 0
+	}
+	if wait := time.Until(time.Unix(seconds, 0)); wait > 0 {
+		return wait
+	}
+	return 0
+}
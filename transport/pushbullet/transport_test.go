@@ -0,0 +1,154 @@
+package pushbullet
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		*body = nil
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusOK, `{"active":true,"iden":"ujpah72o0sjAoRtnM0jc","type":"note"}`), nil
+	})
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("token", "device-1", captureBody(&req, &body))
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Deploy finished").Content("Version 1.2.3 is live"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://api.pushbullet.com/v2/pushes" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+	if req.Header.Get("Access-Token") != "token" {
+		t.Errorf("Unexpected Access-Token header: %s", req.Header.Get("Access-Token"))
+	}
+
+	data, _ := json.Marshal(body)
+	if string(data) != `{"body":"Version 1.2.3 is live","device_iden":"device-1","title":"Deploy finished","type":"note"}` {
+		t.Errorf("Unexpected body: %s", data)
+	}
+	if sentMsg.GetMessageID() != "ujpah72o0sjAoRtnM0jc" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportPushTypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		options  *Options
+		expected string
+	}{
+		{"link", NewOptions().Link("https://example.com/deploys/1"), `{"body":"Deploy finished","device_iden":"device-1","type":"link","url":"https://example.com/deploys/1"}`},
+		{"file", NewOptions().File("https://dl.pushbulletusercontent.com/abc/report.pdf", "report.pdf", "application/pdf"), `{"body":"Deploy finished","device_iden":"device-1","file_name":"report.pdf","file_type":"application/pdf","file_url":"https://dl.pushbulletusercontent.com/abc/report.pdf","type":"file"}`},
+		{"channel", NewOptions().Channel("deploys"), `{"body":"Deploy finished","channel_tag":"deploys","type":"note"}`},
+		{"email", NewOptions().Email("jane@example.com"), `{"body":"Deploy finished","email":"jane@example.com","type":"note"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var body map[string]any
+			transport := NewTransport("token", "device-1", captureBody(&req, &body))
+
+			msg := notifier.NewChatMessage("Deploy finished").WithOptions("pushbullet", tt.options)
+			if _, err := transport.Send(context.Background(), msg); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			data, _ := json.Marshal(body)
+			if string(data) != tt.expected {
+				t.Errorf("Unexpected body: %s", data)
+			}
+		})
+	}
+}
+
+func TestTransportErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusUnauthorized, `{"error":{"code":"invalid_access_token","type":"invalid_request","message":"Access token is missing or invalid.","cat":"(=^‥^=)"}}`), nil
+	})
+
+	transport := NewTransport("wrong", "", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || err.Error() != "pushbullet: API error (status 401): Access token is missing or invalid." {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	reset := time.Now().Add(time.Minute).Unix()
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(http.StatusTooManyRequests, `{"error":{"message":"Too many requests"}}`)
+		resp.Header.Set("X-Ratelimit-Reset", strconv.FormatInt(reset, 10))
+		return resp, nil
+	})
+
+	transport = NewTransport("token", "", client)
+	_, err = transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter <= 0 || rateLimitErr.RetryAfter > time.Minute {
+		t.Errorf("Expected RateLimitError until reset, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("pushbullet://token@default?device=device-1")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support pushbullet DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "pushbullet://api.pushbullet.com?device=device-1" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	dsn, _ = notifier.NewDSN("pushbullet://default")
+	if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+		t.Errorf("Expected incomplete DSN error, got: %v", err)
+	}
+}
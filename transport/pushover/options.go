@@ -0,0 +1,136 @@
+package pushover
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Pushover message priorities.
+const (
+	PriorityLowest    = -2
+	PriorityLow       = -1
+	PriorityNormal    = 0
+	PriorityHigh      = 1
+	PriorityEmergency = 2
+)
+
+// Options implements MessageOptionsInterface for Pushover.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the user or group key, overriding the DSN user.
+func (o *Options) Recipient(id string) *Options {
+	o.options["recipient_id"] = id
+	return o
+}
+
+// Title sets the message title.
+func (o *Options) Title(title string) *Options {
+	o.options["title"] = title
+	return o
+}
+
+// Priority sets the message priority (-2 to 2).
+func (o *Options) Priority(priority int) *Options {
+	if priority < PriorityLowest {
+		priority = PriorityLowest
+	}
+	if priority > PriorityEmergency {
+		priority = PriorityEmergency
+	}
+	o.options["priority"] = priority
+	return o
+}
+
+// Emergency sets emergency priority, which repeats the notification every retry
+// interval until it is acknowledged or expire has elapsed.
+// Pushover requires retry >= 30s and expire <= 3h.
+func (o *Options) Emergency(retry, expire time.Duration) *Options {
+	o.options["priority"] = PriorityEmergency
+	o.options["retry"] = int(retry.Seconds())
+	o.options["expire"] = int(expire.Seconds())
+	return o
+}
+
+// Callback sets the URL called when an emergency notification is acknowledged.
+func (o *Options) Callback(url string) *Options {
+	o.options["callback"] = url
+	return o
+}
+
+// Sound sets the notification sound (e.g., "pushover", "siren", "none").
+func (o *Options) Sound(sound string) *Options {
+	o.options["sound"] = sound
+	return o
+}
+
+// URL sets a supplementary URL shown with the message.
+func (o *Options) URL(url string, title ...string) *Options {
+	o.options["url"] = url
+	if len(title) > 0 {
+		o.options["url_title"] = title[0]
+	}
+	return o
+}
+
+// Device limits delivery to the given device names (comma separated).
+func (o *Options) Device(device string) *Options {
+	o.options["device"] = device
+	return o
+}
+
+// HTML enables HTML formatting of the message.
+func (o *Options) HTML(html bool) *Options {
+	o.options["html"] = html
+	return o
+}
+
+// Timestamp sets the displayed message time.
+func (o *Options) Timestamp(timestamp time.Time) *Options {
+	o.options["timestamp"] = timestamp.Unix()
+	return o
+}
+
+// TTL sets the time after which the message is deleted from devices.
+func (o *Options) TTL(ttl time.Duration) *Options {
+	o.options["ttl"] = int(ttl.Seconds())
+	return o
+}
+
+// Attachment attaches a local image file to the message.
+func (o *Options) Attachment(path string) *Options {
+	o.options["attachment"] = path
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"priority":  0,
+	"retry":     0,
+	"expire":    0,
+	"timestamp": int64(0),
+	"ttl":       0,
+}
@@ -0,0 +1,224 @@
+package pushover
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends messages via Pushover Messages API.
+type Transport struct {
+	*notifier.AbstractTransport
+	token   string
+	userKey string
+}
+
+// NewTransport creates a new Pushover transport.
+func NewTransport(token, userKey string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		userKey:           userKey,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("pushover://%s?user=%s", t.getEndpoint(), t.userKey)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("pushover: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("pushover").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	userKey := chatMsg.GetRecipientId()
+	if userKey == "" {
+		userKey = t.userKey
+	}
+	delete(options, "recipient_id")
+
+	if options["priority"] == PriorityEmergency {
+		if _, ok := options["retry"]; !ok {
+			return nil, fmt.Errorf("pushover: emergency priority requires retry and expire, use Options.Emergency")
+		}
+	}
+
+	attachment, _ := options["attachment"].(string)
+	delete(options, "attachment")
+
+	fields := url.Values{}
+	for k, v := range options {
+		if !isEmptyValue(v) {
+			fields.Set(k, formatValue(v))
+		}
+	}
+	fields.Set("token", t.token)
+	fields.Set("user", userKey)
+	fields.Set("message", chatMsg.GetSubject())
+	if content := chatMsg.GetContent(); content != "" {
+		// The subject becomes the title unless one is set
+		if fields.Get("title") == "" {
+			fields.Set("title", chatMsg.GetSubject())
+			fields.Set("message", content)
+		} else {
+			fields.Set("message", chatMsg.GetText())
+		}
+	}
+
+	var body io.Reader
+	var contentType string
+	if attachment != "" {
+		var err error
+		body, contentType, err = createMultipartBody(fields, attachment)
+		if err != nil {
+			return nil, fmt.Errorf("pushover: %w", err)
+		}
+	} else {
+		body = strings.NewReader(fields.Encode())
+		contentType = "application/x-www-form-urlencoded"
+	}
+
+	endpoint := fmt.Sprintf("https://%s/1/messages.json", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("pushover: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("pushover: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("pushover: read response: %w", err)
+	}
+
+	var result struct {
+		Status  int      `json:"status"`
+		Request string   `json:"request"`
+		Receipt string   `json:"receipt"`
+		Errors  []string `json:"errors"`
+	}
+
+	raw, err := t.DecodeResponse(bytes.NewReader(respBody), &result)
+	if err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("pushover: API error (status %d): %s", resp.StatusCode, string(respBody))
+		}
+		return nil, fmt.Errorf("pushover: decode response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || result.Status != 1 {
+		return nil, fmt.Errorf("pushover: API error (status %d): %s", resp.StatusCode, strings.Join(result.Errors, ", "))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Request)
+	if result.Receipt != "" {
+		sentMessage.SetInfo("receipt", result.Receipt)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.pushover.net"
+	}
+	return endpoint
+}
+
+func createMultipartBody(fields url.Values, attachment string) (io.Reader, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := writer.WriteField(k, fields.Get(k)); err != nil {
+			return nil, "", fmt.Errorf("write field %s: %w", k, err)
+		}
+	}
+
+	file, err := os.Open(attachment)
+	if err != nil {
+		return nil, "", fmt.Errorf("open attachment: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	part, err := writer.CreateFormFile("attachment", filepath.Base(attachment))
+	if err != nil {
+		return nil, "", fmt.Errorf("create form file: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, "", fmt.Errorf("copy attachment: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("close multipart writer: %w", err)
+	}
+
+	return &body, writer.FormDataContentType(), nil
+}
+
+func formatValue(v any) string {
+	switch val := v.(type) {
+	case bool:
+		if val {
+			return "1"
+		}
+		return "0"
+	default:
+		return fmt.Sprint(val)
+	}
+}
+
+func isEmptyValue(v any) bool {
+	switch val := v.(type) {
+	case string:
+		return val == ""
+	case []any:
+		return len(val) == 0
+	case map[string]any:
+		return len(val) == 0
+	case nil:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,284 @@
+package pushover
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSupports(t *testing.T) {
+	transport := NewTransport("token", "user", nil)
+
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should support ChatMessage")
+	}
+}
+
+func TestTransportString(t *testing.T) {
+	transport := NewTransport("token", "user", nil)
+
+	if transport.String() != "pushover://api.pushover.net?user=user" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+}
+
+func TestOptions(t *testing.T) {
+	opts := NewOptions().
+		Recipient("group").
+		Title("Title").
+		Sound("siren").
+		URL("https://example.com", "Example").
+		Device("phone").
+		HTML(true).
+		Priority(5)
+
+	m := opts.ToMap()
+	if m["recipient_id"] != "group" {
+		t.Error("Recipient not set correctly")
+	}
+	if m["url"] != "https://example.com" || m["url_title"] != "Example" {
+		t.Error("URL not set correctly")
+	}
+	if m["priority"] != PriorityEmergency {
+		t.Error("Priority should be clamped to emergency")
+	}
+
+	opts = NewOptions().Emergency(60*time.Second, time.Hour)
+	m = opts.ToMap()
+	if m["priority"] != PriorityEmergency || m["retry"] != 60 || m["expire"] != 3600 {
+		t.Errorf("Emergency not set correctly: %v", m)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("pushover://token@default?user=userkey")
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support pushover DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	pushoverTransport, ok := transport.(*Transport)
+	if !ok {
+		t.Fatal("Transport is not a Pushover transport")
+	}
+	if pushoverTransport.token != "token" || pushoverTransport.userKey != "userkey" {
+		t.Error("Token or user key not set correctly")
+	}
+}
+
+func TestFactoryMissingCredentials(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	for _, dsnString := range []string{
+		"pushover://default?user=userkey",
+		"pushover://token@default",
+	} {
+		dsn, _ := notifier.NewDSN(dsnString)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", dsnString)
+		}
+	}
+}
+
+func TestHTTPSuccess(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedForm url.Values
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		body, _ := io.ReadAll(req.Body)
+		capturedForm, _ = url.ParseQuery(string(body))
+		return newResponse(http.StatusOK, `{"status":1,"request":"req-1","receipt":"rcpt-1"}`), nil
+	})
+
+	transport := NewTransport("token", "userkey", client)
+	msg := notifier.NewChatMessage("Server down").
+		WithOptions("pushover", NewOptions().Title("Alert").HTML(true).Emergency(30*time.Second, time.Hour))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.URL.String() != "https://api.pushover.net/1/messages.json" {
+		t.Errorf("Unexpected URL: %s", capturedRequest.URL.String())
+	}
+	expected := map[string]string{
+		"token":    "token",
+		"user":     "userkey",
+		"message":  "Server down",
+		"title":    "Alert",
+		"html":     "1",
+		"priority": "2",
+		"retry":    "30",
+		"expire":   "3600",
+	}
+	for k, v := range expected {
+		if capturedForm.Get(k) != v {
+			t.Errorf("Expected %s=%s, got '%s'", k, v, capturedForm.Get(k))
+		}
+	}
+
+	if sentMsg.GetMessageID() != "req-1" {
+		t.Errorf("Expected message ID 'req-1', got '%s'", sentMsg.GetMessageID())
+	}
+	if sentMsg.GetInfo("receipt") != "rcpt-1" {
+		t.Errorf("Expected receipt 'rcpt-1', got '%v'", sentMsg.GetInfo("receipt"))
+	}
+}
+
+func TestHTTPRawResponse(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `{"status":1,"request":"req-1"}`), nil
+	})
+
+	transport := NewTransport("token", "userkey", client)
+	transport.SetCaptureRawResponse(true)
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Server down"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	raw, ok := sentMsg.GetInfo(notifier.RawResponseInfo).(map[string]any)
+	if !ok || raw["request"] != "req-1" || raw["status"] != float64(1) {
+		t.Errorf("Unexpected raw response: %v", sentMsg.GetInfo(notifier.RawResponseInfo))
+	}
+}
+
+func TestHTTPRecipientOverride(t *testing.T) {
+	var capturedForm url.Values
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		capturedForm, _ = url.ParseQuery(string(body))
+		return newResponse(http.StatusOK, `{"status":1,"request":"req-1"}`), nil
+	})
+
+	transport := NewTransport("token", "userkey", client)
+	msg := notifier.NewChatMessage("Hello").WithOptions("pushover", NewOptions().Recipient("groupkey"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedForm.Get("user") != "groupkey" {
+		t.Errorf("Expected user 'groupkey', got '%s'", capturedForm.Get("user"))
+	}
+	if capturedForm.Has("recipient_id") {
+		t.Error("recipient_id should not be sent")
+	}
+}
+
+func TestHTTPAttachment(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "image.png")
+	os.WriteFile(path, []byte("PNGDATA"), 0o600)
+
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Expected multipart body, got: %v", err)
+		}
+		if req.FormValue("message") != "Look" {
+			t.Errorf("Expected message 'Look', got '%s'", req.FormValue("message"))
+		}
+		file, header, err := req.FormFile("attachment")
+		if err != nil {
+			t.Fatalf("Expected attachment, got: %v", err)
+		}
+		content, _ := io.ReadAll(file)
+		if header.Filename != "image.png" || string(content) != "PNGDATA" {
+			t.Errorf("Unexpected attachment %s: %q", header.Filename, content)
+		}
+		return newResponse(http.StatusOK, `{"status":1,"request":"req-1"}`), nil
+	})
+
+	transport := NewTransport("token", "userkey", client)
+	msg := notifier.NewChatMessage("Look").WithOptions("pushover", NewOptions().Attachment(path))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
+
+func TestHTTPEmergencyRequiresRetry(t *testing.T) {
+	transport := NewTransport("token", "userkey", nil)
+	msg := notifier.NewChatMessage("Hello").WithOptions("pushover", NewOptions().Priority(PriorityEmergency))
+
+	_, err := transport.Send(context.Background(), msg)
+	if err == nil || !strings.Contains(err.Error(), "requires retry and expire") {
+		t.Errorf("Expected emergency validation error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIErrors(t *testing.T) {
+	tests := []struct {
+		name           string
+		statusCode     int
+		body           string
+		expectedErrMsg string
+	}{
+		{"invalid user", http.StatusBadRequest, `{"status":0,"errors":["user identifier is invalid"]}`, "API error (status 400): user identifier is invalid"},
+		{"server error", http.StatusInternalServerError, "oops", "API error (status 500): oops"},
+		{"invalid json", http.StatusOK, "not json", "decode response"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newMockClient(func(req *http.Request) (*http.Response, error) {
+				return newResponse(tt.statusCode, tt.body), nil
+			})
+
+			transport := NewTransport("token", "userkey", client)
+			_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+			if err == nil || !strings.Contains(err.Error(), tt.expectedErrMsg) {
+				t.Errorf("Expected error containing '%s', got: %v", tt.expectedErrMsg, err)
+			}
+		})
+	}
+}
+
+func TestHTTPNetworkError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	transport := NewTransport("token", "userkey", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "pushover: send request") {
+		t.Errorf("Expected send request error, got: %v", err)
+	}
+}
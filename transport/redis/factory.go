@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory())
+	notifier.RegisterOptionsDecoder("redis", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Redis transports from DSN.
+type TransportFactory struct{}
+
+// NewTransportFactory creates a new Redis transport factory.
+func NewTransportFactory() *TransportFactory {
+	return &TransportFactory{}
+}
+
+// Create creates a Redis transport from a DSN.
+// DSN format: redis://[[<user>]:<password>@]<host>[:<port>][/<db>]?channel=<channel>|stream=<stream>[&maxlen=<n>]
+// Example: redis://:secret@redis.local:6379/0?stream=notifications&maxlen=10000
+//
+// The rediss scheme connects with TLS.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	channel := dsn.GetOption("channel")
+	stream := dsn.GetOption("stream")
+	if (channel == "") == (stream == "") {
+		return nil, fmt.Errorf("invalid DSN: Exactly one of channel or stream is required. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	var transport *Transport
+	if stream != "" {
+		transport = NewStreamTransport(stream)
+	} else {
+		transport = NewTransport(channel)
+	}
+
+	if value := dsn.GetOption("maxlen"); value != "" {
+		maxLen, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || maxLen < 0 {
+			return nil, fmt.Errorf("invalid DSN: Invalid maxlen \"%s\". DSN: %s", value, dsn.GetOriginalDSN())
+		}
+		transport.SetMaxLen(maxLen)
+	}
+
+	if path := strings.TrimPrefix(dsn.GetPath(), "/"); path != "" {
+		db, err := strconv.Atoi(path)
+		if err != nil || db < 0 {
+			return nil, fmt.Errorf("invalid DSN: Invalid database \"%s\". DSN: %s", path, dsn.GetOriginalDSN())
+		}
+		transport.SetDB(db)
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = "localhost"
+	}
+
+	transport.SetCredentials(dsn.GetUser(), dsn.GetPassword())
+	transport.SetTLS(scheme == "rediss")
+	transport.SetInsecureSkipVerify(dsn.GetBooleanOption("insecure_skip_verify"))
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"redis", "rediss"}
+}
@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Redis.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Channel publishes the message to a pub/sub channel instead of the configured destination.
+func (o *Options) Channel(channel string) *Options {
+	o.options["channel"] = channel
+	return o
+}
+
+// Stream appends the message to a stream instead of the configured destination.
+func (o *Options) Stream(stream string) *Options {
+	o.options["stream"] = stream
+	return o
+}
+
+// MaxLen caps the stream to approximately maxLen entries.
+func (o *Options) MaxLen(maxLen int64) *Options {
+	o.options["max_len"] = maxLen
+	return o
+}
+
+// Field adds a field to the stream entry, next to the type and payload fields.
+func (o *Options) Field(key, value string) *Options {
+	fields, ok := o.options["fields"].(map[string]string)
+	if !ok {
+		fields = make(map[string]string)
+		o.options["fields"] = fields
+	}
+	fields[key] = value
+	return o
+}
+
+// Metadata adds a key-value pair to the metadata of the published payload.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"max_len":  int64(0),
+	"fields":   map[string]string(nil),
+	"metadata": map[string]string(nil),
+}
@@ -0,0 +1,210 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"maps"
+	"net"
+	"slices"
+	"strconv"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the Redis port.
+const DefaultPort = 6379
+
+// Transport publishes messages as JSON to a Redis pub/sub channel or appends them to a stream.
+// Each Send opens a short-lived connection.
+type Transport struct {
+	*notifier.AbstractTransport
+	channel            string
+	stream             string
+	maxLen             int64
+	username           string
+	password           string
+	db                 int
+	useTLS             bool
+	insecureSkipVerify bool
+}
+
+// NewTransport creates a new Redis transport publishing to the given pub/sub channel.
+func NewTransport(channel string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		channel:           channel,
+	}
+}
+
+// NewStreamTransport creates a new Redis transport appending to the given stream with XADD.
+func NewStreamTransport(stream string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		stream:            stream,
+	}
+}
+
+// SetCredentials sets the password and, for Redis 6 ACLs, the user name.
+func (t *Transport) SetCredentials(username, password string) *Transport {
+	t.username = username
+	t.password = password
+	return t
+}
+
+// SetDB selects the logical database.
+func (t *Transport) SetDB(db int) *Transport {
+	t.db = db
+	return t
+}
+
+// SetMaxLen caps the stream to approximately maxLen entries, zero disables trimming.
+func (t *Transport) SetMaxLen(maxLen int64) *Transport {
+	t.maxLen = maxLen
+	return t
+}
+
+// SetTLS enables TLS for the server connection.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed servers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	scheme := "redis"
+	if t.useTLS {
+		scheme = "rediss"
+	}
+	if t.stream != "" {
+		return fmt.Sprintf("%s://%s?stream=%s", scheme, t.getAddress(), t.stream)
+	}
+	return fmt.Sprintf("%s://%s?channel=%s", scheme, t.getAddress(), t.channel)
+}
+
+// Supports returns true for all message types, they are published as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("redis").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	channel, stream, maxLen := t.channel, t.stream, t.maxLen
+	if value, ok := options["channel"].(string); ok && value != "" {
+		channel, stream = value, ""
+	}
+	if value, ok := options["stream"].(string); ok && value != "" {
+		channel, stream = "", value
+	}
+	if value, ok := options["max_len"].(int64); ok {
+		maxLen = value
+	}
+	if channel == "" && stream == "" {
+		return nil, fmt.Errorf("redis: missing channel or stream")
+	}
+
+	payload := notifier.NewPayload(message)
+	if metadata, ok := options["metadata"].(map[string]string); ok {
+		payload.Metadata = metadata
+	}
+	body, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("redis: marshal payload: %w", err)
+	}
+
+	c, err := t.connect(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("redis: %w", err)
+	}
+	defer func() { _ = c.close() }()
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+
+	if stream == "" {
+		reply, err := c.do("PUBLISH", channel, string(body))
+		if err != nil {
+			return nil, fmt.Errorf("redis: PUBLISH: %w", err)
+		}
+		sentMessage.SetInfo("channel", channel)
+		// Messages published without subscribers are dropped by Redis
+		sentMessage.SetInfo("receivers", reply)
+		return sentMessage, nil
+	}
+
+	args := []string{"XADD", stream}
+	if maxLen > 0 {
+		args = append(args, "MAXLEN", "~", strconv.FormatInt(maxLen, 10))
+	}
+	args = append(args, "*", "type", payload.Type, "payload", string(body))
+	if fields, ok := options["fields"].(map[string]string); ok {
+		for _, key := range slices.Sorted(maps.Keys(fields)) {
+			args = append(args, key, fields[key])
+		}
+	}
+
+	reply, err := c.do(args...)
+	if err != nil {
+		return nil, fmt.Errorf("redis: XADD: %w", err)
+	}
+	id, _ := reply.(string)
+	sentMessage.SetMessageID(id)
+	sentMessage.SetInfo("stream", stream)
+
+	return sentMessage, nil
+}
+
+// connect dials the server, authenticates and selects the database.
+func (t *Transport) connect(ctx context.Context) (*conn, error) {
+	address := t.getAddress()
+
+	var netConn net.Conn
+	var err error
+	if t.useTLS {
+		host, _, _ := net.SplitHostPort(address)
+		dialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}}
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		var dialer net.Dialer
+		netConn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("connect: %w", err)
+	}
+
+	c := &conn{netConn: netConn, reader: bufio.NewReader(netConn), stop: notifier.BindConnContext(ctx, netConn)}
+	if t.password != "" {
+		args := []string{"AUTH", t.password}
+		if t.username != "" {
+			args = []string{"AUTH", t.username, t.password}
+		}
+		if _, err := c.do(args...); err != nil {
+			c.abort()
+			return nil, fmt.Errorf("AUTH: %w", err)
+		}
+	}
+	if t.db > 0 {
+		if _, err := c.do("SELECT", strconv.Itoa(t.db)); err != nil {
+			c.abort()
+			return nil, fmt.Errorf("SELECT: %w", err)
+		}
+	}
+	return c, nil
+}
+
+func (t *Transport) getAddress() string {
+	endpoint := t.GetEndpoint()
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		return net.JoinHostPort(endpoint, strconv.Itoa(DefaultPort))
+	}
+	return endpoint
+}
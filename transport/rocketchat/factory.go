@@ -0,0 +1,92 @@
+package rocketchat
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("rocketchat", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Rocket.Chat transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Rocket.Chat transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Rocket.Chat transport from a DSN.
+// DSN format (webhook): rocketchat://<webhook_id>/<webhook_token>@<host>[?channel=<channel>]
+// DSN format (REST API): rocketchat://<user_id>:<auth_token>@<host>?channel=<channel>
+// Example: rocketchat://abc123/XyZ789@chat.example.com?channel=%23alerts
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "rocketchat" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	user := dsn.GetUser()
+	if user == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing webhook token or user ID. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	// Rocket.Chat is self-hosted, so the server is required
+	host := dsn.GetHost()
+	if host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing server host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	channel := dsn.GetOption("channel")
+
+	var transport *Transport
+	if authToken := dsn.GetPassword(); authToken != "" {
+		if channel == "" {
+			return nil, fmt.Errorf("incomplete DSN: Missing channel. DSN: %s", dsn.GetOriginalDSN())
+		}
+		transport = NewTransport(user, authToken, channel, f.client)
+	} else {
+		if !strings.Contains(strings.Trim(user, "/"), "/") {
+			return nil, fmt.Errorf("invalid DSN: Webhook token must be \"<id>/<token>\". DSN: %s", dsn.GetOriginalDSN())
+		}
+		transport = NewWebhookTransport(user, channel, f.client)
+	}
+
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"rocketchat"}
+}
@@ -0,0 +1,163 @@
+package rocketchat
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for Rocket.Chat.
+type Options struct {
+	options     map[string]any
+	attachments []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options:     make(map[string]any),
+		attachments: make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.attachments) > 0 {
+		o.options["attachments"] = o.attachments
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the channel ("#general"), user ("@jane") or room ID to post into.
+func (o *Options) Recipient(channel string) *Options {
+	o.options["recipient_id"] = channel
+	return o
+}
+
+// Alias sets the name shown instead of the username of the bot.
+func (o *Options) Alias(alias string) *Options {
+	o.options["alias"] = alias
+	return o
+}
+
+// Avatar sets the URL of the avatar shown instead of the avatar of the bot.
+func (o *Options) Avatar(url string) *Options {
+	o.options["avatar"] = url
+	return o
+}
+
+// Emoji sets an emoji shown as avatar (e.g., ":rocket:"), overriding the avatar.
+func (o *Options) Emoji(emoji string) *Options {
+	o.options["emoji"] = emoji
+	return o
+}
+
+// AddAttachment adds a message attachment.
+func (o *Options) AddAttachment(attachment *Attachment) *Options {
+	o.attachments = append(o.attachments, attachment.ToMap())
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.ToMap())
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"attachments": []map[string]any(nil),
+}
+
+// Attachment represents a Rocket.Chat message attachment.
+type Attachment struct {
+	options map[string]any
+	fields  []map[string]any
+}
+
+func NewAttachment() *Attachment {
+	return &Attachment{
+		options: make(map[string]any),
+		fields:  make([]map[string]any, 0),
+	}
+}
+
+func (a *Attachment) ToMap() map[string]any {
+	if len(a.fields) > 0 {
+		a.options["fields"] = a.fields
+	}
+	return a.options
+}
+
+// Color sets the color of the left border (e.g., "#FF0000").
+func (a *Attachment) Color(color string) *Attachment {
+	a.options["color"] = color
+	return a
+}
+
+// Text sets the attachment text.
+func (a *Attachment) Text(text string) *Attachment {
+	a.options["text"] = text
+	return a
+}
+
+// Title sets the attachment title, optionally linked.
+func (a *Attachment) Title(title string, link ...string) *Attachment {
+	a.options["title"] = title
+	if len(link) > 0 {
+		a.options["title_link"] = link[0]
+	}
+	return a
+}
+
+// Author sets the attachment author, optionally linked.
+func (a *Attachment) Author(name string, link ...string) *Attachment {
+	a.options["author_name"] = name
+	if len(link) > 0 {
+		a.options["author_link"] = link[0]
+	}
+	return a
+}
+
+// AuthorIcon sets the author icon URL.
+func (a *Attachment) AuthorIcon(url string) *Attachment {
+	a.options["author_icon"] = url
+	return a
+}
+
+// Image sets the image URL.
+func (a *Attachment) Image(url string) *Attachment {
+	a.options["image_url"] = url
+	return a
+}
+
+// Thumbnail sets the thumbnail URL.
+func (a *Attachment) Thumbnail(url string) *Attachment {
+	a.options["thumb_url"] = url
+	return a
+}
+
+// Timestamp sets the time shown next to the attachment.
+func (a *Attachment) Timestamp(timestamp time.Time) *Attachment {
+	a.options["ts"] = timestamp.Format(time.RFC3339)
+	return a
+}
+
+// Collapsed collapses the attachment by default.
+func (a *Attachment) Collapsed(collapsed bool) *Attachment {
+	a.options["collapsed"] = collapsed
+	return a
+}
+
+// AddField adds a field to the attachment. Short fields are shown side by side.
+func (a *Attachment) AddField(title, value string, short ...bool) *Attachment {
+	field := map[string]any{"title": title, "value": value}
+	if len(short) > 0 {
+		field["short"] = short[0]
+	}
+	a.fields = append(a.fields, field)
+	return a
+}
@@ -0,0 +1,172 @@
+package rocketchat
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts messages to Rocket.Chat, either through an incoming webhook or through the
+// REST API (chat.postMessage) as a user or bot.
+type Transport struct {
+	*notifier.AbstractTransport
+	webhookToken string
+	userID       string
+	authToken    string
+	channel      string
+}
+
+// NewWebhookTransport creates a Rocket.Chat transport posting through the incoming webhook with
+// the token "<id>/<token>" (the part of the webhook URL after "/hooks/"). The channel is optional
+// and overrides the channel of the webhook. The server is set with SetHost.
+func NewWebhookTransport(token, channel string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		webhookToken:      strings.Trim(token, "/"),
+		channel:           channel,
+	}
+}
+
+// NewTransport creates a Rocket.Chat transport posting with chat.postMessage as the user with the
+// personal access token. The server is set with SetHost.
+func NewTransport(userID, authToken, channel string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		userID:            userID,
+		authToken:         authToken,
+		channel:           channel,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.channel != "" {
+		return fmt.Sprintf("rocketchat://%s?channel=%s", t.GetEndpoint(), t.channel)
+	}
+	return fmt.Sprintf("rocketchat://%s", t.GetEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("rocketchat: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("rocketchat").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	channel := chatMsg.GetRecipientId()
+	if channel == "" {
+		channel = t.channel
+	}
+	delete(options, "recipient_id")
+	options["text"] = chatMsg.GetText()
+
+	var endpoint string
+	if t.webhookToken != "" {
+		if channel != "" {
+			options["channel"] = channel
+		}
+		endpoint = fmt.Sprintf("https://%s/hooks/%s", t.GetEndpoint(), t.webhookToken)
+	} else {
+		// Channels and users are addressed by name, everything else is a room ID
+		switch {
+		case channel == "":
+			return nil, fmt.Errorf("rocketchat: missing channel")
+		case strings.HasPrefix(channel, "#") || strings.HasPrefix(channel, "@"):
+			options["channel"] = channel
+		default:
+			options["roomId"] = channel
+		}
+		endpoint = fmt.Sprintf("https://%s/api/v1/chat.postMessage", t.GetEndpoint())
+	}
+
+	jsonBody, err := notifier.JSONMarshal(options)
+	if err != nil {
+		return nil, fmt.Errorf("rocketchat: marshal options: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("rocketchat: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if t.webhookToken == "" {
+		req.Header.Set("X-User-Id", t.userID)
+		req.Header.Set("X-Auth-Token", t.authToken)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("rocketchat: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		// The reset header is a Unix timestamp in milliseconds
+		var retryAfter time.Duration
+		if reset, err := strconv.ParseInt(resp.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+			retryAfter = max(time.Until(time.UnixMilli(reset)), 0)
+		}
+		return nil, &notifier.RateLimitError{
+			Transport:  "rocketchat",
+			RetryAfter: retryAfter,
+			Message:    string(respBody),
+		}
+	}
+
+	var result struct {
+		Success bool   `json:"success"`
+		Error   string `json:"error"`
+		Channel string `json:"channel"`
+		Message struct {
+			ID  string `json:"_id"`
+			RID string `json:"rid"`
+		} `json:"message"`
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("rocketchat: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("rocketchat: decode response: %w", err)
+	}
+	if !result.Success {
+		return nil, fmt.Errorf("rocketchat: API error: %s", result.Error)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	if result.Message.ID != "" {
+		sentMessage.SetMessageID(result.Message.ID)
+		sentMessage.SetInfo("room_id", result.Message.RID)
+	}
+
+	return sentMessage, nil
+}
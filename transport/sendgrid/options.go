@@ -0,0 +1,82 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for SendGrid.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// TemplateID sends the email with a dynamic template (e.g., "d-f43daeeaef504760851f727007e0b5d0").
+// The subject and bodies of the message are optional with a template.
+func (o *Options) TemplateID(id string) *Options {
+	o.options["template_id"] = id
+	return o
+}
+
+// TemplateData sets a value of the dynamic template data (handlebars substitutions).
+func (o *Options) TemplateData(key string, value any) *Options {
+	data, ok := o.options["dynamic_template_data"].(map[string]any)
+	if !ok {
+		data = make(map[string]any)
+		o.options["dynamic_template_data"] = data
+	}
+	data[key] = value
+	return o
+}
+
+// Category adds categories used to group statistics.
+func (o *Options) Category(categories ...string) *Options {
+	existing, _ := o.options["categories"].([]string)
+	o.options["categories"] = append(existing, categories...)
+	return o
+}
+
+// CustomArg adds a custom argument, included in event webhooks.
+func (o *Options) CustomArg(key, value string) *Options {
+	args, ok := o.options["custom_args"].(map[string]string)
+	if !ok {
+		args = make(map[string]string)
+		o.options["custom_args"] = args
+	}
+	args[key] = value
+	return o
+}
+
+// SendAt schedules the email (up to 72 hours in advance).
+func (o *Options) SendAt(at time.Time) *Options {
+	o.options["send_at"] = at.Unix()
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"categories":  []string(nil),
+	"custom_args": map[string]string(nil),
+	"send_at":     int64(0),
+}
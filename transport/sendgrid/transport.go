@@ -0,0 +1,219 @@
+package sendgrid
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends emails via the SendGrid v3 Mail Send API.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey string
+	from   string
+}
+
+// NewTransport creates a new SendGrid transport. from is the default sender address.
+func NewTransport(apiKey, from string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		from:              from,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("sendgrid://%s?from=%s", t.getEndpoint(), t.from)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.EmailMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	emailMsg, ok := message.(*notifier.EmailMessage)
+	if !ok {
+		return nil, fmt.Errorf("sendgrid: unsupported message type %T, expected EmailMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := emailMsg.GetOptions("sendgrid").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	from := emailMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+	sender, err := address(from)
+	if err != nil {
+		return nil, err
+	}
+
+	personalization := make(map[string]any)
+	for key, addresses := range map[string][]string{
+		"to":  emailMsg.GetTo(),
+		"cc":  emailMsg.GetCc(),
+		"bcc": emailMsg.GetBcc(),
+	} {
+		if len(addresses) == 0 {
+			continue
+		}
+		list := make([]map[string]string, 0, len(addresses))
+		for _, recipient := range addresses {
+			parsed, err := address(recipient)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, parsed)
+		}
+		personalization[key] = list
+	}
+	if _, ok := personalization["to"]; !ok {
+		return nil, fmt.Errorf("sendgrid: missing recipient")
+	}
+	if data, ok := options["dynamic_template_data"]; ok {
+		personalization["dynamic_template_data"] = data
+	}
+
+	payload := map[string]any{
+		"personalizations": []map[string]any{personalization},
+		"from":             sender,
+	}
+	if subject := emailMsg.GetSubject(); subject != "" {
+		payload["subject"] = subject
+	}
+	if replyTo := emailMsg.GetReplyTo(); replyTo != "" {
+		parsed, err := address(replyTo)
+		if err != nil {
+			return nil, err
+		}
+		payload["reply_to"] = parsed
+	}
+
+	// Plain text must come before HTML
+	var content []map[string]string
+	if text := emailMsg.GetText(); text != "" {
+		content = append(content, map[string]string{"type": "text/plain", "value": text})
+	}
+	if html := emailMsg.GetHTML(); html != "" {
+		content = append(content, map[string]string{"type": "text/html", "value": html})
+	}
+	if len(content) > 0 {
+		payload["content"] = content
+	}
+
+	if attachments := emailMsg.GetAttachments(); len(attachments) > 0 {
+		list := make([]map[string]string, 0, len(attachments))
+		for _, attachment := range attachments {
+			item := map[string]string{
+				"content":     base64.StdEncoding.EncodeToString(attachment.Data),
+				"filename":    attachment.Filename,
+				"disposition": "attachment",
+			}
+			if attachment.ContentType != "" {
+				item["type"] = attachment.ContentType
+			}
+			list = append(list, item)
+		}
+		payload["attachments"] = list
+	}
+	if headers := emailMsg.GetHeaders(); len(headers) > 0 {
+		payload["headers"] = headers
+	}
+	for _, key := range []string{"template_id", "categories", "custom_args", "send_at"} {
+		if value, ok := options[key]; ok {
+			payload[key] = value
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("sendgrid: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v3/mail/send", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("sendgrid: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sendgrid: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusAccepted && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Errors []struct {
+				Message string `json:"message"`
+				Field   string `json:"field"`
+			} `json:"errors"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && len(apiResp.Errors) > 0 {
+			messages := make([]string, 0, len(apiResp.Errors))
+			for _, apiErr := range apiResp.Errors {
+				if apiErr.Field != "" {
+					messages = append(messages, fmt.Sprintf("%s: %s", apiErr.Field, apiErr.Message))
+				} else {
+					messages = append(messages, apiErr.Message)
+				}
+			}
+			errMessage = strings.Join(messages, "; ")
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "sendgrid",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("sendgrid: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	// Accepted emails have an empty body, the message ID is returned as header
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetMessageID(resp.Header.Get("X-Message-Id"))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.sendgrid.com"
+	}
+	return endpoint
+}
+
+// address converts an address like "Jane Doe <jane@example.com>" to a SendGrid email object.
+func address(value string) (map[string]string, error) {
+	parsed, err := mail.ParseAddress(value)
+	if err != nil {
+		return nil, fmt.Errorf("sendgrid: invalid address %q: %w", value, err)
+	}
+	result := map[string]string{"email": parsed.Address}
+	if parsed.Name != "" {
+		result["name"] = parsed.Name
+	}
+	return result, nil
+}
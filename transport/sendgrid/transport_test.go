@@ -0,0 +1,170 @@
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body string
+	client := newMockClient(func(r *http.Request) (*http.Response, error) {
+		req = r
+		data, _ := io.ReadAll(r.Body)
+		body = string(data)
+		resp := newResponse(http.StatusAccepted, "")
+		resp.Header.Set("X-Message-Id", "W86EgYT6SQKk0lRflfLRsA")
+		return resp, nil
+	})
+
+	transport := NewTransport("SG.key", "Alerts <alerts@example.com>", client)
+
+	msg := notifier.NewEmailMessage("Deploy finished").
+		To("jane@example.com").
+		Bcc("audit@example.com").
+		Text("Version 1.2.3 is live").
+		HTML("<p>Version 1.2.3 is live</p>").
+		Attach("report.txt", "text/plain", []byte("ok")).
+		WithOptions("sendgrid", NewOptions().Category("deploys").SendAt(time.Unix(1700000000, 0)))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://api.sendgrid.com/v3/mail/send" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+	if req.Header.Get("Authorization") != "Bearer SG.key" {
+		t.Errorf("Unexpected Authorization header: %s", req.Header.Get("Authorization"))
+	}
+
+	expected := `{"attachments":[{"content":"b2s=","disposition":"attachment","filename":"report.txt","type":"text/plain"}],` +
+		`"categories":["deploys"],"content":[{"type":"text/plain","value":"Version 1.2.3 is live"},{"type":"text/html","value":"\u003cp\u003eVersion 1.2.3 is live\u003c/p\u003e"}],` +
+		`"from":{"email":"alerts@example.com","name":"Alerts"},"personalizations":[{"bcc":[{"email":"audit@example.com"}],"to":[{"email":"jane@example.com"}]}],` +
+		`"send_at":1700000000,"subject":"Deploy finished"}`
+	if strings.TrimSpace(body) != expected {
+		t.Errorf("Unexpected body:\n%s", body)
+	}
+	if sentMsg.GetMessageID() != "W86EgYT6SQKk0lRflfLRsA" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportSendTemplate(t *testing.T) {
+	var payload map[string]any
+	client := newMockClient(func(r *http.Request) (*http.Response, error) {
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &payload)
+		return newResponse(http.StatusAccepted, ""), nil
+	})
+
+	transport := NewTransport("SG.key", "alerts@example.com", client)
+
+	msg := notifier.NewEmailMessage("").
+		To("jane@example.com").
+		WithOptions("sendgrid", NewOptions().TemplateID("d-123").TemplateData("name", "Jane"))
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	personalization := payload["personalizations"].([]any)[0].(map[string]any)
+	if payload["template_id"] != "d-123" || personalization["dynamic_template_data"].(map[string]any)["name"] != "Jane" {
+		t.Errorf("Unexpected payload: %v", payload)
+	}
+	if _, ok := payload["subject"]; ok {
+		t.Errorf("Expected no subject: %v", payload)
+	}
+}
+
+func TestTransportSendError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusBadRequest, `{"errors":[{"message":"The from address does not match a verified Sender Identity.","field":"from","help":null}]}`), nil
+	})
+
+	transport := NewTransport("SG.key", "alerts@example.com", client)
+	_, err := transport.Send(context.Background(), notifier.NewEmailMessage("Hi").To("jane@example.com").Text("Hi"))
+	if err == nil || err.Error() != "sendgrid: API error (status 400): from: The from address does not match a verified Sender Identity." {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestTransportSendRateLimit(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusTooManyRequests, `{"errors":[{"message":"too many requests"}]}`), nil
+	})
+
+	transport := NewTransport("SG.key", "alerts@example.com", client)
+	_, err := transport.Send(context.Background(), notifier.NewEmailMessage("Hi").To("jane@example.com").Text("Hi"))
+
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Fatalf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestTransportMissingRecipient(t *testing.T) {
+	transport := NewTransport("SG.key", "alerts@example.com", nil)
+
+	_, err := transport.Send(context.Background(), notifier.NewEmailMessage("Hi").Text("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "missing recipient") {
+		t.Errorf("Expected missing recipient error, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("sendgrid://SG.key@default?from=alerts@example.com")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support sendgrid DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	sendgridTransport := transport.(*Transport)
+	if sendgridTransport.apiKey != "SG.key" || sendgridTransport.from != "alerts@example.com" {
+		t.Errorf("Unexpected transport: %+v", sendgridTransport)
+	}
+
+	for _, invalid := range []string{"sendgrid://default?from=alerts@example.com", "sendgrid://SG.key@default"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+package ses
+
+import (
+	"fmt"
+
+	"github.com/shyim/go-notifier/internal/awsauth"
+)
+
+// Credentials holds AWS access credentials.
+type Credentials = awsauth.Credentials
+
+// LoadDefaultCredentials resolves credentials the way the AWS SDKs do for static credentials:
+// the AWS_ACCESS_KEY_ID/AWS_SECRET_ACCESS_KEY/AWS_SESSION_TOKEN environment variables first,
+// then the AWS_PROFILE (or "default") profile of the shared credentials file.
+func LoadDefaultCredentials() (*Credentials, error) {
+	credentials, err := awsauth.LoadDefaultCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("ses: %w", err)
+	}
+	return credentials, nil
+}
+
+// DefaultRegion returns the region from AWS_REGION or AWS_DEFAULT_REGION.
+func DefaultRegion() string {
+	return awsauth.DefaultRegion()
+}
@@ -0,0 +1,57 @@
+package ses
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Amazon SES.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// ConfigurationSet sets the configuration set used to send the email,
+// overriding the configuration_set DSN option.
+func (o *Options) ConfigurationSet(name string) *Options {
+	o.options["configuration_set"] = name
+	return o
+}
+
+// Tag adds a message tag, published to the configuration set's event destinations.
+func (o *Options) Tag(name, value string) *Options {
+	tags, _ := o.options["tags"].([]map[string]string)
+	o.options["tags"] = append(tags, map[string]string{"Name": name, "Value": value})
+	return o
+}
+
+// FromArn sets the ARN of the identity authorized to send on behalf of the sender (sending authorization).
+func (o *Options) FromArn(arn string) *Options {
+	o.options["from_arn"] = arn
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"tags": []map[string]string(nil),
+}
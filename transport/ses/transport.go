@@ -0,0 +1,169 @@
+package ses
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/mail"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/internal/awsauth"
+)
+
+// Transport sends emails via the Amazon SES v2 API.
+type Transport struct {
+	*notifier.AbstractTransport
+	credentials      *Credentials
+	region           string
+	from             string
+	configurationSet string
+	now              func() time.Time
+}
+
+// NewTransport creates a new Amazon SES transport. from is the default sender address.
+func NewTransport(credentials *Credentials, region, from string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		credentials:       credentials,
+		region:            region,
+		from:              from,
+		now:               time.Now,
+	}
+}
+
+// SetConfigurationSet sets the default configuration set used to send emails.
+func (t *Transport) SetConfigurationSet(name string) *Transport {
+	t.configurationSet = name
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("ses://%s?region=%s", t.getEndpoint(), t.region)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.EmailMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	emailMsg, ok := message.(*notifier.EmailMessage)
+	if !ok {
+		return nil, fmt.Errorf("ses: unsupported message type %T, expected EmailMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := emailMsg.GetOptions("ses").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	raw, err := emailMsg.MIME(t.from)
+	if err != nil {
+		return nil, fmt.Errorf("ses: %w", err)
+	}
+
+	from := emailMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+
+	// The raw message carries To and Cc, but Bcc only exists in the envelope
+	destination := make(map[string][]string)
+	for key, addresses := range map[string][]string{
+		"ToAddresses":  emailMsg.GetTo(),
+		"CcAddresses":  emailMsg.GetCc(),
+		"BccAddresses": emailMsg.GetBcc(),
+	} {
+		for _, address := range addresses {
+			parsed, err := mail.ParseAddress(address)
+			if err != nil {
+				return nil, fmt.Errorf("ses: invalid recipient address %q: %w", address, err)
+			}
+			destination[key] = append(destination[key], parsed.Address)
+		}
+	}
+
+	payload := map[string]any{
+		"FromEmailAddress": from,
+		"Destination":      destination,
+		"Content": map[string]any{
+			"Raw": map[string]any{"Data": raw},
+		},
+	}
+
+	configurationSet := t.configurationSet
+	if name, ok := options["configuration_set"].(string); ok && name != "" {
+		configurationSet = name
+	}
+	if configurationSet != "" {
+		payload["ConfigurationSetName"] = configurationSet
+	}
+	if tags, ok := options["tags"]; ok {
+		payload["EmailTags"] = tags
+	}
+	if arn, ok := options["from_arn"].(string); ok && arn != "" {
+		payload["FromEmailAddressIdentityArn"] = arn
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("ses: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v2/email/outbound-emails", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("ses: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	awsauth.SignRequest(req, jsonBody, t.credentials, t.region, "ses", t.now())
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ses: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport: "ses",
+			Message:   string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("ses: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		MessageID string `json:"MessageId"`
+	}
+
+	rawResponse, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("ses: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, rawResponse)
+	sentMessage.SetMessageID(result.MessageID)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return fmt.Sprintf("email.%s.amazonaws.com", t.region)
+	}
+	return endpoint
+}
@@ -0,0 +1,81 @@
+package signal
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("signal", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Signal transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Signal transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Signal transport from a DSN.
+// DSN format: signal://<host>[:<port>]?from=<phone_number>[&to=<recipient>]
+// Example: signal+http://localhost:8080?from=%2B15551234567&to=%2B15557654321
+//
+// Plain HTTP servers can be reached with signal+http://<host>.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "" || host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	from, err := dsn.GetRequiredOption("from")
+	if err != nil {
+		return nil, err
+	}
+
+	transport := NewTransport(from, dsn.GetOption("to"), f.client)
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+	if scheme == "signal+http" {
+		transport.SetScheme("http")
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"signal", "signal+http"}
+}
@@ -0,0 +1,68 @@
+package signal
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Options implements MessageOptionsInterface for Signal.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the phone number, username or group ID to send to, overriding the transport's.
+func (o *Options) Recipient(id string) *Options {
+	o.options["recipient_id"] = id
+	return o
+}
+
+// Group sends the message to the group with the ID returned by the /v1/groups endpoint.
+func (o *Options) Group(id string) *Options {
+	if !strings.HasPrefix(id, "group.") {
+		id = "group." + id
+	}
+	return o.Recipient(id)
+}
+
+// Attachment attaches a file, which is sent base64 encoded.
+func (o *Options) Attachment(filename, contentType string, data []byte) *Options {
+	attachments, _ := o.options["base64_attachments"].([]string)
+	o.options["base64_attachments"] = append(attachments, fmt.Sprintf("data:%s;filename=%s;base64,%s", contentType, filename, base64.StdEncoding.EncodeToString(data)))
+	return o
+}
+
+// TextMode sets the text mode, "normal" or "styled" to format *bold*, _italic_,
+// ~strikethrough~, ||spoiler|| and `monospace` text.
+func (o *Options) TextMode(mode string) *Options {
+	o.options["text_mode"] = mode
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"base64_attachments": []string(nil),
+}
@@ -0,0 +1,131 @@
+package signal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends messages via a self-hosted signal-cli REST API
+// (https://github.com/bbernhard/signal-cli-rest-api).
+type Transport struct {
+	*notifier.AbstractTransport
+	from   string
+	to     string
+	scheme string
+}
+
+// NewTransport creates a new Signal transport sending from the registered phone number.
+func NewTransport(from, to string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		from:              from,
+		to:                to,
+		scheme:            "https",
+	}
+}
+
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.to != "" {
+		return fmt.Sprintf("signal://%s?from=%s&to=%s", t.GetEndpoint(), t.from, t.to)
+	}
+	return fmt.Sprintf("signal://%s?from=%s", t.GetEndpoint(), t.from)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("signal: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	payload := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("signal").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			payload[k] = v
+		}
+	}
+	delete(payload, "recipient_id")
+
+	to := chatMsg.GetRecipientId()
+	if to == "" {
+		to = t.to
+	}
+	if to == "" {
+		return nil, fmt.Errorf("signal: missing recipient")
+	}
+
+	text := chatMsg.GetText()
+	if _, ok := payload["base64_attachments"]; !ok && text == "" {
+		return nil, fmt.Errorf("signal: message has no content")
+	}
+
+	payload["number"] = t.from
+	payload["recipients"] = []string{to}
+	payload["message"] = text
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("signal: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s://%s/v2/send", t.scheme, t.GetEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("signal: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("signal: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Error string `json:"error"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Error != "" {
+			errMessage = apiResp.Error
+		}
+		return nil, fmt.Errorf("signal: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		Timestamp string `json:"timestamp"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("signal: decode response: %w", err)
+	}
+
+	// The timestamp identifies the message for replies, reactions and deletion
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Timestamp)
+
+	return sentMessage, nil
+}
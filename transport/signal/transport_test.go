@@ -0,0 +1,185 @@
+package signal
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		*body = nil
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusCreated, `{"timestamp":"1694004466413"}`), nil
+	})
+}
+
+func newTransport(client *http.Client) *Transport {
+	transport := NewTransport("+15551234567", "+15557654321", client)
+	transport.SetHost("signal.example.com")
+	return transport
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := newTransport(captureBody(&req, &body))
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Deploy finished").Content("Version 1.2.3 is live"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://signal.example.com/v2/send" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+
+	data, _ := json.Marshal(body)
+	if string(data) != `{"message":"Deploy finished\n\nVersion 1.2.3 is live","number":"+15551234567","recipients":["+15557654321"]}` {
+		t.Errorf("Unexpected body: %s", data)
+	}
+	if sentMsg.GetMessageID() != "1694004466413" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  *notifier.ChatMessage
+		expected string
+	}{
+		{
+			"group",
+			notifier.NewChatMessage("Hi").WithOptions("signal", NewOptions().Group("ZmFrZWdyb3VwaWQ=")),
+			`{"message":"Hi","number":"+15551234567","recipients":["group.ZmFrZWdyb3VwaWQ="]}`,
+		},
+		{
+			"prefixed group",
+			notifier.NewChatMessage("Hi").WithOptions("signal", NewOptions().Group("group.ZmFrZWdyb3VwaWQ=")),
+			`{"message":"Hi","number":"+15551234567","recipients":["group.ZmFrZWdyb3VwaWQ="]}`,
+		},
+		{
+			"attachments",
+			notifier.NewChatMessage("").WithOptions("signal", NewOptions().
+				Attachment("report.txt", "text/plain", []byte("hello")).
+				Attachment("empty.bin", "application/octet-stream", nil)),
+			`{"base64_attachments":["data:text/plain;filename=report.txt;base64,aGVsbG8=","data:application/octet-stream;filename=empty.bin;base64,"],"message":"","number":"+15551234567","recipients":["+15557654321"]}`,
+		},
+		{
+			"styled",
+			notifier.NewChatMessage("*Disk* full").WithOptions("signal", NewOptions().Recipient("+15550000000").TextMode("styled")),
+			`{"message":"*Disk* full","number":"+15551234567","recipients":["+15550000000"],"text_mode":"styled"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var body map[string]any
+			transport := newTransport(captureBody(&req, &body))
+
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			data, _ := json.Marshal(body)
+			if string(data) != tt.expected {
+				t.Errorf("Unexpected body: %s", data)
+			}
+		})
+	}
+}
+
+func TestTransportErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport *Transport
+		message   *notifier.ChatMessage
+		expected  string
+	}{
+		{"missing recipient", NewTransport("+15551234567", "", nil), notifier.NewChatMessage("Hi"), "signal: missing recipient"},
+		{"no content", newTransport(nil), notifier.NewChatMessage(""), "signal: message has no content"},
+		{
+			"api",
+			newTransport(newMockClient(func(req *http.Request) (*http.Response, error) {
+				return newResponse(http.StatusBadRequest, `{"error":"Failed to send message: Unregistered user"}`), nil
+			})),
+			notifier.NewChatMessage("Hi"),
+			"signal: API error (status 400): Failed to send message: Unregistered user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.transport.Send(context.Background(), tt.message)
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("Expected %q, got: %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestFactory(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	factory := NewTransportFactory(captureBody(&req, &body))
+	dsn, _ := notifier.NewDSN("signal+http://localhost:8080?from=+15551234567&to=%2B15557654321")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support signal+http DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+	if transport.String() != "signal://localhost:8080?from=+15551234567&to=+15557654321" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi")); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if req.URL.String() != "http://localhost:8080/v2/send" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+
+	for _, invalid := range []string{"signal://default?from=%2B15551234567", "signal://localhost:8080"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", invalid)
+		}
+	}
+}
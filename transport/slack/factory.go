@@ -11,6 +11,11 @@ import (
 
 func init() {
 	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("slack", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
 }
 
 // TransportFactory creates Slack transports from DSN.
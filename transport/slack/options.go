@@ -60,6 +60,13 @@ func (o *Options) Block(block Block) *Options {
 	return o
 }
 
+// RawBlocks sets blocks encoded in advance, e.g. with notifier.StaticJSON for a layout shared by
+// many messages. Blocks added with Block take precedence.
+func (o *Options) RawBlocks(blocks json.RawMessage) *Options {
+	o.options["blocks"] = blocks
+	return o
+}
+
 // IconEmoji sets the emoji for the bot.
 func (o *Options) IconEmoji(emoji string) *Options {
 	o.options["icon_emoji"] = emoji
@@ -119,6 +126,11 @@ func (o *Options) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.options)
 }
 
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"post_at": int64(0),
+}
+
 // Block represents a Slack block.
 type Block interface {
 	ToMap() map[string]any
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 
 	"github.com/shyim/go-notifier"
@@ -14,8 +15,9 @@ import (
 // Transport sends messages via Slack API.
 type Transport struct {
 	*notifier.AbstractTransport
-	accessToken string
-	channel     string
+	accessToken  string
+	channel      string
+	colorMapping map[string]int
 }
 
 // NewTransport creates a new Slack transport.
@@ -28,9 +30,16 @@ func NewTransport(accessToken, channel string, client *http.Client) *Transport {
 		AbstractTransport: notifier.NewAbstractTransport(client),
 		accessToken:       accessToken,
 		channel:           channel,
+		colorMapping:      notifier.ImportanceColors,
 	}
 }
 
+// SetColorMapping overrides the importance to attachment color mapping (0xRRGGBB).
+func (t *Transport) SetColorMapping(mapping map[string]int) *Transport {
+	t.colorMapping = mapping
+	return t
+}
+
 func (t *Transport) String() string {
 	endpoint := t.getEndpoint()
 	query := ""
@@ -58,11 +67,29 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 
 	options := make(map[string]any)
 	if opts, ok := chatMsg.GetOptions("slack").(*Options); ok {
-		options = opts.ToMap()
+		// The payload is built on a copy, so the attachment of this message doesn't stick to the options
+		options = maps.Clone(opts.ToMap())
 	}
 
 	options["channel"] = chatID
-	options["text"] = chatMsg.GetSubject()
+	options["text"] = chatMsg.GetText()
+
+	// Messages with an importance are wrapped in a colored attachment unless they define their own layout
+	_, hasBlocks := options["blocks"]
+	_, hasAttachments := options["attachments"]
+	if color, ok := t.colorMapping[chatMsg.GetImportance()]; ok && !hasBlocks && !hasAttachments {
+		attachment := map[string]any{
+			"color":    fmt.Sprintf("#%06X", color),
+			"fallback": chatMsg.GetText(),
+			"text":     chatMsg.GetSubject(),
+		}
+		if content := chatMsg.GetContent(); content != "" {
+			attachment["title"] = chatMsg.GetSubject()
+			attachment["text"] = content
+		}
+		options["attachments"] = []map[string]any{attachment}
+		delete(options, "text")
+	}
 
 	// Determine API method
 	apiMethod := "chat.postMessage"
@@ -81,7 +108,7 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 		}
 	}
 
-	jsonBody, err := json.Marshal(filteredOptions)
+	jsonBody, err := notifier.JSONMarshal(filteredOptions)
 	if err != nil {
 		return nil, fmt.Errorf("slack: marshal options: %w", err)
 	}
@@ -95,26 +122,38 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Authorization", "Bearer "+t.accessToken)
 
-	resp, err := t.AbstractTransport.GetClient().Do(req)
+	resp, err := t.AbstractTransport.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("slack: send request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, &notifier.RateLimitError{
+			Transport:  "slack",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("slack: API error (status %d): %s", resp.StatusCode, string(respBody))
 	}
 
 	var result struct {
-		OK      bool   `json:"ok"`
-		Channel string `json:"channel"`
-		TS      string `json:"ts"`
-		Error   string `json:"error"`
-		Errors  string `json:"errors"`
+		OK                 bool   `json:"ok"`
+		Channel            string `json:"channel"`
+		TS                 string `json:"ts"`
+		ScheduledMessageID string `json:"scheduled_message_id"`
+		PostAt             int64  `json:"post_at"`
+		Error              string `json:"error"`
+		Errors             string `json:"errors"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
 		return nil, fmt.Errorf("slack: decode response: %w", err)
 	}
 
@@ -127,12 +166,78 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 	}
 
 	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
 	sentMessage.SetMessageID(result.TS)
 	sentMessage.SetInfo("channel_id", result.Channel)
+	if result.ScheduledMessageID != "" {
+		// Scheduled messages only get a timestamp once they are posted
+		sentMessage.SetMessageID(result.ScheduledMessageID)
+		sentMessage.SetInfo("scheduled_message_id", result.ScheduledMessageID)
+		sentMessage.SetInfo("post_at", result.PostAt)
+	}
 
 	return sentMessage, nil
 }
 
+// CancelScheduled deletes a message scheduled with PostAt before it is posted.
+// The info must contain the "channel_id" returned by Send.
+func (t *Transport) CancelScheduled(ctx context.Context, messageID string, info map[string]any) error {
+	channel, _ := info["channel_id"].(string)
+	if channel == "" {
+		channel = t.channel
+	}
+
+	jsonBody, err := notifier.JSONMarshal(map[string]string{
+		"channel":              channel,
+		"scheduled_message_id": messageID,
+	})
+	if err != nil {
+		return fmt.Errorf("slack: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/api/chat.deleteScheduledMessage", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return fmt.Errorf("slack: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+	req.Header.Set("Authorization", "Bearer "+t.accessToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return fmt.Errorf("slack: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return &notifier.RateLimitError{
+			Transport:  "slack",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("slack: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		OK    bool   `json:"ok"`
+		Error string `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("slack: decode response: %w", err)
+	}
+	if !result.OK {
+		return fmt.Errorf("slack: %s", result.Error)
+	}
+
+	return nil
+}
+
 func (t *Transport) getEndpoint() string {
 	endpoint := t.GetEndpoint()
 	if endpoint == "" || endpoint == "localhost" {
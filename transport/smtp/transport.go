@@ -0,0 +1,205 @@
+package smtp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	netsmtp "net/smtp"
+	"strconv"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the SMTP submission port.
+const DefaultPort = 587
+
+// Supported connection encryption modes.
+const (
+	EncryptionStartTLS = "starttls"
+	EncryptionTLS      = "tls"
+	EncryptionNone     = "none"
+)
+
+// Transport sends emails via SMTP.
+type Transport struct {
+	*notifier.AbstractTransport
+	username           string
+	password           string
+	from               string
+	encryption         string
+	insecureSkipVerify bool
+}
+
+// NewTransport creates a new SMTP transport. from is the default sender address.
+func NewTransport(username, password, from string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		username:          username,
+		password:          password,
+		from:              from,
+		encryption:        EncryptionStartTLS,
+	}
+}
+
+// SetEncryption sets the connection encryption (EncryptionStartTLS, EncryptionTLS or EncryptionNone).
+func (t *Transport) SetEncryption(encryption string) *Transport {
+	t.encryption = encryption
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed relays.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("smtp://%s", t.getAddress())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.EmailMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	emailMsg, ok := message.(*notifier.EmailMessage)
+	if !ok {
+		return nil, fmt.Errorf("smtp: unsupported message type %T, expected EmailMessage", message)
+	}
+
+	body, err := emailMsg.MIME(t.from)
+	if err != nil {
+		return nil, fmt.Errorf("smtp: %w", err)
+	}
+
+	from := emailMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+	sender, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("smtp: invalid sender address: %w", err)
+	}
+
+	var recipients []string
+	for _, list := range [][]string{emailMsg.GetTo(), emailMsg.GetCc(), emailMsg.GetBcc()} {
+		for _, address := range list {
+			parsed, err := mail.ParseAddress(address)
+			if err != nil {
+				return nil, fmt.Errorf("smtp: invalid recipient address %q: %w", address, err)
+			}
+			recipients = append(recipients, parsed.Address)
+		}
+	}
+
+	client, stop, err := t.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stop()
+	defer func() { _ = client.Close() }()
+
+	if t.username != "" {
+		auth := netsmtp.PlainAuth("", t.username, t.password, t.getHost())
+		if err := client.Auth(auth); err != nil {
+			return nil, fmt.Errorf("smtp: authenticate: %w", err)
+		}
+	}
+
+	if err := client.Mail(sender.Address); err != nil {
+		return nil, fmt.Errorf("smtp: MAIL FROM: %w", err)
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return nil, fmt.Errorf("smtp: RCPT TO %s: %w", recipient, err)
+		}
+	}
+
+	writer, err := client.Data()
+	if err != nil {
+		return nil, fmt.Errorf("smtp: DATA: %w", err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		return nil, fmt.Errorf("smtp: write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("smtp: write message: %w", err)
+	}
+
+	if err := client.Quit(); err != nil {
+		return nil, fmt.Errorf("smtp: QUIT: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("recipients", recipients)
+
+	return sentMessage, nil
+}
+
+// getHost returns the configured host without port.
+func (t *Transport) getHost() string {
+	host, _, err := net.SplitHostPort(t.getAddress())
+	if err != nil {
+		return t.getAddress()
+	}
+	return host
+}
+
+// dial connects and negotiates encryption. The returned function stops binding the
+// connection to the context and is called once the client is closed.
+func (t *Transport) dial(ctx context.Context) (*netsmtp.Client, func() bool, error) {
+	address := t.getAddress()
+	tlsConfig := &tls.Config{
+		ServerName:         t.getHost(),
+		InsecureSkipVerify: t.insecureSkipVerify,
+	}
+
+	var conn net.Conn
+	var err error
+	if t.encryption == EncryptionTLS {
+		dialer := &tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		var dialer net.Dialer
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("smtp: connect: %w", err)
+	}
+
+	// Bound the whole SMTP conversation by the context
+	stop := notifier.BindConnContext(ctx, conn)
+
+	client, err := netsmtp.NewClient(conn, t.getHost())
+	if err != nil {
+		_ = conn.Close()
+		stop()
+		return nil, nil, fmt.Errorf("smtp: handshake: %w", err)
+	}
+
+	if t.encryption == EncryptionStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			_ = client.Close()
+			stop()
+			return nil, nil, fmt.Errorf("smtp: server does not support STARTTLS, use encryption=none to send unencrypted")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			_ = client.Close()
+			stop()
+			return nil, nil, fmt.Errorf("smtp: STARTTLS: %w", err)
+		}
+	}
+
+	return client, stop, nil
+}
+
+func (t *Transport) getAddress() string {
+	endpoint := t.GetEndpoint()
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		return net.JoinHostPort(endpoint, strconv.Itoa(DefaultPort))
+	}
+	return endpoint
+}
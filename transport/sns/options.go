@@ -0,0 +1,83 @@
+package sns
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Amazon SNS.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the ARN of the topic the message is published to.
+func (o *Options) Recipient(topicArn string) *Options {
+	o.options["recipient_id"] = topicArn
+	return o
+}
+
+// Subject sets the subject used for email subscriptions of the topic.
+func (o *Options) Subject(subject string) *Options {
+	o.options["subject"] = subject
+	return o
+}
+
+// Attribute adds a string message attribute, used by subscription filter policies.
+func (o *Options) Attribute(name, value string) *Options {
+	attributes, ok := o.options["attributes"].(map[string]string)
+	if !ok {
+		attributes = make(map[string]string)
+		o.options["attributes"] = attributes
+	}
+	attributes[name] = value
+	return o
+}
+
+// SMSType sets whether SMS are "Transactional" (optimized for reliability) or
+// "Promotional" (optimized for cost).
+func (o *Options) SMSType(smsType string) *Options {
+	return o.Attribute("AWS.SNS.SMS.SMSType", smsType)
+}
+
+// SenderID sets the alphanumeric sender ID of SMS, in countries supporting it.
+func (o *Options) SenderID(senderID string) *Options {
+	return o.Attribute("AWS.SNS.SMS.SenderID", senderID)
+}
+
+// MessageGroupID sets the message group of a FIFO topic; messages of a group are delivered in order.
+func (o *Options) MessageGroupID(id string) *Options {
+	o.options["message_group_id"] = id
+	return o
+}
+
+// MessageDeduplicationID sets the deduplication ID of a FIFO topic message.
+func (o *Options) MessageDeduplicationID(id string) *Options {
+	o.options["message_deduplication_id"] = id
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"attributes": map[string]string(nil),
+}
@@ -0,0 +1,172 @@
+package sns
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/internal/awsauth"
+)
+
+// Transport publishes messages to Amazon SNS topics or sends them as SMS to phone numbers.
+type Transport struct {
+	*notifier.AbstractTransport
+	credentials *Credentials
+	region      string
+	topicArn    string
+	now         func() time.Time
+}
+
+// NewTransport creates a new Amazon SNS transport. Chat messages are published to the topic with
+// the given ARN unless their options name another topic; SMS messages are sent to their phone number.
+func NewTransport(credentials *Credentials, region, topicArn string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		credentials:       credentials,
+		region:            region,
+		topicArn:          topicArn,
+		now:               time.Now,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.topicArn != "" {
+		return fmt.Sprintf("sns://%s?region=%s&topic=%s", t.getEndpoint(), t.region, t.topicArn)
+	}
+	return fmt.Sprintf("sns://%s?region=%s", t.getEndpoint(), t.region)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	switch message.(type) {
+	case *notifier.ChatMessage, *notifier.SmsMessage:
+		return true
+	}
+	return false
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", "2010-03-31")
+
+	switch msg := message.(type) {
+	case *notifier.SmsMessage:
+		// Topic ARNs are accepted as phone number to publish SMS to a topic
+		if strings.HasPrefix(msg.GetPhone(), "arn:") {
+			form.Set("TopicArn", msg.GetPhone())
+		} else {
+			form.Set("PhoneNumber", msg.GetPhone())
+		}
+		form.Set("Message", msg.GetSubject())
+	case *notifier.ChatMessage:
+		topicArn := msg.GetRecipientId()
+		if topicArn == "" {
+			topicArn = t.topicArn
+		}
+		if topicArn == "" {
+			return nil, fmt.Errorf("sns: missing topic ARN")
+		}
+		form.Set("TopicArn", topicArn)
+		form.Set("Message", msg.GetText())
+	default:
+		return nil, fmt.Errorf("sns: unsupported message type %T, expected ChatMessage or SmsMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("sns").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	if subject, ok := options["subject"].(string); ok && subject != "" {
+		form.Set("Subject", subject)
+	}
+	if groupID, ok := options["message_group_id"].(string); ok && groupID != "" {
+		form.Set("MessageGroupId", groupID)
+	}
+	if deduplicationID, ok := options["message_deduplication_id"].(string); ok && deduplicationID != "" {
+		form.Set("MessageDeduplicationId", deduplicationID)
+	}
+	if attributes, ok := options["attributes"].(map[string]string); ok {
+		for i, name := range slices.Sorted(maps.Keys(attributes)) {
+			prefix := fmt.Sprintf("MessageAttributes.entry.%d.", i+1)
+			form.Set(prefix+"Name", name)
+			form.Set(prefix+"Value.DataType", "String")
+			form.Set(prefix+"Value.StringValue", attributes[name])
+		}
+	}
+
+	body := []byte(form.Encode())
+	endpoint := fmt.Sprintf("https://%s/", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("sns: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	awsauth.SignRequest(req, body, t.credentials, t.region, "sns", t.now())
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sns: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiError struct {
+			Error struct {
+				Code    string `xml:"Code"`
+				Message string `xml:"Message"`
+			} `xml:"Error"`
+		}
+		errMessage := string(respBody)
+		if xml.Unmarshal(respBody, &apiError) == nil && apiError.Error.Code != "" {
+			errMessage = fmt.Sprintf("%s: %s", apiError.Error.Code, apiError.Error.Message)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || strings.Contains(apiError.Error.Code, "Throttl") {
+			return nil, &notifier.RateLimitError{
+				Transport:  "sns",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("sns: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		MessageID      string `xml:"PublishResult>MessageId"`
+		SequenceNumber string `xml:"PublishResult>SequenceNumber"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("sns: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetMessageID(result.MessageID)
+	if result.SequenceNumber != "" {
+		sentMessage.SetInfo("sequence_number", result.SequenceNumber)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return fmt.Sprintf("sns.%s.amazonaws.com", t.region)
+	}
+	return endpoint
+}
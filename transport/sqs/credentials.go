@@ -0,0 +1,25 @@
+package sqs
+
+import (
+	"fmt"
+
+	"github.com/shyim/go-notifier/internal/awsauth"
+)
+
+// Credentials holds AWS access credentials.
+type Credentials = awsauth.Credentials
+
+// LoadDefaultCredentials resolves credentials from the environment or the shared credentials file,
+// like ses.LoadDefaultCredentials.
+func LoadDefaultCredentials() (*Credentials, error) {
+	credentials, err := awsauth.LoadDefaultCredentials()
+	if err != nil {
+		return nil, fmt.Errorf("sqs: %w", err)
+	}
+	return credentials, nil
+}
+
+// DefaultRegion returns the region from AWS_REGION or AWS_DEFAULT_REGION.
+func DefaultRegion() string {
+	return awsauth.DefaultRegion()
+}
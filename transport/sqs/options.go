@@ -0,0 +1,81 @@
+package sqs
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Options implements MessageOptionsInterface for Amazon SQS.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// MessageGroupID sets the message group of a FIFO queue; messages of a group are delivered in order.
+func (o *Options) MessageGroupID(id string) *Options {
+	o.options["message_group_id"] = id
+	return o
+}
+
+// MessageDeduplicationID sets the deduplication ID of a FIFO queue message.
+// Defaults to the SHA-256 hash of the message body.
+func (o *Options) MessageDeduplicationID(id string) *Options {
+	o.options["message_deduplication_id"] = id
+	return o
+}
+
+// Delay postpones the delivery of the message (up to 15 minutes). Not supported by FIFO queues.
+func (o *Options) Delay(delay time.Duration) *Options {
+	o.options["delay"] = delay
+	return o
+}
+
+// Attribute adds a string message attribute, next to the type, transport and recipient attributes.
+func (o *Options) Attribute(name, value string) *Options {
+	attributes, ok := o.options["attributes"].(map[string]string)
+	if !ok {
+		attributes = make(map[string]string)
+		o.options["attributes"] = attributes
+	}
+	attributes[name] = value
+	return o
+}
+
+// Metadata adds a key-value pair to the metadata of the sent payload.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"delay":      time.Duration(0),
+	"attributes": map[string]string(nil),
+	"metadata":   map[string]string(nil),
+}
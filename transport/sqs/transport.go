@@ -0,0 +1,218 @@
+package sqs
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+	"github.com/shyim/go-notifier/internal/awsauth"
+)
+
+// DefaultMessageGroupID is the message group used for FIFO queues when none is configured.
+const DefaultMessageGroupID = "default"
+
+// Transport sends messages as JSON to an Amazon SQS queue.
+// The message type, transport and recipient are sent as message attributes.
+type Transport struct {
+	*notifier.AbstractTransport
+	credentials    *Credentials
+	region         string
+	queueURL       string
+	messageGroupID string
+	delay          time.Duration
+	now            func() time.Time
+}
+
+// NewTransport creates a new Amazon SQS transport sending to the queue with the given URL
+// (e.g., https://sqs.eu-west-1.amazonaws.com/123456789012/notifications).
+// Requests go to the host of the queue URL unless SetHost is used.
+func NewTransport(credentials *Credentials, region, queueURL string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		credentials:       credentials,
+		region:            region,
+		queueURL:          queueURL,
+		messageGroupID:    DefaultMessageGroupID,
+		now:               time.Now,
+	}
+}
+
+// SetMessageGroupID sets the default message group of FIFO queues.
+func (t *Transport) SetMessageGroupID(id string) *Transport {
+	t.messageGroupID = id
+	return t
+}
+
+// SetDelay sets the default delivery delay (up to 15 minutes). FIFO queues only support a queue-level delay.
+func (t *Transport) SetDelay(delay time.Duration) *Transport {
+	t.delay = delay
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("sqs://%s?region=%s", strings.TrimPrefix(strings.TrimPrefix(t.queueURL, "https://"), "http://"), t.region)
+}
+
+// Supports returns true for all message types, they are sent as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("sqs").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	payload := notifier.NewPayload(message)
+	if metadata, ok := options["metadata"].(map[string]string); ok {
+		payload.Metadata = metadata
+	}
+	body, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("sqs: marshal payload: %w", err)
+	}
+
+	attributes := make(map[string]any)
+	addAttribute := func(name, value string) {
+		if value != "" {
+			attributes[name] = map[string]string{"DataType": "String", "StringValue": value}
+		}
+	}
+	addAttribute("type", payload.Type)
+	addAttribute("transport", payload.Transport)
+	addAttribute("recipient", payload.Recipient)
+	if values, ok := options["attributes"].(map[string]string); ok {
+		for _, name := range slices.Sorted(maps.Keys(values)) {
+			addAttribute(name, values[name])
+		}
+	}
+
+	request := map[string]any{
+		"QueueUrl":          t.queueURL,
+		"MessageBody":       string(body),
+		"MessageAttributes": attributes,
+	}
+
+	if t.isFIFO() {
+		groupID := t.messageGroupID
+		if value, ok := options["message_group_id"].(string); ok && value != "" {
+			groupID = value
+		}
+		request["MessageGroupId"] = groupID
+
+		// Identical messages are deduplicated like with content-based deduplication
+		deduplicationID, _ := options["message_deduplication_id"].(string)
+		if deduplicationID == "" {
+			sum := sha256.Sum256(body)
+			deduplicationID = hex.EncodeToString(sum[:])
+		}
+		request["MessageDeduplicationId"] = deduplicationID
+	} else {
+		delay := t.delay
+		if value, ok := options["delay"].(time.Duration); ok {
+			delay = value
+		}
+		if delay > 0 {
+			request["DelaySeconds"] = int(delay.Seconds())
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("sqs: marshal options: %w", err)
+	}
+
+	endpoint, err := t.getEndpoint()
+	if err != nil {
+		return nil, fmt.Errorf("sqs: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("sqs: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS.SendMessage")
+	awsauth.SignRequest(req, jsonBody, t.credentials, t.region, "sqs", t.now())
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sqs: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiError struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(respBody, &apiError)
+		if resp.StatusCode == http.StatusTooManyRequests || strings.Contains(apiError.Type, "Throttl") {
+			return nil, &notifier.RateLimitError{
+				Transport:  "sqs",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    string(respBody),
+			}
+		}
+		return nil, fmt.Errorf("sqs: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		MessageID        string `json:"MessageId"`
+		MD5OfMessageBody string `json:"MD5OfMessageBody"`
+		SequenceNumber   string `json:"SequenceNumber"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("sqs: decode response: %w", err)
+	}
+
+	if sum := md5.Sum(body); result.MD5OfMessageBody != "" && result.MD5OfMessageBody != hex.EncodeToString(sum[:]) {
+		return nil, fmt.Errorf("sqs: message body checksum mismatch")
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.MessageID)
+	if result.SequenceNumber != "" {
+		sentMessage.SetInfo("sequence_number", result.SequenceNumber)
+	}
+
+	return sentMessage, nil
+}
+
+// isFIFO reports whether the queue is a FIFO queue, which requires message group IDs.
+func (t *Transport) isFIFO() bool {
+	return strings.HasSuffix(t.queueURL, ".fifo")
+}
+
+func (t *Transport) getEndpoint() (string, error) {
+	queueURL, err := url.Parse(t.queueURL)
+	if err != nil || queueURL.Host == "" {
+		return "", fmt.Errorf("invalid queue URL %q", t.queueURL)
+	}
+
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return fmt.Sprintf("%s://%s/", queueURL.Scheme, queueURL.Host), nil
+	}
+	return fmt.Sprintf("https://%s/", endpoint), nil
+}
@@ -0,0 +1,115 @@
+package syslog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory())
+	notifier.RegisterOptionsDecoder("syslog", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// facilities maps the facility names accepted in DSNs.
+var facilities = map[string]Facility{
+	"kern":     FacilityKern,
+	"user":     FacilityUser,
+	"mail":     FacilityMail,
+	"daemon":   FacilityDaemon,
+	"auth":     FacilityAuth,
+	"syslog":   FacilitySyslog,
+	"lpr":      FacilityLpr,
+	"news":     FacilityNews,
+	"uucp":     FacilityUucp,
+	"cron":     FacilityCron,
+	"authpriv": FacilityAuthPriv,
+	"ftp":      FacilityFtp,
+	"local0":   FacilityLocal0,
+	"local1":   FacilityLocal1,
+	"local2":   FacilityLocal2,
+	"local3":   FacilityLocal3,
+	"local4":   FacilityLocal4,
+	"local5":   FacilityLocal5,
+	"local6":   FacilityLocal6,
+	"local7":   FacilityLocal7,
+}
+
+// TransportFactory creates syslog transports from DSN.
+type TransportFactory struct{}
+
+// NewTransportFactory creates a new syslog transport factory.
+func NewTransportFactory() *TransportFactory {
+	return &TransportFactory{}
+}
+
+// Create creates a syslog transport from a DSN.
+// DSN format: syslog://<host>[:<port>][?facility=<name>][&app_name=<name>][&hostname=<name>]
+// Example: syslog://logs.example.com:514?facility=local0&app_name=billing
+//
+// The host "default" writes to the local syslog daemon (the socket can be set with the socket
+// option). The syslog scheme sends to remote collectors over UDP, syslog+tcp over TCP and
+// syslog+tls over TLS, on port 6514 by default.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	network := NetworkUDP
+	if name, ok := strings.CutPrefix(scheme, "syslog+"); ok {
+		network = name
+	}
+	if dsn.GetHost() == "default" {
+		if network != NetworkUDP {
+			return nil, fmt.Errorf("invalid DSN: The local syslog daemon is only supported with the syslog scheme. DSN: %s", dsn.GetOriginalDSN())
+		}
+		network = NetworkLocal
+	}
+
+	transport := NewTransport(network)
+	if name := dsn.GetOption("facility"); name != "" {
+		facility, ok := facilities[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("invalid DSN: Unsupported facility \"%s\". DSN: %s", name, dsn.GetOriginalDSN())
+		}
+		transport.SetFacility(facility)
+	}
+	if appName := dsn.GetOption("app_name"); appName != "" {
+		transport.SetAppName(appName)
+	}
+	if hostname := dsn.GetOption("hostname"); hostname != "" {
+		transport.SetHostname(hostname)
+	}
+	transport.SetSocketPath(dsn.GetOption("socket"))
+	transport.SetInsecureSkipVerify(dsn.GetBooleanOption("insecure_skip_verify"))
+
+	if network != NetworkLocal {
+		transport.SetHost(dsn.GetHost())
+		if port := dsn.GetPort(); port > 0 {
+			transport.SetPort(port)
+		}
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"syslog", "syslog+tcp", "syslog+tls"}
+}
@@ -0,0 +1,74 @@
+package syslog
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for syslog.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Severity overrides the severity derived from the message importance.
+func (o *Options) Severity(severity Severity) *Options {
+	o.options["severity"] = severity
+	return o
+}
+
+// Facility overrides the facility of the transport.
+func (o *Options) Facility(facility Facility) *Options {
+	o.options["facility"] = facility
+	return o
+}
+
+// AppName overrides the application name of the transport.
+func (o *Options) AppName(appName string) *Options {
+	o.options["app_name"] = appName
+	return o
+}
+
+// MsgID sets the RFC 5424 message ID identifying the type of message (e.g., "BACKUP").
+func (o *Options) MsgID(msgID string) *Options {
+	o.options["msg_id"] = msgID
+	return o
+}
+
+// Metadata adds a parameter to the structured data of remote messages.
+func (o *Options) Metadata(key, value string) *Options {
+	metadata, ok := o.options["metadata"].(map[string]string)
+	if !ok {
+		metadata = make(map[string]string)
+		o.options["metadata"] = metadata
+	}
+	metadata[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"severity": Severity(0),
+	"facility": Facility(0),
+	"metadata": map[string]string(nil),
+}
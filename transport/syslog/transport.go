@@ -0,0 +1,339 @@
+package syslog
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"maps"
+	"net"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Networks supported by the transport.
+const (
+	NetworkLocal = "local"
+	NetworkUDP   = "udp"
+	NetworkTCP   = "tcp"
+	NetworkTLS   = "tls"
+)
+
+// DefaultPort is the syslog port for UDP and TCP.
+const DefaultPort = 514
+
+// DefaultTLSPort is the syslog port for TLS (RFC 5425).
+const DefaultTLSPort = 6514
+
+// StructuredDataID is the SD-ID of the structured data element holding the message fields.
+// 32473 is the private enterprise number reserved for documentation (RFC 5612).
+const StructuredDataID = "notifier@32473"
+
+// Severity is a syslog severity level.
+type Severity int
+
+const (
+	SeverityEmergency Severity = iota
+	SeverityAlert
+	SeverityCritical
+	SeverityError
+	SeverityWarning
+	SeverityNotice
+	SeverityInformational
+	SeverityDebug
+)
+
+// Facility is a syslog facility.
+type Facility int
+
+const (
+	FacilityKern Facility = iota
+	FacilityUser
+	FacilityMail
+	FacilityDaemon
+	FacilityAuth
+	FacilitySyslog
+	FacilityLpr
+	FacilityNews
+	FacilityUucp
+	FacilityCron
+	FacilityAuthPriv
+	FacilityFtp
+	FacilityLocal0 Facility = iota + 4
+	FacilityLocal1
+	FacilityLocal2
+	FacilityLocal3
+	FacilityLocal4
+	FacilityLocal5
+	FacilityLocal6
+	FacilityLocal7
+)
+
+// DefaultSeverityMapping translates message importance into syslog severities.
+// Messages without importance are logged as informational.
+var DefaultSeverityMapping = map[string]Severity{
+	notifier.ImportanceUrgent: SeverityCritical,
+	notifier.ImportanceHigh:   SeverityError,
+	notifier.ImportanceMedium: SeverityWarning,
+	notifier.ImportanceLow:    SeverityNotice,
+}
+
+// localSockets are the usual paths of the local syslog socket on Linux, macOS and BSD.
+var localSockets = []string{"/dev/log", "/var/run/syslog", "/var/run/log"}
+
+// Transport writes messages to syslog, either to the local daemon or to a remote collector
+// in RFC 5424 format over UDP, TCP or TLS. The local daemon receives the traditional BSD format
+// (RFC 3164) most local daemons expect.
+type Transport struct {
+	*notifier.AbstractTransport
+	network            string
+	socketPath         string
+	facility           Facility
+	appName            string
+	hostname           string
+	severityMapping    map[string]Severity
+	insecureSkipVerify bool
+	now                func() time.Time
+}
+
+// NewTransport creates a new syslog transport for the network (NetworkLocal, NetworkUDP,
+// NetworkTCP or NetworkTLS). Remote collectors are configured with SetHost and SetPort.
+func NewTransport(network string) *Transport {
+	hostname, _ := os.Hostname()
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		network:           network,
+		facility:          FacilityUser,
+		appName:           "go-notifier",
+		hostname:          hostname,
+		severityMapping:   DefaultSeverityMapping,
+		now:               time.Now,
+	}
+}
+
+// SetFacility sets the facility messages are logged with (FacilityUser by default).
+func (t *Transport) SetFacility(facility Facility) *Transport {
+	t.facility = facility
+	return t
+}
+
+// SetAppName sets the application name (the tag of local messages).
+func (t *Transport) SetAppName(appName string) *Transport {
+	t.appName = appName
+	return t
+}
+
+// SetHostname overrides the host name sent with remote messages.
+func (t *Transport) SetHostname(hostname string) *Transport {
+	t.hostname = hostname
+	return t
+}
+
+// SetSocketPath sets the local syslog socket, found automatically if empty.
+func (t *Transport) SetSocketPath(path string) *Transport {
+	t.socketPath = path
+	return t
+}
+
+// SetSeverityMapping overrides the importance to severity mapping.
+func (t *Transport) SetSeverityMapping(mapping map[string]Severity) *Transport {
+	t.severityMapping = mapping
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed collectors.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	switch t.network {
+	case NetworkLocal:
+		return "syslog://default"
+	case NetworkUDP:
+		return fmt.Sprintf("syslog://%s", t.getAddress())
+	default:
+		return fmt.Sprintf("syslog+%s://%s", t.network, t.getAddress())
+	}
+}
+
+// Supports returns true for all message types.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("syslog").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	payload := notifier.NewPayload(message)
+
+	severity := SeverityInformational
+	if value, ok := t.severityMapping[payload.Importance]; ok {
+		severity = value
+	}
+	if value, ok := options["severity"].(Severity); ok {
+		severity = value
+	}
+	facility := t.facility
+	if value, ok := options["facility"].(Facility); ok {
+		facility = value
+	}
+	if severity < SeverityEmergency || severity > SeverityDebug || facility < FacilityKern || facility > FacilityLocal7 {
+		return nil, fmt.Errorf("syslog: invalid facility %d or severity %d", facility, severity)
+	}
+	appName := t.appName
+	if value, ok := options["app_name"].(string); ok && value != "" {
+		appName = value
+	}
+
+	text := payload.Subject
+	if payload.Content != "" {
+		text += "\n" + payload.Content
+	}
+
+	priority := int(facility)*8 + int(severity)
+	var line string
+	if t.network == NetworkLocal {
+		line = fmt.Sprintf("<%d>%s %s[%d]: %s", priority, t.now().Format(time.Stamp), appName, os.Getpid(), text)
+	} else {
+		params := map[string]string{
+			"type":      payload.Type,
+			"transport": payload.Transport,
+			"recipient": payload.Recipient,
+		}
+		if metadata, ok := options["metadata"].(map[string]string); ok {
+			maps.Copy(params, metadata)
+		}
+		msgID, _ := options["msg_id"].(string)
+		line = fmt.Sprintf("<%d>1 %s %s %s %d %s %s %s",
+			priority,
+			t.now().Format("2006-01-02T15:04:05.000000Z07:00"),
+			headerField(t.hostname, 255),
+			headerField(appName, 48),
+			os.Getpid(),
+			headerField(msgID, 32),
+			structuredData(params),
+			text,
+		)
+	}
+
+	conn, err := t.dial(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("syslog: connect: %w", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	stop := notifier.BindConnContext(ctx, conn)
+	defer stop()
+
+	frame := []byte(line)
+	if t.network == NetworkTCP || t.network == NetworkTLS {
+		// Octet counting framing (RFC 6587), messages may contain new lines
+		frame = append([]byte(strconv.Itoa(len(line))+" "), frame...)
+	}
+	if _, err := conn.Write(frame); err != nil {
+		return nil, fmt.Errorf("syslog: write: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("severity", int(severity))
+	sentMessage.SetInfo("facility", int(facility))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) dial(ctx context.Context) (net.Conn, error) {
+	var dialer net.Dialer
+	switch t.network {
+	case NetworkLocal:
+		paths := localSockets
+		if t.socketPath != "" {
+			paths = []string{t.socketPath}
+		}
+		var errs []error
+		for _, path := range paths {
+			for _, network := range []string{"unixgram", "unix"} {
+				conn, err := dialer.DialContext(ctx, network, path)
+				if err == nil {
+					return conn, nil
+				}
+				errs = append(errs, err)
+			}
+		}
+		return nil, errors.Join(errs...)
+	case NetworkUDP, NetworkTCP:
+		return dialer.DialContext(ctx, t.network, t.getAddress())
+	case NetworkTLS:
+		address := t.getAddress()
+		host, _, _ := net.SplitHostPort(address)
+		tlsDialer := &tls.Dialer{Config: &tls.Config{ServerName: host, InsecureSkipVerify: t.insecureSkipVerify}}
+		return tlsDialer.DialContext(ctx, "tcp", address)
+	default:
+		return nil, fmt.Errorf("unsupported network %q", t.network)
+	}
+}
+
+func (t *Transport) getAddress() string {
+	endpoint := t.GetEndpoint()
+	if _, _, err := net.SplitHostPort(endpoint); err != nil {
+		port := DefaultPort
+		if t.network == NetworkTLS {
+			port = DefaultTLSPort
+		}
+		return net.JoinHostPort(endpoint, strconv.Itoa(port))
+	}
+	return endpoint
+}
+
+// headerField returns the value as a RFC 5424 header field: printable ASCII without spaces,
+// truncated to the maximum length, or the nil value "-".
+func headerField(value string, maxLength int) string {
+	value = strings.Map(func(r rune) rune {
+		if r < 33 || r > 126 {
+			return -1
+		}
+		return r
+	}, value)
+	if len(value) > maxLength {
+		value = value[:maxLength]
+	}
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
+// structuredData formats the non-empty parameters as a single RFC 5424 structured data element.
+func structuredData(params map[string]string) string {
+	var builder strings.Builder
+	for _, name := range slices.Sorted(maps.Keys(params)) {
+		value := params[name]
+		name = strings.Map(func(r rune) rune {
+			if r < 33 || r > 126 || r == '=' || r == ']' || r == '"' {
+				return -1
+			}
+			return r
+		}, name)
+		if len(name) > 32 {
+			name = name[:32]
+		}
+		if name == "" || value == "" {
+			continue
+		}
+		value = strings.NewReplacer(`\`, `\\`, `"`, `\"`, `]`, `\]`).Replace(value)
+		fmt.Fprintf(&builder, ` %s="%s"`, name, value)
+	}
+	if builder.Len() == 0 {
+		return "-"
+	}
+	return "[" + StructuredDataID + builder.String() + "]"
+}
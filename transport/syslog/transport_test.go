@@ -0,0 +1,181 @@
+package syslog
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+var fixedTime = time.Date(2026, 1, 2, 3, 4, 5, 123456000, time.UTC)
+
+func TestTransportSendUDP(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	_, port, _ := net.SplitHostPort(conn.LocalAddr().String())
+	transport := NewTransport(NetworkUDP).SetHostname("web 1").SetAppName("billing").SetFacility(FacilityLocal0)
+	transport.SetHost("127.0.0.1:" + port)
+	transport.now = func() time.Time { return fixedTime }
+
+	message := notifier.NewChatMessage("Invoice failed").
+		Importance(notifier.ImportanceUrgent).
+		WithOptions("syslog", NewOptions().MsgID("INVOICE").Metadata("invoice", `4"2]`))
+	sent, err := transport.Send(context.Background(), message)
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	if sent.GetInfo("severity") != int(SeverityCritical) || sent.GetInfo("facility") != int(FacilityLocal0) {
+		t.Errorf("Unexpected info: %v", sent.GetInfo())
+	}
+
+	buf := make([]byte, 2048)
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("Failed to read: %v", err)
+	}
+	expected := fmt.Sprintf(`<130>1 2026-01-02T03:04:05.123456Z web1 billing %d INVOICE [notifier@32473 invoice="4\"2\]" type="chat"] Invoice failed`, os.Getpid())
+	if string(buf[:n]) != expected {
+		t.Errorf("Unexpected message:\n%s\nexpected:\n%s", buf[:n], expected)
+	}
+}
+
+func TestTransportSendTCP(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	defer func() { _ = listener.Close() }()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer func() { _ = conn.Close() }()
+		var length int
+		reader := bufio.NewReader(conn)
+		if _, err := fmt.Fscanf(reader, "%d ", &length); err != nil {
+			return
+		}
+		buf := make([]byte, length)
+		_, _ = reader.Read(buf)
+		received <- string(buf)
+	}()
+
+	transport := NewTransport(NetworkTCP)
+	transport.SetHost(listener.Addr().String())
+
+	message := notifier.NewPushMessage("Deploy", "Version 1.2 is live")
+	if _, err := transport.Send(context.Background(), message); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	select {
+	case line := <-received:
+		if !strings.HasPrefix(line, "<14>1 ") || !strings.HasSuffix(line, `[notifier@32473 type="push"] Deploy`+"\nVersion 1.2 is live") {
+			t.Errorf("Unexpected message: %q", line)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("No message received")
+	}
+}
+
+func TestTransportSendLocal(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "log")
+	conn, err := net.ListenPacket("unixgram", path)
+	if err != nil {
+		t.Skipf("Unix datagram sockets not supported: %v", err)
+	}
+	defer func() { _ = conn.Close() }()
+
+	transport := NewTransport(NetworkLocal).SetSocketPath(path)
+	transport.now = func() time.Time { return fixedTime }
+
+	message := notifier.NewChatMessage("Backup finished").Importance(notifier.ImportanceLow)
+	if _, err := transport.Send(context.Background(), message); err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	buf := make([]byte, 2048)
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, _, err := conn.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("Failed to read: %v", err)
+	}
+	expected := fmt.Sprintf("<13>Jan  2 03:04:05 go-notifier[%d]: Backup finished", os.Getpid())
+	if string(buf[:n]) != expected {
+		t.Errorf("Unexpected message: %q", buf[:n])
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	transport := NewTransport(NetworkLocal).SetSocketPath(filepath.Join(t.TempDir(), "missing"))
+	if _, err := transport.Send(context.Background(), notifier.NewChatMessage("Hello")); err == nil || !strings.Contains(err.Error(), "syslog: connect") {
+		t.Errorf("Expected connect error, got %v", err)
+	}
+
+	message := notifier.NewChatMessage("Hello").WithOptions("syslog", NewOptions().Severity(Severity(9)))
+	if _, err := transport.Send(context.Background(), message); err == nil || !strings.Contains(err.Error(), "invalid facility") {
+		t.Errorf("Expected invalid severity error, got %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory()
+
+	tests := []struct {
+		dsn      string
+		expected string
+		network  string
+	}{
+		{"syslog://default", "syslog://default", NetworkLocal},
+		{"syslog://logs.example.com?facility=local3&app_name=billing", "syslog://logs.example.com:514", NetworkUDP},
+		{"syslog+tcp://logs.example.com:1514", "syslog+tcp://logs.example.com:1514", NetworkTCP},
+		{"syslog+tls://logs.example.com", "syslog+tls://logs.example.com:6514", NetworkTLS},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support %s", tt.dsn)
+		}
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+		if transport.String() != tt.expected || transport.(*Transport).network != tt.network {
+			t.Errorf("Expected %s, got %s", tt.expected, transport.String())
+		}
+	}
+
+	dsn, _ := notifier.NewDSN("syslog://default?facility=local3&app_name=billing")
+	transport, _ := factory.Create(dsn)
+	if transport.(*Transport).facility != FacilityLocal3 || transport.(*Transport).appName != "billing" {
+		t.Errorf("Unexpected transport: %+v", transport)
+	}
+
+	invalid := []string{
+		"syslog://default?facility=unknown",
+		"syslog+tls://default",
+		"syslog+udp://logs.example.com",
+	}
+	for _, value := range invalid {
+		dsn, _ := notifier.NewDSN(value)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for %s", value)
+		}
+	}
+}
@@ -10,6 +10,11 @@ import (
 
 func init() {
 	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("telegram", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
 }
 
 // TransportFactory creates Telegram transports from DSN.
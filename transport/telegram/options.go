@@ -227,6 +227,16 @@ func (o *Options) MarshalJSON() ([]byte, error) {
 	return json.Marshal(o.options)
 }
 
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"reply_to_message_id": 0,
+	"message_thread_id":   0,
+	"message_id":          0,
+	"upload":              map[string]string(nil),
+	"location":            map[string]float64(nil),
+	"contact":             map[string]string(nil),
+}
+
 // ReplyMarkup represents a Telegram reply markup.
 type ReplyMarkup interface {
 	ToMap() map[string]any
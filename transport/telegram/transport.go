@@ -11,17 +11,27 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/shyim/go-notifier"
 )
 
 const optionCaption = "caption"
 
+// DefaultEmojiMapping translates message importance into an emoji prefixed to the text.
+var DefaultEmojiMapping = map[string]string{
+	notifier.ImportanceUrgent: "🚨",
+	notifier.ImportanceHigh:   "❗",
+	notifier.ImportanceMedium: "⚠️",
+	notifier.ImportanceLow:    "ℹ️",
+}
+
 // Transport sends messages via Telegram Bot API.
 type Transport struct {
 	*notifier.AbstractTransport
-	token       string
-	chatChannel string
+	token        string
+	chatChannel  string
+	emojiMapping map[string]string
 }
 
 // NewTransport creates a new Telegram transport.
@@ -33,9 +43,16 @@ func NewTransport(token, chatChannel string, client *http.Client) *Transport {
 		AbstractTransport: notifier.NewAbstractTransport(client),
 		token:             token,
 		chatChannel:       chatChannel,
+		emojiMapping:      DefaultEmojiMapping,
 	}
 }
 
+// SetEmojiMapping overrides the importance to emoji prefix mapping.
+func (t *Transport) SetEmojiMapping(mapping map[string]string) *Transport {
+	t.emojiMapping = mapping
+	return t
+}
+
 func (t *Transport) String() string {
 	endpoint := t.getEndpoint()
 	if endpoint == "" {
@@ -73,6 +90,7 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 	// Remove recipient_id as it's not a Telegram API parameter
 	delete(options, "recipient_id")
 	text := chatMsg.GetSubject()
+	content := chatMsg.GetContent()
 
 	// Handle parse mode and markdown escaping
 	parseMode, hasParseMode := options["parse_mode"].(string)
@@ -80,6 +98,24 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 		options["parse_mode"] = "MarkdownV2"
 		// Escape special characters for MarkdownV2
 		text = escapeMarkdownV2(text)
+		content = escapeMarkdownV2(content)
+		parseMode = "MarkdownV2"
+	}
+
+	// The subject becomes a bold first line above the content
+	if content != "" {
+		switch parseMode {
+		case "MarkdownV2", "Markdown":
+			text = "*" + text + "*\n" + content
+		case "HTML":
+			text = "<b>" + text + "</b>\n" + content
+		default:
+			text += "\n" + content
+		}
+	}
+
+	if emoji, ok := t.emojiMapping[chatMsg.GetImportance()]; ok && emoji != "" {
+		text = emoji + " " + text
 	}
 
 	// Handle file uploads
@@ -137,7 +173,7 @@ func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface)
 			delete(filteredOptions, "contact")
 		}
 
-		jsonBody, err := json.Marshal(filteredOptions)
+		jsonBody, err := notifier.JSONMarshal(filteredOptions)
 		if err != nil {
 			return nil, fmt.Errorf("telegram: marshal options: %w", err)
 		}
@@ -162,12 +198,17 @@ func (t *Transport) doRequest(ctx context.Context, endpoint string, body io.Read
 	}
 	req.Header.Set("Content-Type", contentType)
 
-	resp, err := t.AbstractTransport.GetClient().Do(req)
+	resp, err := t.AbstractTransport.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("telegram: send request: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
 
+	if resp.StatusCode == http.StatusTooManyRequests {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, rateLimitError(resp, respBody)
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		respBody, _ := io.ReadAll(resp.Body)
 		return nil, fmt.Errorf("telegram: API error (status %d): %s", resp.StatusCode, string(respBody))
@@ -180,11 +221,13 @@ func (t *Transport) doRequest(ctx context.Context, endpoint string, body io.Read
 		} `json:"result"`
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
 		return nil, fmt.Errorf("telegram: decode response: %w", err)
 	}
 
 	sentMessage := notifier.NewSentMessage(originalMessage, t.String())
+	t.SetRawResponse(sentMessage, raw)
 	if result.Result.MessageID != 0 {
 		sentMessage.SetMessageID(fmt.Sprintf("%d", result.Result.MessageID))
 	}
@@ -220,7 +263,7 @@ func (t *Transport) createMultipartBody(options map[string]any, upload map[strin
 		case float64:
 			err = writer.WriteField(k, fmt.Sprintf("%f", val))
 		case map[string]any:
-			jsonVal, jsonErr := json.Marshal(val)
+			jsonVal, jsonErr := notifier.JSONMarshal(val)
 			if jsonErr != nil {
 				return nil, "", fmt.Errorf("marshal field %s: %w", k, jsonErr)
 			}
@@ -343,3 +386,28 @@ func escapeMarkdownV2(text string) string {
 	}
 	return text
 }
+
+// rateLimitError converts a 429 response into a RateLimitError. Telegram sends the delay in
+// seconds as parameters.retry_after, the Retry-After header is used if it is missing.
+func rateLimitError(resp *http.Response, respBody []byte) *notifier.RateLimitError {
+	var result struct {
+		Description string `json:"description"`
+		Parameters  struct {
+			RetryAfter int `json:"retry_after"`
+		} `json:"parameters"`
+	}
+	err := &notifier.RateLimitError{
+		Transport:  "telegram",
+		RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+		Message:    string(respBody),
+	}
+	if json.Unmarshal(respBody, &result) == nil {
+		if result.Parameters.RetryAfter > 0 {
+			err.RetryAfter = time.Duration(result.Parameters.RetryAfter) * time.Second
+		}
+		if result.Description != "" {
+			err.Message = result.Description
+		}
+	}
+	return err
+}
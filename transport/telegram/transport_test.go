@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/shyim/go-notifier"
 )
@@ -300,6 +301,31 @@ func TestSendMessage_Success(t *testing.T) {
 	}
 }
 
+func TestSendMessage_ContextHTTPClient(t *testing.T) {
+	defaultClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		t.Error("Expected the client from the context to be used")
+		return nil, errors.New("unexpected request")
+	})
+	contextClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":true,"result":{"message_id":7}}`)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	transport := NewTransport("123:abc", "-100123", defaultClient)
+	ctx := notifier.WithHTTPClient(context.Background(), contextClient)
+
+	sent, err := transport.Send(ctx, notifier.NewChatMessage("Hello"))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if sent.GetMessageID() != "7" {
+		t.Errorf("Expected message ID 7, got %s", sent.GetMessageID())
+	}
+}
+
 func TestSendMessage_EditMessage(t *testing.T) {
 	var capturedRequest *http.Request
 	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
@@ -384,12 +410,6 @@ func TestSendMessage_HTTPErrors(t *testing.T) {
 			responseBody:  `{"ok":false,"description":"Not Found"}`,
 			expectedError: "telegram: API error (status 404)",
 		},
-		{
-			name:          "429 Too Many Requests",
-			statusCode:    http.StatusTooManyRequests,
-			responseBody:  `{"ok":false,"description":"Too Many Requests: retry after 30"}`,
-			expectedError: "telegram: API error (status 429)",
-		},
 		{
 			name:          "500 Internal Server Error",
 			statusCode:    http.StatusInternalServerError,
@@ -423,6 +443,63 @@ func TestSendMessage_HTTPErrors(t *testing.T) {
 	}
 }
 
+func TestSendMessage_RateLimited(t *testing.T) {
+	tests := []struct {
+		name               string
+		retryAfterHeader   string
+		responseBody       string
+		expectedRetryAfter time.Duration
+		expectedMessage    string
+	}{
+		{
+			name:               "retry_after parameter",
+			retryAfterHeader:   "5",
+			responseBody:       `{"ok":false,"error_code":429,"description":"Too Many Requests: retry after 30","parameters":{"retry_after":30}}`,
+			expectedRetryAfter: 30 * time.Second,
+			expectedMessage:    "Too Many Requests: retry after 30",
+		},
+		{
+			name:               "Retry-After header",
+			retryAfterHeader:   "5",
+			responseBody:       `{"ok":false,"description":"Too Many Requests"}`,
+			expectedRetryAfter: 5 * time.Second,
+			expectedMessage:    "Too Many Requests",
+		},
+		{
+			name:            "no delay",
+			responseBody:    `Too Many Requests`,
+			expectedMessage: "Too Many Requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				header := make(http.Header)
+				if tt.retryAfterHeader != "" {
+					header.Set("Retry-After", tt.retryAfterHeader)
+				}
+				return &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Body:       io.NopCloser(strings.NewReader(tt.responseBody)),
+					Header:     header,
+				}, nil
+			})
+
+			transport := NewTransport("123:abc", "-100123", mockClient)
+			_, err := transport.Send(context.Background(), notifier.NewChatMessage("Test"))
+
+			var rateLimitErr *notifier.RateLimitError
+			if !errors.As(err, &rateLimitErr) {
+				t.Fatalf("Expected RateLimitError, got: %v", err)
+			}
+			if rateLimitErr.Transport != "telegram" || rateLimitErr.RetryAfter != tt.expectedRetryAfter || rateLimitErr.Message != tt.expectedMessage {
+				t.Errorf("Unexpected RateLimitError: %+v", rateLimitErr)
+			}
+		})
+	}
+}
+
 func TestSendMessage_NetworkError(t *testing.T) {
 	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
 		return nil, errors.New("network connection failed")
@@ -538,6 +615,74 @@ func TestSendMessage_WithHTMLParseMode(t *testing.T) {
 	}
 }
 
+func TestSendMessage_Content(t *testing.T) {
+	tests := []struct {
+		name      string
+		parseMode string
+		expected  string
+	}{
+		{"markdownv2", "", "*Build \\#42*\nFailed in step\\.test"},
+		{"html", "HTML", "<b>Build #42</b>\nFailed in step.test"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var capturedBody map[string]any
+			mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+				bodyBytes, _ := io.ReadAll(req.Body)
+				json.Unmarshal(bodyBytes, &capturedBody)
+
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"ok":true,"result":{"message_id":1}}`)),
+					Header:     make(http.Header),
+				}, nil
+			})
+
+			transport := NewTransport("123:abc", "-100123", mockClient)
+			msg := notifier.NewChatMessage("Build #42").Content("Failed in step.test")
+			if tt.parseMode != "" {
+				msg.WithOptions("telegram", NewOptions().ParseMode(tt.parseMode))
+			}
+
+			if _, err := transport.Send(context.Background(), msg); err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+
+			if capturedBody["text"] != tt.expected {
+				t.Errorf("Expected text %q, got %q", tt.expected, capturedBody["text"])
+			}
+		})
+	}
+}
+
+func TestSendMessage_ImportanceEmoji(t *testing.T) {
+	var capturedBody map[string]any
+	mockClient := newMockClient(func(req *http.Request) (*http.Response, error) {
+		bodyBytes, _ := io.ReadAll(req.Body)
+		json.Unmarshal(bodyBytes, &capturedBody)
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"ok":true,"result":{"message_id":1}}`)),
+			Header:     make(http.Header),
+		}, nil
+	})
+
+	transport := NewTransport("123:abc", "-100123", mockClient)
+	msg := notifier.NewChatMessage("Database down").
+		Importance(notifier.ImportanceUrgent).
+		WithOptions("telegram", NewOptions().ParseMode("HTML"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if capturedBody["text"] != "🚨 Database down" {
+		t.Errorf("Expected emoji prefix, got %q", capturedBody["text"])
+	}
+}
+
 func TestSendMessage_FileUpload_MultipartFormData(t *testing.T) {
 	// Create a temporary test file
 	tmpDir := t.TempDir()
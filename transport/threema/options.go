@@ -0,0 +1,50 @@
+package threema
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Threema.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["to"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// To sends the chat message to the given Threema ID instead of the configured one.
+func (o *Options) To(threemaID string) *Options {
+	o.options["to"] = threemaID
+	return o
+}
+
+// Phone sends the chat message to the Threema user linked to the phone number.
+func (o *Options) Phone(phone string) *Options {
+	o.options["phone"] = phone
+	return o
+}
+
+// Email sends the chat message to the Threema user linked to the email address.
+func (o *Options) Email(email string) *Options {
+	o.options["email"] = email
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
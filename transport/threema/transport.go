@@ -0,0 +1,157 @@
+package threema
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// maxTextBytes is the maximum text length of basic mode messages.
+const maxTextBytes = 3500
+
+// errorMessages describes the status codes of the send_simple endpoint.
+var errorMessages = map[int]string{
+	http.StatusBadRequest:            "invalid recipient or not in basic mode",
+	http.StatusUnauthorized:          "invalid gateway ID or secret",
+	http.StatusPaymentRequired:       "no credits remain",
+	http.StatusNotFound:              "recipient phone number or email not found",
+	http.StatusRequestEntityTooLarge: "message is too long",
+}
+
+// Transport sends text messages via the Threema Gateway in basic mode,
+// where the gateway encrypts messages on behalf of the sender.
+//
+// Chat messages are sent to a Threema ID, SMS messages to the Threema user linked to the phone number.
+type Transport struct {
+	*notifier.AbstractTransport
+	gatewayID string
+	secret    string
+	to        string
+}
+
+// NewTransport creates a new Threema Gateway transport. The gateway ID starts with "*";
+// to is the default Threema ID of chat message recipients.
+func NewTransport(gatewayID, secret, to string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		gatewayID:         gatewayID,
+		secret:            secret,
+		to:                to,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.to == "" {
+		return fmt.Sprintf("threema://%s?from=%s", t.getEndpoint(), t.gatewayID)
+	}
+	return fmt.Sprintf("threema://%s?from=%s&to=%s", t.getEndpoint(), t.gatewayID, t.to)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	switch message.(type) {
+	case *notifier.ChatMessage, *notifier.SmsMessage:
+		return true
+	}
+	return false
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	form := url.Values{}
+	form.Set("from", t.gatewayID)
+	form.Set("secret", t.secret)
+
+	switch m := message.(type) {
+	case *notifier.ChatMessage:
+		options := make(map[string]any)
+		if opts, ok := m.GetOptions("threema").(*Options); ok {
+			options = opts.ToMap()
+		}
+		email, _ := options["email"].(string)
+		phone, _ := options["phone"].(string)
+		to, _ := options["to"].(string)
+		if to == "" {
+			to = t.to
+		}
+		switch {
+		case email != "":
+			form.Set("email", email)
+		case phone != "":
+			form.Set("phone", normalizePhone(phone))
+		case to != "":
+			form.Set("to", to)
+		default:
+			return nil, fmt.Errorf("threema: no recipient, set a Threema ID in the DSN or options")
+		}
+	case *notifier.SmsMessage:
+		form.Set("phone", normalizePhone(m.GetPhone()))
+	default:
+		return nil, fmt.Errorf("threema: unsupported message type %T, expected ChatMessage or SmsMessage", message)
+	}
+
+	text := message.GetSubject()
+	if chatMsg, ok := message.(*notifier.ChatMessage); ok {
+		text = chatMsg.GetText()
+	}
+	if len(text) > maxTextBytes {
+		return nil, fmt.Errorf("threema: message is %d bytes long, the maximum is %d", len(text), maxTextBytes)
+	}
+	form.Set("text", text)
+
+	endpoint := fmt.Sprintf("https://%s/send_simple", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("threema: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("threema: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &notifier.RateLimitError{
+			Transport:  "threema",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if description, ok := errorMessages[resp.StatusCode]; ok {
+			return nil, fmt.Errorf("threema: API error (status %d): %s", resp.StatusCode, description)
+		}
+		return nil, fmt.Errorf("threema: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	// The response body is the message ID
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetMessageID(strings.TrimSpace(string(respBody)))
+
+	return sentMessage, nil
+}
+
+// normalizePhone converts a phone number to the E.164 format without "+" expected by the gateway.
+func normalizePhone(phone string) string {
+	return strings.TrimPrefix(strings.NewReplacer(" ", "", "-", "").Replace(phone), "+")
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "msgapi.threema.ch"
+	}
+	return endpoint
+}
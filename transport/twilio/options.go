@@ -0,0 +1,64 @@
+package twilio
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Twilio SMS.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// MessagingServiceSID sends the message through a messaging service, which picks the sender
+// from its number pool instead of the from number.
+func (o *Options) MessagingServiceSID(sid string) *Options {
+	o.options["MessagingServiceSid"] = sid
+	return o
+}
+
+// StatusCallback sets the URL Twilio posts status changes (e.g., delivered, failed) to.
+func (o *Options) StatusCallback(url string) *Options {
+	o.options["StatusCallback"] = url
+	return o
+}
+
+// MediaURL attaches media to the message, sending it as MMS (up to 10 URLs).
+func (o *Options) MediaURL(urls ...string) *Options {
+	existing, _ := o.options["MediaUrl"].([]string)
+	o.options["MediaUrl"] = append(existing, urls...)
+	return o
+}
+
+// ValidityPeriod sets how many seconds the message may wait in the queue before it is dropped.
+func (o *Options) ValidityPeriod(seconds int) *Options {
+	o.options["ValidityPeriod"] = seconds
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"MediaUrl":       []string(nil),
+	"ValidityPeriod": 0,
+}
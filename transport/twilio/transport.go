@@ -0,0 +1,141 @@
+package twilio
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// errorTooManyRequests is the error code returned when the account exceeds its concurrency limit.
+const errorTooManyRequests = 20429
+
+// Transport sends SMS via the Twilio Messages API.
+type Transport struct {
+	*notifier.AbstractTransport
+	accountSID string
+	authToken  string
+	from       string
+}
+
+// NewTransport creates a new Twilio transport. from is the default sender (phone number in
+// E.164 format or alphanumeric sender ID).
+func NewTransport(accountSID, authToken, from string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		accountSID:        accountSID,
+		authToken:         authToken,
+		from:              from,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("twilio://%s?from=%s", t.getEndpoint(), t.from)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.SmsMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	smsMsg, ok := message.(*notifier.SmsMessage)
+	if !ok {
+		return nil, fmt.Errorf("twilio: unsupported message type %T, expected SmsMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := smsMsg.GetOptions("twilio").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	from := smsMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+
+	form := url.Values{}
+	form.Set("To", smsMsg.GetPhone())
+	form.Set("Body", smsMsg.GetSubject())
+	if from != "" {
+		form.Set("From", from)
+	}
+	for key, value := range options {
+		if values, ok := value.([]string); ok {
+			form[key] = values
+			continue
+		}
+		form.Set(key, fmt.Sprint(value))
+	}
+	if !form.Has("From") && !form.Has("MessagingServiceSid") {
+		return nil, fmt.Errorf("twilio: missing sender, set a from number or messaging service")
+	}
+
+	endpoint := fmt.Sprintf("https://%s/2010-04-01/Accounts/%s/Messages.json", t.getEndpoint(), url.PathEscape(t.accountSID))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("twilio: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(t.accountSID, t.authToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("twilio: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Message != "" {
+			errMessage = fmt.Sprintf("%d: %s", apiResp.Code, apiResp.Message)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests || apiResp.Code == errorTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "twilio",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("twilio: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		SID         string `json:"sid"`
+		Status      string `json:"status"`
+		NumSegments string `json:"num_segments"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("twilio: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.SID)
+	sentMessage.SetInfo("status", result.Status)
+	sentMessage.SetInfo("num_segments", result.NumSegments)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.twilio.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,90 @@
+package twitter
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// credentials are the OAuth 1.0a user context credentials of an app and the posting account.
+type credentials struct {
+	consumerKey       string
+	consumerSecret    string
+	accessToken       string
+	accessTokenSecret string
+}
+
+// authorization returns the OAuth 1.0a Authorization header of a request. Form parameters are
+// part of the signature, JSON and multipart bodies are not.
+func (c credentials) authorization(method, rawURL string, form url.Values, nonce string, timestamp int64) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     c.consumerKey,
+		"oauth_nonce":            nonce,
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(timestamp, 10),
+		"oauth_token":            c.accessToken,
+		"oauth_version":          "1.0",
+	}
+
+	params := make([]string, 0)
+	for key, value := range oauthParams {
+		params = append(params, percentEncode(key)+"="+percentEncode(value))
+	}
+	for _, values := range []url.Values{u.Query(), form} {
+		for key, list := range values {
+			for _, value := range list {
+				params = append(params, percentEncode(key)+"="+percentEncode(value))
+			}
+		}
+	}
+	sort.Strings(params)
+
+	baseURL := strings.ToLower(u.Scheme) + "://" + strings.ToLower(u.Host) + u.EscapedPath()
+	base := strings.ToUpper(method) + "&" + percentEncode(baseURL) + "&" + percentEncode(strings.Join(params, "&"))
+
+	mac := hmac.New(sha1.New, []byte(percentEncode(c.consumerSecret)+"&"+percentEncode(c.accessTokenSecret)))
+	mac.Write([]byte(base))
+	oauthParams["oauth_signature"] = base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	keys := make([]string, 0, len(oauthParams))
+	for key := range oauthParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	header := make([]string, len(keys))
+	for i, key := range keys {
+		header[i] = fmt.Sprintf(`%s="%s"`, percentEncode(key), percentEncode(oauthParams[key]))
+	}
+	return "OAuth " + strings.Join(header, ", "), nil
+}
+
+// percentEncode encodes all characters except the RFC 3986 unreserved ones.
+func percentEncode(value string) string {
+	var b strings.Builder
+	for _, c := range []byte(value) {
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') || c == '-' || c == '.' || c == '_' || c == '~' {
+			b.WriteByte(c)
+		} else {
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func newNonce() string {
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+	return hex.EncodeToString(nonce)
+}
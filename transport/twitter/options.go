@@ -0,0 +1,80 @@
+package twitter
+
+import (
+	"encoding/json"
+)
+
+// Reply settings restricting who can reply to a post.
+const (
+	ReplySettingsFollowing      = "following"
+	ReplySettingsMentionedUsers = "mentionedUsers"
+	ReplySettingsSubscribers    = "subscribers"
+)
+
+// Options implements MessageOptionsInterface for X.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// ReplyTo posts the tweet as a reply to the tweet ID, e.g. to build a thread of updates
+// from the message ID of the previous send.
+func (o *Options) ReplyTo(tweetID string) *Options {
+	o.options["in_reply_to_tweet_id"] = tweetID
+	return o
+}
+
+// Quote quotes the tweet with the ID.
+func (o *Options) Quote(tweetID string) *Options {
+	o.options["quote_tweet_id"] = tweetID
+	return o
+}
+
+// Media uploads a local image file (at most 5 MB) and attaches it to the tweet. At most 4
+// images can be attached. Videos and GIFs need a chunked upload, attach them with MediaIDs.
+func (o *Options) Media(path string) *Options {
+	media, _ := o.options["media"].([]string)
+	o.options["media"] = append(media, path)
+	return o
+}
+
+// MediaIDs attaches media that was already uploaded.
+func (o *Options) MediaIDs(ids ...string) *Options {
+	mediaIDs, _ := o.options["media_ids"].([]string)
+	o.options["media_ids"] = append(mediaIDs, ids...)
+	return o
+}
+
+// ReplySettings restricts who can reply (ReplySettingsFollowing, ReplySettingsMentionedUsers
+// or ReplySettingsSubscribers). Everyone can reply by default.
+func (o *Options) ReplySettings(settings string) *Options {
+	o.options["reply_settings"] = settings
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"media":     []string(nil),
+	"media_ids": []string(nil),
+}
@@ -0,0 +1,265 @@
+package twitter
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts tweets via the X API v2 with OAuth 1.0a user context credentials.
+type Transport struct {
+	*notifier.AbstractTransport
+	credentials credentials
+	now         func() time.Time
+	nonce       func() string
+}
+
+// NewTransport creates a new X transport. The consumer key and secret identify the app, the
+// access token and secret the account posting the tweets.
+func NewTransport(consumerKey, consumerSecret, accessToken, accessTokenSecret string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		credentials: credentials{
+			consumerKey:       consumerKey,
+			consumerSecret:    consumerSecret,
+			accessToken:       accessToken,
+			accessTokenSecret: accessTokenSecret,
+		},
+		now:   time.Now,
+		nonce: newNonce,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("twitter://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("twitter: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("twitter").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	mediaIDs, _ := options["media_ids"].([]string)
+	mediaIDs = append([]string(nil), mediaIDs...)
+	text := chatMsg.GetText()
+	media, _ := options["media"].([]string)
+	if text == "" && len(mediaIDs) == 0 && len(media) == 0 {
+		return nil, fmt.Errorf("twitter: message has no content")
+	}
+
+	for _, path := range media {
+		id, err := t.uploadMedia(ctx, path)
+		if err != nil {
+			return nil, fmt.Errorf("twitter: upload media %s: %w", path, err)
+		}
+		mediaIDs = append(mediaIDs, id)
+	}
+
+	payload := make(map[string]any)
+	if text != "" {
+		payload["text"] = text
+	}
+	if len(mediaIDs) > 0 {
+		payload["media"] = map[string]any{"media_ids": mediaIDs}
+	}
+	if replyTo, ok := options["in_reply_to_tweet_id"].(string); ok && replyTo != "" {
+		payload["reply"] = map[string]any{"in_reply_to_tweet_id": replyTo}
+	}
+	for _, key := range []string{"quote_tweet_id", "reply_settings"} {
+		if value, ok := options[key]; ok {
+			payload[key] = value
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("twitter: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/2/tweets", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("twitter: create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	var result struct {
+		Data struct {
+			ID   string `json:"id"`
+			Text string `json:"text"`
+		} `json:"data"`
+	}
+
+	raw, err := t.do(req, &result)
+	if err != nil {
+		return nil, fmt.Errorf("twitter: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.Data.ID)
+
+	return sentMessage, nil
+}
+
+// uploadMedia uploads an image and returns its media ID.
+func (t *Transport) uploadMedia(ctx context.Context, path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+	if err := writer.WriteField("media_category", "tweet_image"); err != nil {
+		return "", fmt.Errorf("write field media_category: %w", err)
+	}
+
+	mediaType := mime.TypeByExtension(filepath.Ext(path))
+	if mediaType == "" {
+		mediaType = "application/octet-stream"
+	}
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="media"; filename="%s"`, quoteEscaper.Replace(filepath.Base(path))))
+	header.Set("Content-Type", mediaType)
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return "", fmt.Errorf("create form file: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return "", fmt.Errorf("copy file: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return "", fmt.Errorf("close multipart writer: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/2/media/upload", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, &body)
+	if err != nil {
+		return "", fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	var result struct {
+		Data struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if _, err := t.do(req, &result); err != nil {
+		return "", err
+	}
+	return result.Data.ID, nil
+}
+
+// do signs and sends the request and decodes the response into result.
+func (t *Transport) do(req *http.Request, result any) (any, error) {
+	authorization, err := t.credentials.authorization(req.Method, req.URL.String(), nil, t.nonce(), t.now().Unix())
+	if err != nil {
+		return nil, fmt.Errorf("sign request: %w", err)
+	}
+	req.Header.Set("Authorization", authorization)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		respBody, _ := io.ReadAll(resp.Body)
+		errMessage := apiErrorMessage(respBody)
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "twitter",
+				RetryAfter: retryAfter(resp.Header.Get("X-Rate-Limit-Reset")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, result)
+	if err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return raw, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "api.x.com"
+	}
+	return endpoint
+}
+
+// apiErrorMessage returns the detail of a problem response, or the messages of an error list.
+func apiErrorMessage(body []byte) string {
+	var apiResp struct {
+		Title  string `json:"title"`
+		Detail string `json:"detail"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(body, &apiResp) != nil {
+		return string(body)
+	}
+	if apiResp.Detail != "" {
+		return apiResp.Detail
+	}
+	messages := make([]string, 0, len(apiResp.Errors))
+	for _, e := range apiResp.Errors {
+		messages = append(messages, e.Message)
+	}
+	if len(messages) > 0 {
+		return strings.Join(messages, "; ")
+	}
+	if apiResp.Title != "" {
+		return apiResp.Title
+	}
+	return string(body)
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// retryAfter returns the time until the rate limit resets at the given unix time.
+func retryAfter(reset string) time.Duration {
+	seconds, err := strconv.ParseInt(reset, 10, 64)
+	if err != nil {
+		return 0
+	}
+	if wait := time.Until(time.Unix(seconds, 0)); wait > 0 {
+		return wait
+	}
+	return 0
+}
@@ -0,0 +1,194 @@
+package viber
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Viber.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the Viber user ID of the subscriber, overriding the transport's.
+func (o *Options) Recipient(id string) *Options {
+	o.options["recipient_id"] = id
+	return o
+}
+
+// Sender overrides the sender name (at most 28 characters) and avatar of the transport.
+func (o *Options) Sender(name, avatar string) *Options {
+	sender := map[string]any{"name": name}
+	if avatar != "" {
+		sender["avatar"] = avatar
+	}
+	o.options["sender"] = sender
+	return o
+}
+
+// Picture sends a picture message with the message text as caption. The thumbnail is optional.
+func (o *Options) Picture(mediaURL, thumbnailURL string) *Options {
+	o.options["type"] = "picture"
+	o.options["media"] = mediaURL
+	if thumbnailURL != "" {
+		o.options["thumbnail"] = thumbnailURL
+	}
+	return o
+}
+
+// Keyboard shows the keyboard below the message.
+func (o *Options) Keyboard(keyboard *Keyboard) *Options {
+	o.options["keyboard"] = keyboard.ToMap()
+	return o
+}
+
+// TrackingData is sent back with the user's reply in the callback.
+func (o *Options) TrackingData(data string) *Options {
+	o.options["tracking_data"] = data
+	return o
+}
+
+// MinAPIVersion restricts the message to clients supporting the API version.
+func (o *Options) MinAPIVersion(version int) *Options {
+	o.options["min_api_version"] = version
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"min_api_version": 0,
+}
+
+// Keyboard represents a Viber keyboard of up to 24 buttons, laid out in rows
+// of six columns.
+type Keyboard struct {
+	buttons       []Button
+	defaultHeight bool
+	bgColor       string
+}
+
+func NewKeyboard(buttons ...Button) *Keyboard {
+	return &Keyboard{
+		buttons: buttons,
+	}
+}
+
+func (k *Keyboard) AddButton(buttons ...Button) *Keyboard {
+	k.buttons = append(k.buttons, buttons...)
+	return k
+}
+
+// DefaultHeight shows the keyboard at its default height instead of fitting the buttons.
+func (k *Keyboard) DefaultHeight(defaultHeight bool) *Keyboard {
+	k.defaultHeight = defaultHeight
+	return k
+}
+
+// BgColor sets the background color as hex value, e.g. "#FFFFFF".
+func (k *Keyboard) BgColor(color string) *Keyboard {
+	k.bgColor = color
+	return k
+}
+
+func (k *Keyboard) ToMap() map[string]any {
+	buttons := make([]map[string]any, len(k.buttons))
+	for i, btn := range k.buttons {
+		buttons[i] = btn.ToMap()
+	}
+	m := map[string]any{
+		"Type":    "keyboard",
+		"Buttons": buttons,
+	}
+	if k.defaultHeight {
+		m["DefaultHeight"] = true
+	}
+	if k.bgColor != "" {
+		m["BgColor"] = k.bgColor
+	}
+	return m
+}
+
+// Button represents a button in a Viber keyboard.
+type Button struct {
+	actionType string
+	actionBody string
+	text       string
+	columns    int
+	rows       int
+	bgColor    string
+}
+
+// NewReplyButton creates a button that sends the action body as reply to the bot.
+func NewReplyButton(text, actionBody string) Button {
+	return Button{
+		actionType: "reply",
+		actionBody: actionBody,
+		text:       text,
+	}
+}
+
+// NewOpenURLButton creates a button that opens the URL.
+func NewOpenURLButton(text, url string) Button {
+	return Button{
+		actionType: "open-url",
+		actionBody: url,
+		text:       text,
+	}
+}
+
+// Columns sets the button width from 1 to 6 columns (6 by default).
+func (b Button) Columns(columns int) Button {
+	b.columns = columns
+	return b
+}
+
+// Rows sets the button height from 1 to 2 rows (1 by default).
+func (b Button) Rows(rows int) Button {
+	b.rows = rows
+	return b
+}
+
+// BgColor sets the background color as hex value, e.g. "#2DB9B9".
+func (b Button) BgColor(color string) Button {
+	b.bgColor = color
+	return b
+}
+
+func (b Button) ToMap() map[string]any {
+	m := map[string]any{
+		"ActionType": b.actionType,
+		"ActionBody": b.actionBody,
+		"Text":       b.text,
+	}
+	if b.columns > 0 {
+		m["Columns"] = b.columns
+	}
+	if b.rows > 0 {
+		m["Rows"] = b.rows
+	}
+	if b.bgColor != "" {
+		m["BgColor"] = b.bgColor
+	}
+	return m
+}
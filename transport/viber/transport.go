@@ -0,0 +1,165 @@
+package viber
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/shyim/go-notifier"
+)
+
+// statusTooManyRequests is the Viber status for exceeded rate limits.
+const statusTooManyRequests = 12
+
+// Transport sends messages to subscribers of a Viber bot via the Viber REST API.
+type Transport struct {
+	*notifier.AbstractTransport
+	authToken    string
+	receiver     string
+	senderName   string
+	senderAvatar string
+}
+
+// NewTransport creates a new Viber transport. Messages are sent on behalf of the sender,
+// which Viber requires to have a name.
+func NewTransport(authToken, senderName, receiver string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		authToken:         authToken,
+		senderName:        senderName,
+		receiver:          receiver,
+	}
+}
+
+// SetSenderAvatar sets the URL of the sender avatar, at most 100 KB.
+func (t *Transport) SetSenderAvatar(avatar string) *Transport {
+	t.senderAvatar = avatar
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.receiver != "" {
+		return fmt.Sprintf("viber://%s?to=%s", t.getEndpoint(), t.receiver)
+	}
+	return fmt.Sprintf("viber://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("viber: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	payload := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("viber").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			payload[k] = v
+		}
+	}
+	delete(payload, "recipient_id")
+
+	receiver := chatMsg.GetRecipientId()
+	if receiver == "" {
+		receiver = t.receiver
+	}
+	if receiver == "" {
+		return nil, fmt.Errorf("viber: missing recipient")
+	}
+	payload["receiver"] = receiver
+
+	if _, ok := payload["sender"]; !ok {
+		sender := map[string]any{"name": t.senderName}
+		if t.senderAvatar != "" {
+			sender["avatar"] = t.senderAvatar
+		}
+		payload["sender"] = sender
+	}
+
+	if _, ok := payload["type"]; !ok {
+		payload["type"] = "text"
+	}
+	if text := chatMsg.GetText(); text != "" {
+		payload["text"] = text
+	} else if payload["type"] == "text" {
+		return nil, fmt.Errorf("viber: message has no content")
+	}
+
+	// Keyboards require API version 3 and above
+	if _, ok := payload["keyboard"]; ok {
+		if _, ok := payload["min_api_version"]; !ok {
+			payload["min_api_version"] = 3
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("viber: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/pa/send_message", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("viber: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Viber-Auth-Token", t.authToken)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("viber: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("viber: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	// Viber answers with HTTP 200 and reports failures in the status field
+	var result struct {
+		Status        int    `json:"status"`
+		StatusMessage string `json:"status_message"`
+		MessageToken  int64  `json:"message_token"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("viber: decode response: %w", err)
+	}
+
+	if result.Status == statusTooManyRequests {
+		return nil, &notifier.RateLimitError{
+			Transport: "viber",
+			Message:   result.StatusMessage,
+		}
+	}
+	if result.Status != 0 {
+		return nil, fmt.Errorf("viber: %s (status %d)", result.StatusMessage, result.Status)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(strconv.FormatInt(result.MessageToken, 10))
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "chatapi.viber.com"
+	}
+	return endpoint
+}
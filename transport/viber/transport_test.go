@@ -0,0 +1,202 @@
+package viber
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+// captureBody returns a client recording the request and its JSON body.
+func captureBody(req **http.Request, body *map[string]any) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		*body = nil
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, body)
+		return newResponse(http.StatusOK, `{"status":0,"status_message":"ok","message_token":5741311803571721087,"chat_hostname":"SN-CHAT-05_"}`), nil
+	})
+}
+
+func TestTransportSend(t *testing.T) {
+	var req *http.Request
+	var body map[string]any
+	transport := NewTransport("token", "Alerts", "01234567890A=", captureBody(&req, &body)).
+		SetSenderAvatar("https://example.com/avatar.png")
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Deploy finished").Content("Version 1.2.3 is live"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://chatapi.viber.com/pa/send_message" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+	if req.Header.Get("X-Viber-Auth-Token") != "token" {
+		t.Errorf("Unexpected X-Viber-Auth-Token header: %s", req.Header.Get("X-Viber-Auth-Token"))
+	}
+
+	data, _ := json.Marshal(body)
+	if string(data) != `{"receiver":"01234567890A=","sender":{"avatar":"https://example.com/avatar.png","name":"Alerts"},"text":"Deploy finished\n\nVersion 1.2.3 is live","type":"text"}` {
+		t.Errorf("Unexpected body: %s", data)
+	}
+	if sentMsg.GetMessageID() != "5741311803571721087" {
+		t.Errorf("Unexpected message ID: %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportMessageTypes(t *testing.T) {
+	keyboard := NewKeyboard(
+		NewReplyButton("Acknowledge", "ack").Columns(3),
+		NewOpenURLButton("Open", "https://example.com/incidents/1").Columns(3).BgColor("#2DB9B9"),
+	).DefaultHeight(true)
+
+	tests := []struct {
+		name     string
+		message  *notifier.ChatMessage
+		expected string
+	}{
+		{
+			"picture",
+			notifier.NewChatMessage("CPU graph").WithOptions("viber", NewOptions().Picture("https://example.com/cpu.png", "https://example.com/cpu-thumb.png")),
+			`{"media":"https://example.com/cpu.png","receiver":"01234567890A=","sender":{"name":"Alerts"},"text":"CPU graph","thumbnail":"https://example.com/cpu-thumb.png","type":"picture"}`,
+		},
+		{
+			"picture without caption",
+			notifier.NewChatMessage("").WithOptions("viber", NewOptions().Picture("https://example.com/cpu.png", "")),
+			`{"media":"https://example.com/cpu.png","receiver":"01234567890A=","sender":{"name":"Alerts"},"type":"picture"}`,
+		},
+		{
+			"keyboard",
+			notifier.NewChatMessage("Disk full").WithOptions("viber", NewOptions().Keyboard(keyboard).TrackingData("incident-1")),
+			`{"keyboard":{"Buttons":[{"ActionBody":"ack","ActionType":"reply","Columns":3,"Text":"Acknowledge"},{"ActionBody":"https://example.com/incidents/1","ActionType":"open-url","BgColor":"#2DB9B9","Columns":3,"Text":"Open"}],"DefaultHeight":true,"Type":"keyboard"},"min_api_version":3,"receiver":"01234567890A=","sender":{"name":"Alerts"},"text":"Disk full","tracking_data":"incident-1","type":"text"}`,
+		},
+		{
+			"recipient and sender",
+			notifier.NewChatMessage("Hi").WithOptions("viber", NewOptions().Recipient("other=").Sender("Ops", "https://example.com/ops.png")),
+			`{"receiver":"other=","sender":{"avatar":"https://example.com/ops.png","name":"Ops"},"text":"Hi","type":"text"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var body map[string]any
+			transport := NewTransport("token", "Alerts", "01234567890A=", captureBody(&req, &body))
+
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			data, _ := json.Marshal(body)
+			if string(data) != tt.expected {
+				t.Errorf("Unexpected body: %s", data)
+			}
+		})
+	}
+}
+
+func TestTransportErrors(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport *Transport
+		message   *notifier.ChatMessage
+		expected  string
+	}{
+		{"missing recipient", NewTransport("token", "Alerts", "", nil), notifier.NewChatMessage("Hi"), "viber: missing recipient"},
+		{"no content", NewTransport("token", "Alerts", "01234567890A=", nil), notifier.NewChatMessage(""), "viber: message has no content"},
+		{
+			"status",
+			NewTransport("token", "Alerts", "01234567890A=", newMockClient(func(req *http.Request) (*http.Response, error) {
+				return newResponse(http.StatusOK, `{"status":6,"status_message":"notSubscribed","chat_hostname":"SN-CHAT-05_"}`), nil
+			})),
+			notifier.NewChatMessage("Hi"),
+			"viber: notSubscribed (status 6)",
+		},
+		{
+			"http",
+			NewTransport("token", "Alerts", "01234567890A=", newMockClient(func(req *http.Request) (*http.Response, error) {
+				return newResponse(http.StatusBadGateway, `Bad Gateway`), nil
+			})),
+			notifier.NewChatMessage("Hi"),
+			"viber: API error (status 502): Bad Gateway",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.transport.Send(context.Background(), tt.message)
+			if err == nil || err.Error() != tt.expected {
+				t.Errorf("Expected %q, got: %v", tt.expected, err)
+			}
+		})
+	}
+
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusOK, `{"status":12,"status_message":"tooManyRequests"}`), nil
+	})
+
+	transport := NewTransport("token", "Alerts", "01234567890A=", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.Message != "tooManyRequests" {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("viber://token@default?sender=Alerts&avatar=https://example.com/avatar.png&to=01234567890A%3D")
+
+	if !factory.Supports(dsn) {
+		t.Fatal("Factory should support viber DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	viberTransport := transport.(*Transport)
+	if viberTransport.String() != "viber://chatapi.viber.com?to=01234567890A=" {
+		t.Errorf("Unexpected string: %s", viberTransport.String())
+	}
+	if viberTransport.authToken != "token" || viberTransport.senderName != "Alerts" || viberTransport.senderAvatar != "https://example.com/avatar.png" {
+		t.Errorf("Unexpected transport: %+v", viberTransport)
+	}
+
+	for _, invalid := range []string{"viber://default?sender=Alerts", "viber://token@default"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+			t.Errorf("Expected incomplete DSN error for %s, got: %v", invalid, err)
+		}
+	}
+}
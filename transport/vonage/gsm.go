@@ -0,0 +1,19 @@
+package vonage
+
+import "strings"
+
+// gsmCharacters is the GSM 03.38 default alphabet and its extension table.
+const gsmCharacters = "@£$¥èéùìòÇ\nØø\rÅåΔ_ΦΓΛΩΠΨΣΘΞÆæßÉ !\"#¤%&'()*+,-./0123456789:;<=>?" +
+	"¡ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÑÜ§¿abcdefghijklmnopqrstuvwxyzäöñüà" +
+	"\f^{}\\[~]|€"
+
+// isGSM reports whether text can be encoded in the GSM 7-bit alphabet.
+// Other text must be sent as unicode, otherwise carriers replace the characters.
+func isGSM(text string) bool {
+	for _, r := range text {
+		if !strings.ContainsRune(gsmCharacters, r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package vonage
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Vonage SMS.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Unicode forces the message to be sent as unicode, even if it only contains GSM characters.
+func (o *Options) Unicode() *Options {
+	o.options["type"] = "unicode"
+	return o
+}
+
+// ClientRef sets a reference (up to 100 characters) that is returned in the delivery receipt.
+func (o *Options) ClientRef(ref string) *Options {
+	o.options["client-ref"] = ref
+	return o
+}
+
+// Callback sets the webhook URL delivery receipts are sent to, overriding the account default.
+func (o *Options) Callback(url string) *Options {
+	o.options["callback"] = url
+	return o
+}
+
+// StatusReportRequired requests a delivery receipt.
+func (o *Options) StatusReportRequired(required bool) *Options {
+	o.options["status-report-req"] = required
+	return o
+}
+
+// TTL sets the message lifetime in milliseconds.
+func (o *Options) TTL(milliseconds int) *Options {
+	o.options["ttl"] = milliseconds
+	return o
+}
+
+// MessageClass sets the data coding scheme message class (0 sends a flash message).
+func (o *Options) MessageClass(class int) *Options {
+	o.options["message-class"] = class
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"ttl": 0,
+}
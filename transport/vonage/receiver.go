@@ -0,0 +1,88 @@
+package vonage
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+)
+
+// Delivery receipt statuses.
+const (
+	StatusDelivered = "delivered"
+	StatusExpired   = "expired"
+	StatusFailed    = "failed"
+	StatusRejected  = "rejected"
+	StatusAccepted  = "accepted"
+	StatusBuffered  = "buffered"
+	StatusUnknown   = "unknown"
+)
+
+// DeliveryReceipt is a delivery receipt sent by Vonage to the account's delivery receipt webhook.
+type DeliveryReceipt struct {
+	MessageID        string `json:"messageId"`
+	To               string `json:"to"`
+	MSISDN           string `json:"msisdn"`
+	NetworkCode      string `json:"network-code"`
+	Price            string `json:"price"`
+	Status           string `json:"status"`
+	SCTS             string `json:"scts"`
+	ErrorCode        string `json:"err-code"`
+	ClientRef        string `json:"client-ref"`
+	MessageTimestamp string `json:"message-timestamp"`
+}
+
+// Delivered reports whether the message reached the handset.
+func (r *DeliveryReceipt) Delivered() bool {
+	return r.Status == StatusDelivered
+}
+
+// ParseDeliveryReceipt parses a delivery receipt webhook request. Depending on the
+// account settings, Vonage sends receipts as GET query parameters, a POST form or POST JSON.
+func ParseDeliveryReceipt(r *http.Request) (*DeliveryReceipt, error) {
+	var receipt DeliveryReceipt
+
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if r.Method == http.MethodPost && mediaType == "application/json" {
+		if err := json.NewDecoder(r.Body).Decode(&receipt); err != nil {
+			return nil, fmt.Errorf("vonage: decode delivery receipt: %w", err)
+		}
+	} else {
+		if err := r.ParseForm(); err != nil {
+			return nil, fmt.Errorf("vonage: parse delivery receipt: %w", err)
+		}
+		receipt = DeliveryReceipt{
+			MessageID:        r.Form.Get("messageId"),
+			To:               r.Form.Get("to"),
+			MSISDN:           r.Form.Get("msisdn"),
+			NetworkCode:      r.Form.Get("network-code"),
+			Price:            r.Form.Get("price"),
+			Status:           r.Form.Get("status"),
+			SCTS:             r.Form.Get("scts"),
+			ErrorCode:        r.Form.Get("err-code"),
+			ClientRef:        r.Form.Get("client-ref"),
+			MessageTimestamp: r.Form.Get("message-timestamp"),
+		}
+	}
+
+	if receipt.MessageID == "" || receipt.Status == "" {
+		return nil, fmt.Errorf("vonage: invalid delivery receipt: missing messageId or status")
+	}
+
+	return &receipt, nil
+}
+
+// DeliveryReceiptHandler returns an http.Handler that parses delivery receipts and passes
+// them to handle. Invalid requests are answered with 400 Bad Request; valid receipts with
+// 204 No Content, as Vonage retries receipts that are not acknowledged with a 2xx status.
+func DeliveryReceiptHandler(handle func(*DeliveryReceipt)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		receipt, err := ParseDeliveryReceipt(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		handle(receipt)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
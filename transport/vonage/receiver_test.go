@@ -0,0 +1,78 @@
+package vonage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseDeliveryReceiptQuery(t *testing.T) {
+	req := httptest.NewRequest("GET", "/dlr?msisdn=447700900000&to=ACME&network-code=23410&messageId=0A0000000123ABCD1&price=0.03330000&status=delivered&scts=2001011400&err-code=0&client-ref=order-1&message-timestamp=2020-01-01+12%3A00%3A00", nil)
+
+	receipt, err := ParseDeliveryReceipt(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if receipt.MessageID != "0A0000000123ABCD1" || receipt.MSISDN != "447700900000" || receipt.ClientRef != "order-1" {
+		t.Errorf("Unexpected receipt: %+v", receipt)
+	}
+	if receipt.MessageTimestamp != "2020-01-01 12:00:00" {
+		t.Errorf("Unexpected timestamp: %s", receipt.MessageTimestamp)
+	}
+	if !receipt.Delivered() {
+		t.Error("Expected receipt to be delivered")
+	}
+}
+
+func TestParseDeliveryReceiptJSON(t *testing.T) {
+	req := httptest.NewRequest("POST", "/dlr", strings.NewReader(`{"msisdn":"447700900000","to":"ACME","messageId":"0A0000000123ABCD1","status":"failed","err-code":"6"}`))
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	receipt, err := ParseDeliveryReceipt(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if receipt.Status != StatusFailed || receipt.ErrorCode != "6" || receipt.Delivered() {
+		t.Errorf("Unexpected receipt: %+v", receipt)
+	}
+}
+
+func TestParseDeliveryReceiptForm(t *testing.T) {
+	req := httptest.NewRequest("POST", "/dlr", strings.NewReader("messageId=abc&status=expired"))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	receipt, err := ParseDeliveryReceipt(req)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if receipt.MessageID != "abc" || receipt.Status != StatusExpired {
+		t.Errorf("Unexpected receipt: %+v", receipt)
+	}
+}
+
+func TestDeliveryReceiptHandler(t *testing.T) {
+	var received []*DeliveryReceipt
+	handler := DeliveryReceiptHandler(func(receipt *DeliveryReceipt) {
+		received = append(received, receipt)
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/dlr?messageId=abc&status=delivered", nil))
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", rec.Code)
+	}
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest("GET", "/dlr", nil))
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400, got %d", rec.Code)
+	}
+
+	if len(received) != 1 || received[0].MessageID != "abc" {
+		t.Errorf("Unexpected receipts: %v", received)
+	}
+}
@@ -0,0 +1,147 @@
+package vonage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// statusThrottled is returned when the account sends faster than its allowed rate.
+const statusThrottled = "1"
+
+// Transport sends SMS via the Vonage (formerly Nexmo) SMS API.
+type Transport struct {
+	*notifier.AbstractTransport
+	apiKey    string
+	apiSecret string
+	from      string
+}
+
+// NewTransport creates a new Vonage transport. from is the default sender
+// (phone number or alphanumeric sender ID).
+func NewTransport(apiKey, apiSecret, from string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		apiKey:            apiKey,
+		apiSecret:         apiSecret,
+		from:              from,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("vonage://%s?from=%s", t.getEndpoint(), t.from)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.SmsMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	smsMsg, ok := message.(*notifier.SmsMessage)
+	if !ok {
+		return nil, fmt.Errorf("vonage: unsupported message type %T, expected SmsMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := smsMsg.GetOptions("vonage").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	from := smsMsg.GetFrom()
+	if from == "" {
+		from = t.from
+	}
+
+	form := url.Values{}
+	form.Set("api_key", t.apiKey)
+	form.Set("api_secret", t.apiSecret)
+	form.Set("from", from)
+	// Vonage expects the number in international format without the leading + or 00
+	form.Set("to", strings.TrimPrefix(smsMsg.GetPhone(), "+"))
+	form.Set("text", smsMsg.GetSubject())
+	if !isGSM(smsMsg.GetSubject()) {
+		form.Set("type", "unicode")
+	}
+	for key, value := range options {
+		form.Set(key, fmt.Sprint(value))
+	}
+
+	endpoint := fmt.Sprintf("https://%s/sms/json", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("vonage: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("vonage: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("vonage: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Messages []struct {
+			MessageID        string `json:"message-id"`
+			Status           string `json:"status"`
+			ErrorText        string `json:"error-text"`
+			RemainingBalance string `json:"remaining-balance"`
+			MessagePrice     string `json:"message-price"`
+			Network          string `json:"network"`
+		} `json:"messages"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("vonage: decode response: %w", err)
+	}
+
+	if len(result.Messages) == 0 {
+		return nil, fmt.Errorf("vonage: API error: empty response")
+	}
+
+	// Long messages are split into several parts, each with its own status
+	var messageIDs []string
+	for _, part := range result.Messages {
+		if part.Status == statusThrottled {
+			return nil, &notifier.RateLimitError{
+				Transport: "vonage",
+				Message:   part.ErrorText,
+			}
+		}
+		if part.Status != "0" {
+			return nil, fmt.Errorf("vonage: API error (status %s): %s", part.Status, part.ErrorText)
+		}
+		messageIDs = append(messageIDs, part.MessageID)
+	}
+
+	last := result.Messages[len(result.Messages)-1]
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(messageIDs[0])
+	sentMessage.SetInfo("message_ids", messageIDs)
+	sentMessage.SetInfo("remaining_balance", last.RemainingBalance)
+	sentMessage.SetInfo("network", last.Network)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "rest.nexmo.com"
+	}
+	return endpoint
+}
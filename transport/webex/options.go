@@ -0,0 +1,80 @@
+package webex
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Webex.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the room ID to post into.
+func (o *Options) Recipient(id string) *Options {
+	o.options["recipient_id"] = id
+	return o
+}
+
+// ToPersonEmail sends a direct message to the person with the given email.
+func (o *Options) ToPersonEmail(email string) *Options {
+	o.options["toPersonEmail"] = email
+	return o
+}
+
+// ToPersonID sends a direct message to the person with the given ID.
+func (o *Options) ToPersonID(id string) *Options {
+	o.options["toPersonId"] = id
+	return o
+}
+
+// Markdown sets the markdown content of the message.
+// When set, the message subject is used as plain text fallback.
+func (o *Options) Markdown(markdown string) *Options {
+	o.options["markdown"] = markdown
+	return o
+}
+
+// ParentID replies in the thread of the given message.
+func (o *Options) ParentID(id string) *Options {
+	o.options["parentId"] = id
+	return o
+}
+
+// File attaches a publicly reachable file URL to the message.
+func (o *Options) File(url string) *Options {
+	o.options["files"] = []string{url}
+	return o
+}
+
+// UploadFile attaches a local file to the message.
+func (o *Options) UploadFile(path string) *Options {
+	o.options["upload"] = path
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"files": []string(nil),
+}
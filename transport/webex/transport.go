@@ -0,0 +1,174 @@
+package webex
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends messages via Webex Messages API.
+type Transport struct {
+	*notifier.AbstractTransport
+	token string
+	room  string
+}
+
+// NewTransport creates a new Webex transport.
+func NewTransport(token, room string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		room:              room,
+	}
+}
+
+func (t *Transport) String() string {
+	endpoint := t.getEndpoint()
+	if t.room != "" {
+		return fmt.Sprintf("webex://%s?room=%s", endpoint, t.room)
+	}
+	return fmt.Sprintf("webex://%s", endpoint)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("webex: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("webex").(*Options); ok {
+		for k, v := range opts.ToMap() {
+			options[k] = v
+		}
+	}
+
+	// A direct person recipient takes precedence over the room
+	_, hasPersonEmail := options["toPersonEmail"]
+	_, hasPersonID := options["toPersonId"]
+	if !hasPersonEmail && !hasPersonID {
+		roomID := chatMsg.GetRecipientId()
+		if roomID == "" {
+			roomID = t.room
+		}
+		if roomID == "" {
+			return nil, fmt.Errorf("webex: missing recipient, set a room, person email or person ID")
+		}
+		options["roomId"] = roomID
+	}
+	delete(options, "recipient_id")
+	options["text"] = chatMsg.GetText()
+
+	upload, _ := options["upload"].(string)
+	delete(options, "upload")
+
+	var body io.Reader
+	var contentType string
+	if upload != "" {
+		var err error
+		body, contentType, err = createMultipartBody(options, upload)
+		if err != nil {
+			return nil, fmt.Errorf("webex: %w", err)
+		}
+	} else {
+		jsonBody, err := notifier.JSONMarshal(options)
+		if err != nil {
+			return nil, fmt.Errorf("webex: marshal options: %w", err)
+		}
+		body = bytes.NewReader(jsonBody)
+		contentType = "application/json"
+	}
+
+	endpoint := fmt.Sprintf("https://%s/v1/messages", t.getEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("webex: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("webex: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("webex: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		ID     string `json:"id"`
+		RoomID string `json:"roomId"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("webex: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.ID)
+	sentMessage.SetInfo("room_id", result.RoomID)
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "webexapis.com"
+	}
+	return endpoint
+}
+
+func createMultipartBody(options map[string]any, upload string) (io.Reader, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	for k, v := range options {
+		if str, ok := v.(string); ok && str != "" {
+			if err := writer.WriteField(k, str); err != nil {
+				return nil, "", fmt.Errorf("write field %s: %w", k, err)
+			}
+		}
+	}
+
+	file, err := os.Open(upload)
+	if err != nil {
+		return nil, "", fmt.Errorf("open file: %w", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	part, err := writer.CreateFormFile("files", filepath.Base(upload))
+	if err != nil {
+		return nil, "", fmt.Errorf("create form file: %w", err)
+	}
+	if _, err := io.Copy(part, file); err != nil {
+		return nil, "", fmt.Errorf("copy file: %w", err)
+	}
+
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("close multipart writer: %w", err)
+	}
+
+	return &body, writer.FormDataContentType(), nil
+}
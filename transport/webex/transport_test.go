@@ -0,0 +1,201 @@
+package webex
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSupports(t *testing.T) {
+	transport := NewTransport("token", "room", nil)
+
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should support ChatMessage")
+	}
+}
+
+func TestTransportString(t *testing.T) {
+	tests := []struct {
+		room     string
+		expected string
+	}{
+		{"", "webex://webexapis.com"},
+		{"ROOM", "webex://webexapis.com?room=ROOM"},
+	}
+
+	for _, tt := range tests {
+		transport := NewTransport("token", tt.room, nil)
+		if transport.String() != tt.expected {
+			t.Errorf("Expected %s, got %s", tt.expected, transport.String())
+		}
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("webex://token@default?room=ROOM")
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support webex DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	webexTransport := transport.(*Transport)
+	if webexTransport.token != "token" || webexTransport.room != "ROOM" {
+		t.Error("Token or room not set correctly")
+	}
+
+	dsn, _ = notifier.NewDSN("webex://default?room=ROOM")
+	if _, err := factory.Create(dsn); err == nil {
+		t.Error("Factory should return error for missing token")
+	}
+}
+
+func TestHTTPSendToRoom(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"id":"MSG","roomId":"ROOM"}`), nil
+	})
+
+	transport := NewTransport("token", "ROOM", client)
+	msg := notifier.NewChatMessage("Deploy done").
+		WithOptions("webex", NewOptions().Markdown("**Deploy** done").File("https://example.com/log.txt"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.URL.String() != "https://webexapis.com/v1/messages" {
+		t.Errorf("Unexpected URL: %s", capturedRequest.URL.String())
+	}
+	if capturedRequest.Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Unexpected Authorization: %s", capturedRequest.Header.Get("Authorization"))
+	}
+	if capturedBody["roomId"] != "ROOM" || capturedBody["text"] != "Deploy done" || capturedBody["markdown"] != "**Deploy** done" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if files, ok := capturedBody["files"].([]any); !ok || len(files) != 1 {
+		t.Errorf("Expected one file, got: %v", capturedBody["files"])
+	}
+	if sentMsg.GetMessageID() != "MSG" || sentMsg.GetInfo("room_id") != "ROOM" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo("room_id"))
+	}
+}
+
+func TestHTTPSendToPerson(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"id":"MSG"}`), nil
+	})
+
+	transport := NewTransport("token", "ROOM", client)
+	msg := notifier.NewChatMessage("Hi").WithOptions("webex", NewOptions().ToPersonEmail("jane@example.com"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedBody["toPersonEmail"] != "jane@example.com" {
+		t.Errorf("Expected toPersonEmail, got: %v", capturedBody)
+	}
+	if _, ok := capturedBody["roomId"]; ok {
+		t.Error("roomId should not be set for direct messages")
+	}
+}
+
+func TestHTTPUploadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.txt")
+	os.WriteFile(path, []byte("report"), 0o600)
+
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if err := req.ParseMultipartForm(1 << 20); err != nil {
+			t.Fatalf("Expected multipart body, got: %v", err)
+		}
+		if req.FormValue("roomId") != "ROOM" || req.FormValue("text") != "Report" {
+			t.Errorf("Unexpected fields: %v", req.MultipartForm.Value)
+		}
+		if _, header, err := req.FormFile("files"); err != nil || header.Filename != "report.txt" {
+			t.Errorf("Expected file upload, got: %v", err)
+		}
+		return newResponse(http.StatusOK, `{"id":"MSG"}`), nil
+	})
+
+	transport := NewTransport("token", "ROOM", client)
+	msg := notifier.NewChatMessage("Report").WithOptions("webex", NewOptions().UploadFile(path))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+}
+
+func TestHTTPMissingRecipient(t *testing.T) {
+	transport := NewTransport("token", "", nil)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "missing recipient") {
+		t.Errorf("Expected missing recipient error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusUnauthorized, `{"message":"The request requires a valid access token"}`), nil
+	})
+
+	transport := NewTransport("token", "ROOM", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 401)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestHTTPNetworkError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	transport := NewTransport("token", "ROOM", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "webex: send request") {
+		t.Errorf("Expected send request error, got: %v", err)
+	}
+}
@@ -0,0 +1,109 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("webhook", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates generic webhook transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new webhook transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a webhook transport from a DSN.
+// DSN format: webhook://[<user>:<password>@]<host>[:<port>]/<path>[?method=POST&token=<bearer_token>&headers=<name>:<value>,...&template=<json>]
+// Example: webhook://hooks.example.com/notify?token=s3cr3t&template=%7B%22text%22%3A%22%7B%7Bsubject%7D%7D%22%7D
+//
+// Plain HTTP endpoints can be reached with webhook+http://<host>/<path>.
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if !f.Supports(dsn) {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "" || host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing host. DSN: %s", dsn.GetOriginalDSN())
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(dsn.GetPath(), f.client)
+	transport.SetHost(host)
+	if port > 0 {
+		transport.SetPort(port)
+	}
+	if scheme == "webhook+http" {
+		transport.SetScheme("http")
+	}
+
+	switch method := strings.ToUpper(dsn.GetOption("method", http.MethodPost)); method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		transport.SetMethod(method)
+	default:
+		return nil, fmt.Errorf("invalid DSN: Unsupported method \"%s\" (supported: POST, PUT, PATCH). DSN: %s", method, dsn.GetOriginalDSN())
+	}
+
+	if user := dsn.GetUser(); user != "" {
+		transport.SetBasicAuth(user, dsn.GetPassword())
+	}
+	if token := dsn.GetOption("token"); token != "" {
+		transport.SetBearerToken(token)
+	}
+
+	if value := dsn.GetOption("headers"); value != "" {
+		for _, pair := range strings.Split(value, ",") {
+			key, header, ok := strings.Cut(pair, ":")
+			if !ok || key == "" {
+				return nil, fmt.Errorf("invalid DSN: Invalid header \"%s\", expected <name>:<value>. DSN: %s", pair, dsn.GetOriginalDSN())
+			}
+			transport.SetHeader(key, strings.TrimSpace(header))
+		}
+	}
+
+	if template := dsn.GetOption("template"); template != "" {
+		if !json.Valid([]byte(template)) {
+			return nil, fmt.Errorf("invalid DSN: Payload template is not valid JSON. DSN: %s", dsn.GetOriginalDSN())
+		}
+		transport.SetTemplate(template)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"webhook", "webhook+http"}
+}
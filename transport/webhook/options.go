@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for webhooks.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the recipient available to the payload template as {{recipient}}.
+func (o *Options) Recipient(recipient string) *Options {
+	o.options["recipient_id"] = recipient
+	return o
+}
+
+// Var sets a variable available to the payload template as {{options.<key>}}.
+func (o *Options) Var(key string, value any) *Options {
+	vars, ok := o.options["vars"].(map[string]any)
+	if !ok {
+		vars = make(map[string]any)
+		o.options["vars"] = vars
+	}
+	vars[key] = value
+	return o
+}
+
+// Template overrides the payload template of the transport for this message.
+func (o *Options) Template(template string) *Options {
+	o.options["template"] = template
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
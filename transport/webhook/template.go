@@ -0,0 +1,68 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// placeholderPattern matches placeholders such as {{subject}} or {{data.build}}.
+var placeholderPattern = regexp.MustCompile(`{{\s*([\w.-]+)\s*}}`)
+
+// render replaces the placeholders in the string values of the JSON template with the
+// variables. A string consisting of a single placeholder is replaced by the value itself,
+// keeping numbers, lists and objects; unknown placeholders become null or an empty string.
+func render(template string, vars map[string]any) (any, error) {
+	var tree any
+	if err := json.Unmarshal([]byte(template), &tree); err != nil {
+		return nil, fmt.Errorf("invalid payload template: %w", err)
+	}
+	return renderValue(tree, vars), nil
+}
+
+func renderValue(value any, vars map[string]any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			v[key] = renderValue(item, vars)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = renderValue(item, vars)
+		}
+	case string:
+		if match := placeholderPattern.FindStringSubmatch(v); match != nil && match[0] == v {
+			return lookup(vars, match[1])
+		}
+		return placeholderPattern.ReplaceAllStringFunc(v, func(placeholder string) string {
+			return format(lookup(vars, placeholderPattern.FindStringSubmatch(placeholder)[1]))
+		})
+	}
+	return value
+}
+
+// lookup resolves a dotted path in the variables.
+func lookup(vars map[string]any, path string) any {
+	var value any = vars
+	for _, key := range strings.Split(path, ".") {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = m[key]
+	}
+	return value
+}
+
+// format returns the value as text inside a string, JSON encoding everything but strings.
+func format(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	encoded, _ := json.Marshal(value)
+	return string(encoded)
+}
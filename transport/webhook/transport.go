@@ -0,0 +1,175 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport sends messages as JSON to any HTTP endpoint. Without a template the body is
+// the message payload, see notifier.Payload.
+type Transport struct {
+	*notifier.AbstractTransport
+	scheme      string
+	path        string
+	method      string
+	template    string
+	headers     map[string]string
+	username    string
+	password    string
+	bearerToken string
+}
+
+// NewTransport creates a new webhook transport posting to the path on the host set with SetHost.
+func NewTransport(path string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		scheme:            "https",
+		path:              path,
+		method:            http.MethodPost,
+		headers:           make(map[string]string),
+	}
+}
+
+// SetScheme sets the URL scheme used to reach the server ("https" or "http").
+func (t *Transport) SetScheme(scheme string) *Transport {
+	t.scheme = scheme
+	return t
+}
+
+// SetMethod sets the HTTP method (default POST).
+func (t *Transport) SetMethod(method string) *Transport {
+	t.method = strings.ToUpper(method)
+	return t
+}
+
+// SetTemplate sets the JSON payload template. Placeholders such as {{subject}}, {{content}},
+// {{recipient}}, {{importance}} or {{data.<key>}} refer to the message payload fields,
+// {{options.<key>}} to the variables set with Options.Var.
+func (t *Transport) SetTemplate(template string) *Transport {
+	t.template = template
+	return t
+}
+
+// SetHeader sets a header sent with every request.
+func (t *Transport) SetHeader(key, value string) *Transport {
+	t.headers[key] = value
+	return t
+}
+
+// SetBasicAuth sets the credentials for HTTP basic authentication.
+func (t *Transport) SetBasicAuth(username, password string) *Transport {
+	t.username = username
+	t.password = password
+	return t
+}
+
+// SetBearerToken sets the token sent as Authorization: Bearer header.
+func (t *Transport) SetBearerToken(token string) *Transport {
+	t.bearerToken = token
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("webhook://%s%s?method=%s", t.GetEndpoint(), t.path, t.method)
+}
+
+// Supports returns true for all message types, they are sent as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("webhook").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	payload := notifier.NewPayload(message)
+	// Transport options are configuration, not data for the endpoint
+	payload.Options = nil
+
+	template := t.template
+	if value, ok := options["template"].(string); ok && value != "" {
+		template = value
+	}
+
+	var body any = payload
+	if template != "" {
+		encoded, err := notifier.JSONMarshal(payload)
+		if err != nil {
+			return nil, fmt.Errorf("webhook: marshal payload: %w", err)
+		}
+		vars := make(map[string]any)
+		if err := json.Unmarshal(encoded, &vars); err != nil {
+			return nil, fmt.Errorf("webhook: marshal payload: %w", err)
+		}
+		if value, ok := options["vars"].(map[string]any); ok {
+			vars["options"] = value
+		}
+
+		body, err = render(template, vars)
+		if err != nil {
+			return nil, fmt.Errorf("webhook: %w", err)
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: marshal payload: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("%s://%s%s", t.scheme, t.GetEndpoint(), t.path)
+	req, err := http.NewRequestWithContext(ctx, t.method, endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("webhook: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	switch {
+	case t.bearerToken != "":
+		req.Header.Set("Authorization", "Bearer "+t.bearerToken)
+	case t.username != "":
+		req.SetBasicAuth(t.username, t.password)
+	}
+	for key, value := range t.headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("webhook: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &notifier.RateLimitError{
+			Transport:  "webhook",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("webhook: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetInfo("status", resp.StatusCode)
+
+	return sentMessage, nil
+}
@@ -0,0 +1,230 @@
+package wecom
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// errCodeTokenExpired is returned when a cached access token is no longer valid.
+const errCodeTokenExpired = 42001
+
+// AppTransport sends messages to users, departments or tags through a WeCom
+// self-built application (app message API).
+type AppTransport struct {
+	*notifier.AbstractTransport
+	corpID     string
+	corpSecret string
+	agentID    int
+	to         string
+
+	mu          sync.Mutex
+	accessToken string
+	expiresAt   time.Time
+}
+
+// NewAppTransport creates a new WeCom app message transport. to is the default
+// recipient list ("userA|userB" or "@all").
+func NewAppTransport(corpID, corpSecret string, agentID int, to string, client *http.Client) *AppTransport {
+	return &AppTransport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		corpID:            corpID,
+		corpSecret:        corpSecret,
+		agentID:           agentID,
+		to:                to,
+	}
+}
+
+func (t *AppTransport) String() string {
+	return fmt.Sprintf("wecomapp://%s?agent_id=%d", t.getEndpoint(), t.agentID)
+}
+
+func (t *AppTransport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *AppTransport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("wecomapp: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("wecomapp").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	if options["msgtype"] == MsgTypeImage {
+		return nil, fmt.Errorf("wecomapp: image messages are only supported by group bots")
+	}
+
+	payload := buildMessage(chatMsg.GetSubject(), options)
+	payload["agentid"] = t.agentID
+
+	touser := t.to
+	if recipient, ok := options["recipient_id"].(string); ok && recipient != "" {
+		touser = recipient
+	}
+	if touser != "" {
+		payload["touser"] = touser
+	}
+	for _, key := range []string{"toparty", "totag", "safe"} {
+		if value, ok := options[key]; ok {
+			payload[key] = value
+		}
+	}
+	if payload["touser"] == nil && payload["toparty"] == nil && payload["totag"] == nil {
+		return nil, fmt.Errorf("wecomapp: missing recipient, set users, departments or tags")
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("wecomapp: marshal options: %w", err)
+	}
+
+	var result struct {
+		apiResult
+		MsgID        string `json:"msgid"`
+		InvalidUser  string `json:"invaliduser"`
+		InvalidParty string `json:"invalidparty"`
+		InvalidTag   string `json:"invalidtag"`
+	}
+
+	// An expired token is refreshed once, e.g. after it was revoked by another client
+	var raw any
+	for attempt := 0; ; attempt++ {
+		token, err := t.getAccessToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		endpoint := fmt.Sprintf("https://%s/cgi-bin/message/send?access_token=%s", t.getEndpoint(), url.QueryEscape(token))
+		raw, err = t.post(ctx, endpoint, jsonBody, &result)
+		if err != nil {
+			return nil, err
+		}
+
+		if result.ErrCode == errCodeTokenExpired && attempt == 0 {
+			t.resetAccessToken()
+			continue
+		}
+		break
+	}
+
+	if err := result.err("wecomapp"); err != nil {
+		return nil, err
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(result.MsgID)
+	if result.InvalidUser != "" {
+		sentMessage.SetInfo("invalid_user", result.InvalidUser)
+	}
+	if result.InvalidParty != "" {
+		sentMessage.SetInfo("invalid_party", result.InvalidParty)
+	}
+	if result.InvalidTag != "" {
+		sentMessage.SetInfo("invalid_tag", result.InvalidTag)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *AppTransport) post(ctx context.Context, endpoint string, jsonBody []byte, result any) (any, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("wecomapp: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("wecomapp: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("wecomapp: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, result)
+	if err != nil {
+		return nil, fmt.Errorf("wecomapp: decode response: %w", err)
+	}
+	return raw, nil
+}
+
+// getAccessToken returns a cached access token, requesting a new one when expired.
+func (t *AppTransport) getAccessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.accessToken != "" && time.Now().Before(t.expiresAt) {
+		return t.accessToken, nil
+	}
+
+	query := url.Values{}
+	query.Set("corpid", t.corpID)
+	query.Set("corpsecret", t.corpSecret)
+
+	endpoint := fmt.Sprintf("https://%s/cgi-bin/gettoken?%s", t.getEndpoint(), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return "", fmt.Errorf("wecomapp: create token request: %w", err)
+	}
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("wecomapp: token request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("wecomapp: token error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		apiResult
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("wecomapp: decode token response: %w", err)
+	}
+	if result.ErrCode != 0 {
+		return "", fmt.Errorf("wecomapp: token error (errcode %d): %s", result.ErrCode, result.ErrMsg)
+	}
+
+	t.accessToken = result.AccessToken
+	// Refresh a minute early to avoid using a token that expires in flight
+	t.expiresAt = time.Now().Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+
+	return t.accessToken, nil
+}
+
+func (t *AppTransport) resetAccessToken() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.accessToken = ""
+}
+
+func (t *AppTransport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "qyapi.weixin.qq.com"
+	}
+	return endpoint
+}
@@ -0,0 +1,149 @@
+package wecom
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// Message types.
+const (
+	MsgTypeText     = "text"
+	MsgTypeMarkdown = "markdown"
+	MsgTypeImage    = "image"
+	MsgTypeNews     = "news"
+)
+
+// Article is an entry of a news message.
+type Article struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	URL         string `json:"url"`
+	PicURL      string `json:"picurl,omitempty"`
+}
+
+// Options implements MessageOptionsInterface for WeCom.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the user IDs of an app message, separated by "|", or "@all".
+func (o *Options) Recipient(userIDs string) *Options {
+	o.options["recipient_id"] = userIDs
+	return o
+}
+
+// ToParty sets the department IDs of an app message, separated by "|".
+func (o *Options) ToParty(partyIDs string) *Options {
+	o.options["toparty"] = partyIDs
+	return o
+}
+
+// ToTag sets the tag IDs of an app message, separated by "|".
+func (o *Options) ToTag(tagIDs string) *Options {
+	o.options["totag"] = tagIDs
+	return o
+}
+
+// Markdown sends the subject as markdown instead of plain text.
+func (o *Options) Markdown() *Options {
+	o.options["msgtype"] = MsgTypeMarkdown
+	return o
+}
+
+// Image sends an image (JPG or PNG, at most 2 MB) instead of text. Group bots only.
+func (o *Options) Image(data []byte) *Options {
+	sum := md5.Sum(data)
+	o.options["msgtype"] = MsgTypeImage
+	o.options["image"] = map[string]string{
+		"base64": base64.StdEncoding.EncodeToString(data),
+		"md5":    hex.EncodeToString(sum[:]),
+	}
+	return o
+}
+
+// News sends a news card with up to 8 articles instead of text.
+func (o *Options) News(articles ...Article) *Options {
+	o.options["msgtype"] = MsgTypeNews
+	o.options["articles"] = articles
+	return o
+}
+
+// Mention mentions group members by user ID ("@all" mentions everyone). Text messages only.
+func (o *Options) Mention(userIDs ...string) *Options {
+	mentions, _ := o.options["mentioned_list"].([]string)
+	o.options["mentioned_list"] = append(mentions, userIDs...)
+	return o
+}
+
+// MentionMobile mentions group members by mobile number. Text messages only.
+func (o *Options) MentionMobile(mobiles ...string) *Options {
+	mentions, _ := o.options["mentioned_mobile_list"].([]string)
+	o.options["mentioned_mobile_list"] = append(mentions, mobiles...)
+	return o
+}
+
+// Safe marks an app message as confidential, preventing it from being forwarded.
+func (o *Options) Safe() *Options {
+	o.options["safe"] = 1
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"image":                 map[string]string(nil),
+	"articles":              []Article(nil),
+	"mentioned_list":        []string(nil),
+	"mentioned_mobile_list": []string(nil),
+	"safe":                  0,
+}
+
+// buildMessage builds the msgtype specific part of a group bot or app message.
+func buildMessage(subject string, options map[string]any) map[string]any {
+	msgType, _ := options["msgtype"].(string)
+	if msgType == "" {
+		msgType = MsgTypeText
+	}
+
+	message := map[string]any{"msgtype": msgType}
+	switch msgType {
+	case MsgTypeText:
+		text := map[string]any{"content": subject}
+		for _, key := range []string{"mentioned_list", "mentioned_mobile_list"} {
+			if value, ok := options[key]; ok {
+				text[key] = value
+			}
+		}
+		message["text"] = text
+	case MsgTypeMarkdown:
+		message["markdown"] = map[string]any{"content": subject}
+	case MsgTypeImage:
+		message["image"] = options["image"]
+	case MsgTypeNews:
+		message["news"] = map[string]any{"articles": options["articles"]}
+	}
+	return message
+}
@@ -0,0 +1,112 @@
+package wecom
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/shyim/go-notifier"
+)
+
+// errCodeRateLimited is returned when a group bot sends more than 20 messages per minute.
+const errCodeRateLimited = 45009
+
+// Transport sends messages to a WeCom (WeChat Work) group bot webhook.
+type Transport struct {
+	*notifier.AbstractTransport
+	key string
+}
+
+// NewTransport creates a new WeCom group bot transport. key is the key parameter of the webhook URL.
+func NewTransport(key string, client *http.Client) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		key:               key,
+	}
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("wecom://%s", t.getEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("wecom: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("wecom").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	jsonBody, err := notifier.JSONMarshal(buildMessage(chatMsg.GetText(), options))
+	if err != nil {
+		return nil, fmt.Errorf("wecom: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/cgi-bin/webhook/send?key=%s", t.getEndpoint(), url.QueryEscape(t.key))
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("wecom: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("wecom: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("wecom: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result apiResult
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("wecom: decode response: %w", err)
+	}
+	if err := result.err("wecom"); err != nil {
+		return nil, err
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "qyapi.weixin.qq.com"
+	}
+	return endpoint
+}
+
+// apiResult is the error envelope of all WeCom API responses.
+type apiResult struct {
+	ErrCode int    `json:"errcode"`
+	ErrMsg  string `json:"errmsg"`
+}
+
+// err converts a non-zero errcode into an error. HTTP status codes are always 200.
+func (r apiResult) err(transport string) error {
+	switch r.ErrCode {
+	case 0:
+		return nil
+	case errCodeRateLimited:
+		return &notifier.RateLimitError{Transport: transport, Message: r.ErrMsg}
+	}
+	return fmt.Errorf("%s: API error (errcode %d): %s", transport, r.ErrCode, r.ErrMsg)
+}
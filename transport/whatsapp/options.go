@@ -0,0 +1,80 @@
+package whatsapp
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for WhatsApp.
+type Options struct {
+	options    map[string]any
+	components []map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options:    make(map[string]any),
+		components: make([]map[string]any, 0),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	if len(o.components) > 0 {
+		o.options["components"] = o.components
+	}
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the recipient phone number in international format.
+func (o *Options) Recipient(phone string) *Options {
+	o.options["recipient_id"] = phone
+	return o
+}
+
+// PreviewURL enables link previews for text messages.
+func (o *Options) PreviewURL(preview bool) *Options {
+	o.options["preview_url"] = preview
+	return o
+}
+
+// Template sends a pre-approved template message instead of free-form text.
+// Business-initiated conversations require template messages.
+func (o *Options) Template(name, languageCode string) *Options {
+	o.options["template_name"] = name
+	o.options["template_language"] = languageCode
+	return o
+}
+
+// BodyParameters adds text parameters for the template body placeholders.
+func (o *Options) BodyParameters(values ...string) *Options {
+	parameters := make([]map[string]any, 0, len(values))
+	for _, value := range values {
+		parameters = append(parameters, map[string]any{"type": "text", "text": value})
+	}
+	return o.AddComponent(map[string]any{
+		"type":       "body",
+		"parameters": parameters,
+	})
+}
+
+// AddComponent adds a raw template component (header, body or button).
+func (o *Options) AddComponent(component map[string]any) *Options {
+	o.components = append(o.components, component)
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"components": []map[string]any(nil),
+}
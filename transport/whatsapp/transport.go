@@ -0,0 +1,160 @@
+package whatsapp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultAPIVersion is the Graph API version used when none is configured.
+const DefaultAPIVersion = "v21.0"
+
+// Transport sends messages via WhatsApp Cloud API.
+type Transport struct {
+	*notifier.AbstractTransport
+	token         string
+	phoneNumberID string
+	to            string
+	apiVersion    string
+}
+
+// NewTransport creates a new WhatsApp transport.
+// phoneNumberID is the ID of the sending business phone number, to the default recipient.
+func NewTransport(token, phoneNumberID, to string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		token:             token,
+		phoneNumberID:     phoneNumberID,
+		to:                to,
+		apiVersion:        DefaultAPIVersion,
+	}
+}
+
+// SetAPIVersion sets the Graph API version (e.g., "v21.0").
+func (t *Transport) SetAPIVersion(version string) *Transport {
+	t.apiVersion = version
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("whatsapp://%s?from=%s", t.getEndpoint(), t.phoneNumberID)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("whatsapp: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("whatsapp").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	to := chatMsg.GetRecipientId()
+	if to == "" {
+		to = t.to
+	}
+	if to == "" {
+		return nil, fmt.Errorf("whatsapp: missing recipient phone number")
+	}
+
+	payload := map[string]any{
+		"messaging_product": "whatsapp",
+		"recipient_type":    "individual",
+		"to":                to,
+	}
+
+	if name, ok := options["template_name"].(string); ok && name != "" {
+		template := map[string]any{
+			"name":     name,
+			"language": map[string]any{"code": options["template_language"]},
+		}
+		if components, ok := options["components"]; ok {
+			template["components"] = components
+		}
+		payload["type"] = "template"
+		payload["template"] = template
+	} else {
+		text := map[string]any{"body": chatMsg.GetText()}
+		if preview, ok := options["preview_url"].(bool); ok {
+			text["preview_url"] = preview
+		}
+		payload["type"] = "text"
+		payload["text"] = text
+	}
+
+	jsonBody, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("whatsapp: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s/%s/%s/messages", t.getEndpoint(), t.apiVersion, t.phoneNumberID)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("whatsapp: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+t.token)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("whatsapp: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiErr struct {
+			Error struct {
+				Message string `json:"message"`
+				Code    int    `json:"code"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(respBody, &apiErr) == nil && apiErr.Error.Message != "" {
+			return nil, fmt.Errorf("whatsapp: API error (status %d, code %d): %s", resp.StatusCode, apiErr.Error.Code, apiErr.Error.Message)
+		}
+		return nil, fmt.Errorf("whatsapp: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	var result struct {
+		Messages []struct {
+			ID string `json:"id"`
+		} `json:"messages"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("whatsapp: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	if len(result.Messages) > 0 {
+		sentMessage.SetMessageID(result.Messages[0].ID)
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "graph.facebook.com"
+	}
+	return endpoint
+}
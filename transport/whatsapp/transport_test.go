@@ -0,0 +1,181 @@
+package whatsapp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSupports(t *testing.T) {
+	transport := NewTransport("token", "106540352242922", "", nil)
+
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should support ChatMessage")
+	}
+}
+
+func TestTransportString(t *testing.T) {
+	transport := NewTransport("token", "106540352242922", "", nil)
+
+	if transport.String() != "whatsapp://graph.facebook.com?from=106540352242922" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, _ := notifier.NewDSN("whatsapp://token@default?from=106540352242922&to=15551234567&version=v20.0")
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support whatsapp DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	whatsappTransport := transport.(*Transport)
+	if whatsappTransport.phoneNumberID != "106540352242922" || whatsappTransport.to != "15551234567" || whatsappTransport.apiVersion != "v20.0" {
+		t.Errorf("Transport not configured correctly: %+v", whatsappTransport)
+	}
+
+	for _, invalid := range []string{
+		"whatsapp://default?from=106540352242922",
+		"whatsapp://token@default",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
+
+func TestHTTPSendText(t *testing.T) {
+	var capturedRequest *http.Request
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedRequest = req
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"messaging_product":"whatsapp","messages":[{"id":"wamid.1"}]}`), nil
+	})
+
+	transport := NewTransport("token", "106540352242922", "15551234567", client)
+	msg := notifier.NewChatMessage("See https://example.com").WithOptions("whatsapp", NewOptions().PreviewURL(true))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedRequest.URL.String() != "https://graph.facebook.com/v21.0/106540352242922/messages" {
+		t.Errorf("Unexpected URL: %s", capturedRequest.URL.String())
+	}
+	if capturedRequest.Header.Get("Authorization") != "Bearer token" {
+		t.Errorf("Unexpected Authorization: %s", capturedRequest.Header.Get("Authorization"))
+	}
+	if capturedBody["type"] != "text" || capturedBody["to"] != "15551234567" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	text := capturedBody["text"].(map[string]any)
+	if text["body"] != "See https://example.com" || text["preview_url"] != true {
+		t.Errorf("Unexpected text: %v", text)
+	}
+	if sentMsg.GetMessageID() != "wamid.1" {
+		t.Errorf("Expected message ID 'wamid.1', got '%s'", sentMsg.GetMessageID())
+	}
+}
+
+func TestHTTPSendTemplate(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		json.NewDecoder(req.Body).Decode(&capturedBody)
+		return newResponse(http.StatusOK, `{"messages":[{"id":"wamid.2"}]}`), nil
+	})
+
+	transport := NewTransport("token", "106540352242922", "", client)
+	msg := notifier.NewChatMessage("ignored").
+		WithOptions("whatsapp", NewOptions().
+			Recipient("15550000000").
+			Template("order_update", "en_US").
+			BodyParameters("Jane", "#1234"))
+
+	if _, err := transport.Send(context.Background(), msg); err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if capturedBody["type"] != "template" || capturedBody["to"] != "15550000000" {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	template := capturedBody["template"].(map[string]any)
+	if template["name"] != "order_update" || template["language"].(map[string]any)["code"] != "en_US" {
+		t.Errorf("Unexpected template: %v", template)
+	}
+	components := template["components"].([]any)
+	parameters := components[0].(map[string]any)["parameters"].([]any)
+	if len(parameters) != 2 || parameters[1].(map[string]any)["text"] != "#1234" {
+		t.Errorf("Unexpected parameters: %v", parameters)
+	}
+}
+
+func TestHTTPMissingRecipient(t *testing.T) {
+	transport := NewTransport("token", "106540352242922", "", nil)
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "missing recipient") {
+		t.Errorf("Expected missing recipient error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusBadRequest, `{"error":{"message":"Recipient phone number not in allowed list","code":131030}}`), nil
+	})
+
+	transport := NewTransport("token", "106540352242922", "15551234567", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "API error (status 400, code 131030): Recipient phone number not in allowed list") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestHTTPNetworkError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	transport := NewTransport("token", "106540352242922", "15551234567", client)
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "whatsapp: send request") {
+		t.Errorf("Expected send request error, got: %v", err)
+	}
+}
@@ -0,0 +1,306 @@
+package xmpp
+
+import (
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+)
+
+// XML namespaces used during stream negotiation (RFC 6120).
+const (
+	nsStream  = "http://etherx.jabber.org/streams"
+	nsClient  = "jabber:client"
+	nsTLS     = "urn:ietf:params:xml:ns:xmpp-tls"
+	nsSASL    = "urn:ietf:params:xml:ns:xmpp-sasl"
+	nsBind    = "urn:ietf:params:xml:ns:xmpp-bind"
+	nsSession = "urn:ietf:params:xml:ns:xmpp-session"
+	nsMUC     = "http://jabber.org/protocol/muc"
+)
+
+type streamFeatures struct {
+	StartTLS   *struct{} `xml:"urn:ietf:params:xml:ns:xmpp-tls starttls"`
+	Mechanisms []string  `xml:"urn:ietf:params:xml:ns:xmpp-sasl mechanisms>mechanism"`
+	Bind       *struct{} `xml:"urn:ietf:params:xml:ns:xmpp-bind bind"`
+	Session    *struct{} `xml:"urn:ietf:params:xml:ns:xmpp-session session"`
+}
+
+type saslFailure struct {
+	Condition struct {
+		XMLName xml.Name
+	} `xml:",any"`
+	Text string `xml:"text"`
+}
+
+type iq struct {
+	ID   string `xml:"id,attr"`
+	Type string `xml:"type,attr"`
+	Bind struct {
+		JID string `xml:"jid"`
+	} `xml:"urn:ietf:params:xml:ns:xmpp-bind bind"`
+	Error *stanzaError `xml:"error"`
+}
+
+type presence struct {
+	From  string       `xml:"from,attr"`
+	Type  string       `xml:"type,attr"`
+	Error *stanzaError `xml:"error"`
+}
+
+type stanzaError struct {
+	Type      string `xml:"type,attr"`
+	Condition struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+func (e *stanzaError) String() string {
+	return e.Condition.XMLName.Local
+}
+
+// conn is a minimal client-to-server XMPP connection, sufficient to send messages.
+type conn struct {
+	netConn net.Conn
+	decoder *xml.Decoder
+	domain  string
+	jid     string
+}
+
+// negotiate opens the stream and runs STARTTLS, SASL PLAIN authentication and resource binding.
+func (c *conn) negotiate(username, password, resource string, tlsConfig *tls.Config) error {
+	features, err := c.openStream()
+	if err != nil {
+		return err
+	}
+
+	if tlsConfig != nil {
+		if features.StartTLS == nil {
+			return fmt.Errorf("server does not support STARTTLS, use tls=none to connect unencrypted")
+		}
+		if _, err := fmt.Fprintf(c.netConn, "<starttls xmlns='%s'/>", nsTLS); err != nil {
+			return err
+		}
+		start, err := c.nextElement()
+		if err != nil {
+			return err
+		}
+		if start.Name.Local != "proceed" {
+			return fmt.Errorf("STARTTLS failed: %s", start.Name.Local)
+		}
+		tlsConn := tls.Client(c.netConn, tlsConfig)
+		if err := tlsConn.Handshake(); err != nil {
+			return fmt.Errorf("TLS handshake: %w", err)
+		}
+		c.netConn = tlsConn
+		if features, err = c.openStream(); err != nil {
+			return err
+		}
+	}
+
+	if !contains(features.Mechanisms, "PLAIN") {
+		return fmt.Errorf("server does not support SASL PLAIN (offered: %s)", strings.Join(features.Mechanisms, ", "))
+	}
+	credentials := base64.StdEncoding.EncodeToString([]byte("\x00" + username + "\x00" + password))
+	if _, err := fmt.Fprintf(c.netConn, "<auth xmlns='%s' mechanism='PLAIN'>%s</auth>", nsSASL, credentials); err != nil {
+		return err
+	}
+	start, err := c.nextElement()
+	if err != nil {
+		return err
+	}
+	switch start.Name.Local {
+	case "success":
+		if err := c.decoder.Skip(); err != nil {
+			return err
+		}
+	case "failure":
+		var failure saslFailure
+		_ = c.decoder.DecodeElement(&failure, &start)
+		return fmt.Errorf("authentication failed: %s", failure.Condition.XMLName.Local)
+	default:
+		return fmt.Errorf("authentication failed: unexpected <%s>", start.Name.Local)
+	}
+
+	if features, err = c.openStream(); err != nil {
+		return err
+	}
+
+	if features.Bind == nil {
+		return fmt.Errorf("server does not support resource binding")
+	}
+	var bindResult iq
+	if err := c.request(fmt.Sprintf("<iq type='set' id='%s'><bind xmlns='%s'><resource>%s</resource></bind></iq>", newID(), nsBind, escape(resource)), &bindResult); err != nil {
+		return fmt.Errorf("bind resource: %w", err)
+	}
+	c.jid = bindResult.Bind.JID
+
+	// Legacy servers still require a session (RFC 3921)
+	if features.Session != nil {
+		if err := c.request(fmt.Sprintf("<iq type='set' id='%s'><session xmlns='%s'/></iq>", newID(), nsSession), &iq{}); err != nil {
+			return fmt.Errorf("establish session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// openStream sends the stream header and reads the server's stream features.
+func (c *conn) openStream() (*streamFeatures, error) {
+	c.decoder = xml.NewDecoder(c.netConn)
+	if _, err := fmt.Fprintf(c.netConn, "<?xml version='1.0'?><stream:stream to='%s' xmlns='%s' xmlns:stream='%s' version='1.0'>", escape(c.domain), nsClient, nsStream); err != nil {
+		return nil, err
+	}
+
+	start, err := c.nextElement()
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Space != nsStream || start.Name.Local != "stream" {
+		return nil, fmt.Errorf("expected stream header, got <%s>", start.Name.Local)
+	}
+
+	start, err = c.nextElement()
+	if err != nil {
+		return nil, err
+	}
+	if start.Name.Local != "features" {
+		return nil, fmt.Errorf("expected stream features, got <%s>", start.Name.Local)
+	}
+	var features streamFeatures
+	if err := c.decoder.DecodeElement(&features, &start); err != nil {
+		return nil, err
+	}
+	return &features, nil
+}
+
+// request sends an iq stanza and decodes the matching response.
+func (c *conn) request(stanza string, result *iq) error {
+	if _, err := io.WriteString(c.netConn, stanza); err != nil {
+		return err
+	}
+	for {
+		start, err := c.nextElement()
+		if err != nil {
+			return err
+		}
+		if start.Name.Local != "iq" {
+			if err := c.decoder.Skip(); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.decoder.DecodeElement(result, &start); err != nil {
+			return err
+		}
+		if result.Type == "error" {
+			if result.Error != nil {
+				return fmt.Errorf("%s", result.Error)
+			}
+			return fmt.Errorf("request failed")
+		}
+		return nil
+	}
+}
+
+// joinRoom enters a multi-user chat room and waits until the server confirms the join.
+func (c *conn) joinRoom(room, nick string) error {
+	occupant := room + "/" + nick
+	// History is suppressed so joining does not flood the stream
+	stanza := fmt.Sprintf("<presence to='%s'><x xmlns='%s'><history maxstanzas='0'/></x></presence>", escape(occupant), nsMUC)
+	if _, err := io.WriteString(c.netConn, stanza); err != nil {
+		return err
+	}
+
+	for {
+		start, err := c.nextElement()
+		if err != nil {
+			return err
+		}
+		if start.Name.Local != "presence" {
+			if err := c.decoder.Skip(); err != nil {
+				return err
+			}
+			continue
+		}
+		var p presence
+		if err := c.decoder.DecodeElement(&p, &start); err != nil {
+			return err
+		}
+		if !strings.EqualFold(p.From, occupant) {
+			continue
+		}
+		if p.Type == "error" {
+			condition := "unknown error"
+			if p.Error != nil {
+				condition = p.Error.String()
+			}
+			return fmt.Errorf("join room %s: %s", room, condition)
+		}
+		return nil
+	}
+}
+
+// sendMessage sends a message stanza and returns its ID.
+func (c *conn) sendMessage(to, messageType, body string) (string, error) {
+	id := newID()
+	stanza := fmt.Sprintf("<message to='%s' type='%s' id='%s'><body>%s</body></message>", escape(to), messageType, id, escape(body))
+	if _, err := io.WriteString(c.netConn, stanza); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// close ends the stream and closes the connection.
+func (c *conn) close() error {
+	_, _ = io.WriteString(c.netConn, "</stream:stream>")
+	return c.netConn.Close()
+}
+
+// nextElement returns the next start element, failing on stream errors and stream end.
+func (c *conn) nextElement() (xml.StartElement, error) {
+	for {
+		token, err := c.decoder.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Space == nsStream && t.Name.Local == "error" {
+				var streamErr stanzaError
+				_ = c.decoder.DecodeElement(&streamErr, &t)
+				return xml.StartElement{}, fmt.Errorf("stream error: %s", streamErr.String())
+			}
+			return t, nil
+		case xml.EndElement:
+			if t.Name.Space == nsStream && t.Name.Local == "stream" {
+				return xml.StartElement{}, io.EOF
+			}
+		}
+	}
+}
+
+func escape(value string) string {
+	var b strings.Builder
+	_ = xml.EscapeText(&b, []byte(value))
+	return b.String()
+}
+
+func newID() string {
+	id := make([]byte, 8)
+	_, _ = rand.Read(id)
+	return hex.EncodeToString(id)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+package xmpp
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for XMPP.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the JID of the user to send a direct message to. A JID on a conference.
+// or muc. domain is joined as a room instead.
+func (o *Options) Recipient(jid string) *Options {
+	o.options["recipient_id"] = jid
+	return o
+}
+
+// Room sends the message to a multi-user chat room (e.g., ops@conference.example.com).
+func (o *Options) Room(jid string) *Options {
+	o.options["room"] = jid
+	return o
+}
+
+// Nick sets the nickname used when joining a room.
+func (o *Options) Nick(nick string) *Options {
+	o.options["nick"] = nick
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
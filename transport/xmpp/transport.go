@@ -0,0 +1,170 @@
+package xmpp
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// DefaultPort is the XMPP client-to-server port.
+const DefaultPort = 5222
+
+// DefaultResource is the resource bound for the sending session.
+const DefaultResource = "go-notifier"
+
+// Transport sends chat messages to XMPP users or multi-user chat rooms.
+// Each Send opens a short-lived client session.
+type Transport struct {
+	*notifier.AbstractTransport
+	jid                string
+	password           string
+	to                 string
+	room               string
+	nick               string
+	useTLS             bool
+	insecureSkipVerify bool
+}
+
+// NewTransport creates a new XMPP transport authenticating as jid (user@domain).
+// Messages go to the to JID, or to room if set. The server defaults to the JID's domain.
+func NewTransport(jid, password, to string) *Transport {
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(nil),
+		jid:               jid,
+		password:          password,
+		to:                to,
+		nick:              DefaultResource,
+		useTLS:            true,
+	}
+}
+
+// SetRoom sets the default multi-user chat room.
+func (t *Transport) SetRoom(room string) *Transport {
+	t.room = room
+	return t
+}
+
+// SetNick sets the default nickname used in rooms.
+func (t *Transport) SetNick(nick string) *Transport {
+	t.nick = nick
+	return t
+}
+
+// SetTLS enables or disables STARTTLS. Disable it only for local test servers.
+func (t *Transport) SetTLS(enabled bool) *Transport {
+	t.useTLS = enabled
+	return t
+}
+
+// SetInsecureSkipVerify disables TLS certificate verification, e.g. for self-signed servers.
+func (t *Transport) SetInsecureSkipVerify(skip bool) *Transport {
+	t.insecureSkipVerify = skip
+	return t
+}
+
+func (t *Transport) String() string {
+	if t.room != "" {
+		return fmt.Sprintf("xmpp://%s?room=%s", t.getAddress(), t.room)
+	}
+	return fmt.Sprintf("xmpp://%s?to=%s", t.getAddress(), t.to)
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("xmpp: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("xmpp").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	to, room, nick := t.to, t.room, t.nick
+	if recipient, ok := options["recipient_id"].(string); ok && recipient != "" {
+		to, room = recipient, ""
+		if isRoomJID(recipient) {
+			to, room = "", recipient
+		}
+	}
+	if value, ok := options["room"].(string); ok && value != "" {
+		room = value
+	}
+	if value, ok := options["nick"].(string); ok && value != "" {
+		nick = value
+	}
+	if to == "" && room == "" {
+		return nil, fmt.Errorf("xmpp: missing recipient, set a JID or room")
+	}
+
+	username, domain, ok := strings.Cut(t.jid, "@")
+	if !ok || username == "" || domain == "" {
+		return nil, fmt.Errorf("xmpp: invalid JID %q, expected user@domain", t.jid)
+	}
+
+	var dialer net.Dialer
+	netConn, err := dialer.DialContext(ctx, "tcp", t.getAddress())
+	if err != nil {
+		return nil, fmt.Errorf("xmpp: connect: %w", err)
+	}
+	stop := notifier.BindConnContext(ctx, netConn)
+	defer stop()
+
+	c := &conn{netConn: netConn, domain: domain}
+	defer func() { _ = c.close() }()
+
+	var tlsConfig *tls.Config
+	if t.useTLS {
+		tlsConfig = &tls.Config{ServerName: domain, InsecureSkipVerify: t.insecureSkipVerify}
+	}
+	if err := c.negotiate(username, t.password, DefaultResource, tlsConfig); err != nil {
+		return nil, fmt.Errorf("xmpp: %w", err)
+	}
+
+	recipient, messageType := to, "chat"
+	if room != "" {
+		if err := c.joinRoom(room, nick); err != nil {
+			return nil, fmt.Errorf("xmpp: %w", err)
+		}
+		recipient, messageType = room, "groupchat"
+	}
+
+	id, err := c.sendMessage(recipient, messageType, chatMsg.GetText())
+	if err != nil {
+		return nil, fmt.Errorf("xmpp: send message: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	sentMessage.SetMessageID(id)
+	sentMessage.SetInfo("jid", c.jid)
+
+	return sentMessage, nil
+}
+
+// isRoomJID reports whether jid belongs to a multi-user chat service, detected by the
+// conference. and muc. subdomains servers use by default.
+func isRoomJID(jid string) bool {
+	_, domain, ok := strings.Cut(jid, "@")
+	return ok && (strings.HasPrefix(domain, "conference.") || strings.HasPrefix(domain, "muc."))
+}
+
+func (t *Transport) getAddress() string {
+	host, port, err := net.SplitHostPort(t.GetEndpoint())
+	if err != nil {
+		host, port = t.GetEndpoint(), strconv.Itoa(DefaultPort)
+	}
+	if host == "" || host == "localhost" {
+		_, host, _ = strings.Cut(t.jid, "@")
+	}
+	return net.JoinHostPort(host, port)
+}
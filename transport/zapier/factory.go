@@ -0,0 +1,93 @@
+package zapier
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("zapier", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, nil))
+}
+
+// TransportFactory creates Zapier and Make catch hook transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new catch hook transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a catch hook transport from a DSN.
+// DSN format: zapier://default/<hook_path>[?map=<field>:<name>,...]
+// Example: zapier://default/hooks/catch/123456/abcdef/?map=subject:title,content:body
+//
+// Make webhooks use their host: zapier://hook.eu1.make.com/<hook_id>
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "zapier" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	path := dsn.GetPath()
+	if strings.Trim(path, "/") == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing hook path. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	host := dsn.GetHost()
+	if host == "default" {
+		host = ""
+	}
+	port := dsn.GetPort()
+
+	transport := NewTransport(path, f.client)
+	if host != "" {
+		transport.SetHost(host)
+	}
+	if port > 0 {
+		transport.SetPort(port)
+	}
+
+	if value := dsn.GetOption("map"); value != "" {
+		mapping := make(map[string]string)
+		for _, pair := range strings.Split(value, ",") {
+			from, to, ok := strings.Cut(pair, ":")
+			if !ok || from == "" || to == "" {
+				return nil, fmt.Errorf("invalid DSN: Invalid field mapping \"%s\", expected <field>:<name>. DSN: %s", pair, dsn.GetOriginalDSN())
+			}
+			mapping[from] = to
+		}
+		transport.SetFieldMapping(mapping)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"zapier"}
+}
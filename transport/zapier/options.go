@@ -0,0 +1,43 @@
+package zapier
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Zapier and Make catch hooks.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Field adds a top-level field to the posted payload, overriding a payload field of the same name.
+func (o *Options) Field(key string, value any) *Options {
+	fields, ok := o.options["fields"].(map[string]any)
+	if !ok {
+		fields = make(map[string]any)
+		o.options["fields"] = fields
+	}
+	fields[key] = value
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
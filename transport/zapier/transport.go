@@ -0,0 +1,142 @@
+package zapier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts messages as JSON to a Zapier or Make catch hook.
+type Transport struct {
+	*notifier.AbstractTransport
+	path         string
+	fieldMapping map[string]string
+}
+
+// NewTransport creates a new catch hook transport for the hook path
+// (e.g., "/hooks/catch/123456/abcdef/"). The host defaults to hooks.zapier.com,
+// use SetHost for Make hooks (e.g., "hook.eu1.make.com").
+func NewTransport(path string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		path:              path,
+	}
+}
+
+// SetFieldMapping renames payload fields (e.g., "subject" to "title") to match
+// the fields expected by the Zap or scenario.
+func (t *Transport) SetFieldMapping(mapping map[string]string) *Transport {
+	t.fieldMapping = mapping
+	return t
+}
+
+func (t *Transport) String() string {
+	return fmt.Sprintf("zapier://%s", t.getEndpoint())
+}
+
+// Supports returns true for all message types, they are sent as JSON.
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	return message != nil
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	options := make(map[string]any)
+	if opts, ok := message.GetOptions("zapier").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	payload := notifier.NewPayload(message)
+	// Transport options are configuration, not data for the automation
+	payload.Options = nil
+
+	encoded, err := notifier.JSONMarshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("zapier: marshal payload: %w", err)
+	}
+	var body map[string]any
+	if err := json.Unmarshal(encoded, &body); err != nil {
+		return nil, fmt.Errorf("zapier: marshal payload: %w", err)
+	}
+
+	for from, to := range t.fieldMapping {
+		if value, ok := body[from]; ok {
+			delete(body, from)
+			body[to] = value
+		}
+	}
+	if fields, ok := options["fields"].(map[string]any); ok {
+		for key, value := range fields {
+			body[key] = value
+		}
+	}
+
+	jsonBody, err := notifier.JSONMarshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("zapier: marshal options: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("https://%s%s", t.getEndpoint(), t.path)
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("zapier: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("zapier: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	respBody, _ := io.ReadAll(resp.Body)
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return nil, &notifier.RateLimitError{
+			Transport:  "zapier",
+			RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+			Message:    string(respBody),
+		}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("zapier: API error (status %d): %s", resp.StatusCode, string(respBody))
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+
+	// Zapier answers with the request ID, Make with a plain "Accepted"
+	var result struct {
+		ID        string `json:"id"`
+		RequestID string `json:"request_id"`
+	}
+	if raw, err := t.DecodeResponse(bytes.NewReader(respBody), &result); err == nil {
+		t.SetRawResponse(sentMessage, raw)
+		sentMessage.SetMessageID(result.RequestID)
+		if result.ID != "" {
+			sentMessage.SetInfo("id", result.ID)
+		}
+	}
+
+	return sentMessage, nil
+}
+
+func (t *Transport) getEndpoint() string {
+	endpoint := t.GetEndpoint()
+	if endpoint == "" || endpoint == "localhost" {
+		return "hooks.zapier.com"
+	}
+	return endpoint
+}
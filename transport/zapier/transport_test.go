@@ -0,0 +1,169 @@
+package zapier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func TestTransportSend(t *testing.T) {
+	var capturedBody map[string]any
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.String() != "https://hooks.zapier.com/hooks/catch/123456/abcdef/" {
+			t.Errorf("Unexpected URL: %s", req.URL.String())
+		}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &capturedBody)
+		return newResponse(200, `{"attempt":"0190a1b2","id":"0190a1b2-id","request_id":"0190a1b2-request","status":"success"}`), nil
+	})
+
+	transport := NewTransport("/hooks/catch/123456/abcdef/", client).
+		SetFieldMapping(map[string]string{"subject": "title"})
+
+	msg := notifier.NewChatMessage("Deploy finished").
+		Importance(notifier.ImportanceHigh).
+		WithOptions("zapier", NewOptions().Field("environment", "production"))
+
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if sentMsg.GetMessageID() != "0190a1b2-request" || sentMsg.GetInfo("id") != "0190a1b2-id" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo())
+	}
+
+	if capturedBody["title"] != "Deploy finished" || capturedBody["type"] != "chat" || capturedBody["importance"] != notifier.ImportanceHigh {
+		t.Errorf("Unexpected body: %v", capturedBody)
+	}
+	if _, ok := capturedBody["subject"]; ok {
+		t.Errorf("Expected subject to be renamed, got %v", capturedBody)
+	}
+	if capturedBody["environment"] != "production" {
+		t.Errorf("Expected custom field, got %v", capturedBody)
+	}
+	if _, ok := capturedBody["options"]; ok {
+		t.Errorf("Expected no transport options, got %v", capturedBody["options"])
+	}
+}
+
+func TestTransportSendMake(t *testing.T) {
+	var capturedURL string
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		capturedURL = req.URL.String()
+		return newResponse(200, "Accepted"), nil
+	})
+
+	transport := NewTransport("abc123", client)
+	transport.SetHost("hook.eu1.make.com")
+
+	msg := notifier.NewEmailMessage("Invoice").To("billing@example.com")
+	sentMsg, err := transport.Send(context.Background(), msg)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if capturedURL != "https://hook.eu1.make.com/abc123" {
+		t.Errorf("Unexpected URL: %s", capturedURL)
+	}
+	if sentMsg.GetMessageID() != "" {
+		t.Errorf("Expected no message ID, got %s", sentMsg.GetMessageID())
+	}
+}
+
+func TestTransportSendErrors(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(410, `{"status":"error","message":"Hook is no longer active"}`), nil
+	})
+
+	_, err := NewTransport("/hooks/catch/1/a/", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	if err == nil || !strings.Contains(err.Error(), "zapier: API error (status 410)") {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+
+	client = newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(429, "Too Many Requests"), nil
+	})
+
+	_, err = NewTransport("/hooks/catch/1/a/", client).Send(context.Background(), notifier.NewChatMessage("Hello"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) {
+		t.Errorf("Expected RateLimitError, got: %v", err)
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+
+	tests := []struct {
+		dsn      string
+		expected string
+		path     string
+		mapping  map[string]string
+	}{
+		{"zapier://default/hooks/catch/123456/abcdef/", "zapier://hooks.zapier.com", "/hooks/catch/123456/abcdef/", nil},
+		{"zapier://hook.eu1.make.com/abc123?map=subject:title,content:body", "zapier://hook.eu1.make.com", "/abc123", map[string]string{"subject": "title", "content": "body"}},
+	}
+
+	for _, tt := range tests {
+		dsn, _ := notifier.NewDSN(tt.dsn)
+		if !factory.Supports(dsn) {
+			t.Fatalf("Factory should support DSN %s", tt.dsn)
+		}
+
+		transport, err := factory.Create(dsn)
+		if err != nil {
+			t.Fatalf("Failed to create transport for %s: %v", tt.dsn, err)
+		}
+
+		zapierTransport := transport.(*Transport)
+		if transport.String() != tt.expected || zapierTransport.path != tt.path {
+			t.Errorf("Unexpected transport for %s: %s %s", tt.dsn, transport.String(), zapierTransport.path)
+		}
+		if len(zapierTransport.fieldMapping) != len(tt.mapping) {
+			t.Errorf("Unexpected field mapping for %s: %v", tt.dsn, zapierTransport.fieldMapping)
+		}
+		for from, to := range tt.mapping {
+			if zapierTransport.fieldMapping[from] != to {
+				t.Errorf("Expected mapping %s:%s, got %v", from, to, zapierTransport.fieldMapping)
+			}
+		}
+	}
+
+	for _, invalid := range []string{
+		"zapier://default",
+		"zapier://default/hooks/catch/1/a/?map=subject",
+	} {
+		dsn, _ := notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil {
+			t.Errorf("Expected error for DSN %s", invalid)
+		}
+	}
+}
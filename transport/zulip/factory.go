@@ -0,0 +1,78 @@
+package zulip
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+func init() {
+	notifier.RegisterTransportFactory(NewTransportFactory(nil))
+	notifier.RegisterOptionsDecoder("zulip", notifier.MapOptionsDecoder(func(values map[string]any) notifier.MessageOptionsInterface {
+		o := NewOptions()
+		o.options = values
+		return o
+	}, optionTypes))
+}
+
+// TransportFactory creates Zulip transports from DSN.
+type TransportFactory struct {
+	client *http.Client
+}
+
+// NewTransportFactory creates a new Zulip transport factory.
+func NewTransportFactory(client *http.Client) *TransportFactory {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &TransportFactory{
+		client: client,
+	}
+}
+
+// Create creates a Zulip transport from a DSN.
+// DSN format: zulip://<bot_email>:<api_key>@<realm>[?stream=<stream>&topic=<topic>]
+// Example: zulip://alert-bot@example.zulipchat.com:abc123@example.zulipchat.com?stream=general&topic=alerts
+func (f *TransportFactory) Create(dsn *notifier.DSN) (notifier.TransportInterface, error) {
+	scheme := dsn.GetScheme()
+	if scheme != "zulip" {
+		return nil, fmt.Errorf("unsupported scheme: scheme \"%s\" not supported (supported: %s). DSN: %s", scheme, strings.Join(f.GetSupportedSchemes(), ", "), dsn.GetOriginalDSN())
+	}
+
+	email := dsn.GetUser()
+	apiKey := dsn.GetPassword()
+	if email == "" || apiKey == "" {
+		return nil, fmt.Errorf("incomplete DSN: Missing bot email or API key. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	// Every organization has its own realm, so the host is required
+	host := dsn.GetHost()
+	if host == "default" {
+		return nil, fmt.Errorf("incomplete DSN: Missing realm host. DSN: %s", dsn.GetOriginalDSN())
+	}
+
+	transport := NewTransport(email, apiKey, dsn.GetOption("stream"), dsn.GetOption("topic"), f.client)
+	transport.SetHost(host)
+	if port := dsn.GetPort(); port > 0 {
+		transport.SetPort(port)
+	}
+
+	return transport, nil
+}
+
+// Supports checks if the factory supports the given DSN.
+func (f *TransportFactory) Supports(dsn *notifier.DSN) bool {
+	for _, scheme := range f.GetSupportedSchemes() {
+		if dsn.GetScheme() == scheme {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSupportedSchemes returns the supported DSN schemes.
+func (f *TransportFactory) GetSupportedSchemes() []string {
+	return []string{"zulip"}
+}
@@ -0,0 +1,62 @@
+package zulip
+
+import (
+	"encoding/json"
+)
+
+// Options implements MessageOptionsInterface for Zulip.
+type Options struct {
+	options map[string]any
+}
+
+func NewOptions() *Options {
+	return &Options{
+		options: make(map[string]any),
+	}
+}
+
+func (o *Options) ToMap() map[string]any {
+	return o.options
+}
+
+func (o *Options) GetRecipientId() string {
+	if id, ok := o.options["recipient_id"].(string); ok {
+		return id
+	}
+	return ""
+}
+
+// Recipient sets the stream (channel) to post into.
+func (o *Options) Recipient(stream string) *Options {
+	o.options["recipient_id"] = stream
+	return o
+}
+
+// Topic sets the topic of a stream message.
+func (o *Options) Topic(topic string) *Options {
+	o.options["topic"] = topic
+	return o
+}
+
+// DirectTo sends a direct message to the users, given by email or user ID, instead of posting
+// into a stream.
+func (o *Options) DirectTo(users ...string) *Options {
+	o.options["direct_to"] = users
+	return o
+}
+
+// ReadBySender marks the message as read by the bot (e.g., for bots that post for a user).
+func (o *Options) ReadBySender(read bool) *Options {
+	o.options["read_by_sender"] = read
+	return o
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o *Options) MarshalJSON() ([]byte, error) {
+	return json.Marshal(o.options)
+}
+
+// optionTypes are the Go types of the option values that don't survive a JSON round trip.
+var optionTypes = map[string]any{
+	"direct_to": []string(nil),
+}
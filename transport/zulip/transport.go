@@ -0,0 +1,168 @@
+package zulip
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/shyim/go-notifier"
+)
+
+// Transport posts stream and direct messages via the Zulip REST API.
+type Transport struct {
+	*notifier.AbstractTransport
+	email  string
+	apiKey string
+	stream string
+	topic  string
+}
+
+// NewTransport creates a new Zulip transport authenticating as the bot. The realm (e.g.,
+// "example.zulipchat.com") is set with SetHost.
+func NewTransport(email, apiKey, stream, topic string, client *http.Client) *Transport {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &Transport{
+		AbstractTransport: notifier.NewAbstractTransport(client),
+		email:             email,
+		apiKey:            apiKey,
+		stream:            stream,
+		topic:             topic,
+	}
+}
+
+func (t *Transport) String() string {
+	if t.stream != "" {
+		return fmt.Sprintf("zulip://%s?stream=%s", t.GetEndpoint(), t.stream)
+	}
+	return fmt.Sprintf("zulip://%s", t.GetEndpoint())
+}
+
+func (t *Transport) Supports(message notifier.MessageInterface) bool {
+	_, ok := message.(*notifier.ChatMessage)
+	return ok
+}
+
+func (t *Transport) Send(ctx context.Context, message notifier.MessageInterface) (*notifier.SentMessage, error) {
+	chatMsg, ok := message.(*notifier.ChatMessage)
+	if !ok {
+		return nil, fmt.Errorf("zulip: unsupported message type %T, expected ChatMessage", message)
+	}
+
+	options := make(map[string]any)
+	if opts, ok := chatMsg.GetOptions("zulip").(*Options); ok {
+		options = opts.ToMap()
+	}
+
+	fields := url.Values{}
+	content := chatMsg.GetText()
+	if users, _ := options["direct_to"].([]string); len(users) > 0 {
+		to, err := directRecipients(users)
+		if err != nil {
+			return nil, fmt.Errorf("zulip: marshal recipients: %w", err)
+		}
+		fields.Set("type", "direct")
+		fields.Set("to", to)
+	} else {
+		stream := chatMsg.GetRecipientId()
+		if stream == "" {
+			stream = t.stream
+		}
+		if stream == "" {
+			return nil, fmt.Errorf("zulip: missing stream, set a stream or direct message recipients")
+		}
+
+		topic, _ := options["topic"].(string)
+		if topic == "" {
+			topic = t.topic
+		}
+		if topic == "" && chatMsg.GetContent() != "" {
+			// The subject becomes the topic
+			topic = chatMsg.GetSubject()
+			content = chatMsg.GetContent()
+		}
+
+		fields.Set("type", "stream")
+		fields.Set("to", stream)
+		fields.Set("topic", topic)
+	}
+	fields.Set("content", content)
+	if read, ok := options["read_by_sender"].(bool); ok {
+		fields.Set("read_by_sender", strconv.FormatBool(read))
+	}
+
+	endpoint := fmt.Sprintf("https://%s/api/v1/messages", t.GetEndpoint())
+	req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(fields.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("zulip: create request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(t.email, t.apiKey)
+
+	resp, err := t.AbstractTransport.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("zulip: send request: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		var apiResp struct {
+			Msg string `json:"msg"`
+		}
+		errMessage := string(respBody)
+		if json.Unmarshal(respBody, &apiResp) == nil && apiResp.Msg != "" {
+			errMessage = apiResp.Msg
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			return nil, &notifier.RateLimitError{
+				Transport:  "zulip",
+				RetryAfter: notifier.ParseRetryAfter(resp.Header.Get("Retry-After")),
+				Message:    errMessage,
+			}
+		}
+		return nil, fmt.Errorf("zulip: API error (status %d): %s", resp.StatusCode, errMessage)
+	}
+
+	var result struct {
+		ID int64 `json:"id"`
+	}
+
+	raw, err := t.DecodeResponse(resp.Body, &result)
+	if err != nil {
+		return nil, fmt.Errorf("zulip: decode response: %w", err)
+	}
+
+	sentMessage := notifier.NewSentMessage(message, t.String())
+	t.SetRawResponse(sentMessage, raw)
+	sentMessage.SetMessageID(strconv.FormatInt(result.ID, 10))
+	if topic := fields.Get("topic"); topic != "" {
+		sentMessage.SetInfo("topic", topic)
+	}
+
+	return sentMessage, nil
+}
+
+// directRecipients encodes the recipients of a direct message as JSON list of user IDs, or of
+// emails if any recipient isn't a user ID.
+func directRecipients(users []string) (string, error) {
+	ids := make([]int64, 0, len(users))
+	for _, user := range users {
+		id, err := strconv.ParseInt(user, 10, 64)
+		if err != nil {
+			data, err := notifier.JSONMarshal(users)
+			return string(data), err
+		}
+		ids = append(ids, id)
+	}
+	data, err := notifier.JSONMarshal(ids)
+	return string(data), err
+}
@@ -0,0 +1,206 @@
+package zulip
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/shyim/go-notifier"
+)
+
+// mockRoundTripper is a custom RoundTripper for mocking HTTP requests
+type mockRoundTripper struct {
+	handler func(req *http.Request) (*http.Response, error)
+}
+
+func (m *mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return m.handler(req)
+}
+
+func newMockClient(handler func(req *http.Request) (*http.Response, error)) *http.Client {
+	return &http.Client{
+		Transport: &mockRoundTripper{handler: handler},
+	}
+}
+
+func newResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     make(http.Header),
+	}
+}
+
+func newTransport(client *http.Client, stream, topic string) *Transport {
+	transport := NewTransport("bot@example.zulipchat.com", "key", stream, topic, client)
+	transport.SetHost("example.zulipchat.com")
+	return transport
+}
+
+// captureForm returns a client recording the form of the request.
+func captureForm(req **http.Request, form *url.Values) *http.Client {
+	return newMockClient(func(r *http.Request) (*http.Response, error) {
+		*req = r
+		body, _ := io.ReadAll(r.Body)
+		*form, _ = url.ParseQuery(string(body))
+		return newResponse(http.StatusOK, `{"id":42,"msg":"","result":"success"}`), nil
+	})
+}
+
+func TestTransportString(t *testing.T) {
+	transport := newTransport(nil, "general", "")
+	if transport.String() != "zulip://example.zulipchat.com?stream=general" {
+		t.Errorf("Unexpected string: %s", transport.String())
+	}
+	if !transport.Supports(notifier.NewChatMessage("Hello")) {
+		t.Error("Transport should support ChatMessage")
+	}
+}
+
+func TestFactory(t *testing.T) {
+	factory := NewTransportFactory(nil)
+	dsn, err := notifier.NewDSN("zulip://alert-bot@example.zulipchat.com:abc123@example.zulipchat.com?stream=general&topic=alerts")
+	if err != nil {
+		t.Fatalf("Failed to parse DSN: %v", err)
+	}
+
+	if !factory.Supports(dsn) {
+		t.Error("Factory should support zulip DSN")
+	}
+
+	transport, err := factory.Create(dsn)
+	if err != nil {
+		t.Fatalf("Failed to create transport: %v", err)
+	}
+
+	zulipTransport := transport.(*Transport)
+	if zulipTransport.email != "alert-bot@example.zulipchat.com" || zulipTransport.apiKey != "abc123" {
+		t.Errorf("Unexpected credentials: %s %s", zulipTransport.email, zulipTransport.apiKey)
+	}
+	if zulipTransport.stream != "general" || zulipTransport.topic != "alerts" || zulipTransport.GetEndpoint() != "example.zulipchat.com" {
+		t.Errorf("Unexpected transport: %+v", zulipTransport)
+	}
+
+	for _, invalid := range []string{"zulip://bot@example.com@example.zulipchat.com", "zulip://bot@example.com:key@default"} {
+		dsn, _ = notifier.NewDSN(invalid)
+		if _, err := factory.Create(dsn); err == nil || !strings.Contains(err.Error(), "incomplete DSN") {
+			t.Errorf("Expected incomplete DSN error for %s, got: %v", invalid, err)
+		}
+	}
+}
+
+func TestHTTPStreamMessage(t *testing.T) {
+	var req *http.Request
+	var form url.Values
+	transport := newTransport(captureForm(&req, &form), "general", "alerts")
+
+	sentMsg, err := transport.Send(context.Background(), notifier.NewChatMessage("Deploy finished"))
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	if req.URL.String() != "https://example.zulipchat.com/api/v1/messages" {
+		t.Errorf("Unexpected URL: %s", req.URL.String())
+	}
+	if user, key, ok := req.BasicAuth(); !ok || user != "bot@example.zulipchat.com" || key != "key" {
+		t.Errorf("Expected basic auth, got: %v", req.Header)
+	}
+	if form.Get("type") != "stream" || form.Get("to") != "general" || form.Get("topic") != "alerts" || form.Get("content") != "Deploy finished" {
+		t.Errorf("Unexpected form: %v", form)
+	}
+	if sentMsg.GetMessageID() != "42" || sentMsg.GetInfo("topic") != "alerts" {
+		t.Errorf("Unexpected sent message: %s %v", sentMsg.GetMessageID(), sentMsg.GetInfo("topic"))
+	}
+}
+
+func TestHTTPTopic(t *testing.T) {
+	tests := []struct {
+		name    string
+		message *notifier.ChatMessage
+		topic   string
+		content string
+	}{
+		{"option", notifier.NewChatMessage("Disk full").WithOptions("zulip", NewOptions().Recipient("ops").Topic("disks")), "disks", "Disk full"},
+		{"subject", notifier.NewChatMessage("Disk full").Content("95% on /var").WithOptions("zulip", NewOptions().Recipient("ops")), "Disk full", "95% on /var"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var req *http.Request
+			var form url.Values
+			transport := newTransport(captureForm(&req, &form), "general", "")
+
+			if _, err := transport.Send(context.Background(), tt.message); err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if form.Get("to") != "ops" || form.Get("topic") != tt.topic || form.Get("content") != tt.content {
+				t.Errorf("Unexpected form: %v", form)
+			}
+		})
+	}
+}
+
+func TestHTTPDirectMessage(t *testing.T) {
+	tests := []struct {
+		users    []string
+		expected string
+	}{
+		{[]string{"jane@example.com", "9"}, `["jane@example.com","9"]`},
+		{[]string{"8", "9"}, `[8,9]`},
+	}
+
+	for _, tt := range tests {
+		var req *http.Request
+		var form url.Values
+		transport := newTransport(captureForm(&req, &form), "general", "alerts")
+
+		msg := notifier.NewChatMessage("Hi").WithOptions("zulip", NewOptions().DirectTo(tt.users...))
+		if _, err := transport.Send(context.Background(), msg); err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		if form.Get("type") != "direct" || form.Get("to") != tt.expected || form.Has("topic") {
+			t.Errorf("Unexpected form: %v", form)
+		}
+	}
+}
+
+func TestHTTPMissingStream(t *testing.T) {
+	transport := newTransport(nil, "", "")
+
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || !strings.Contains(err.Error(), "missing stream") {
+		t.Errorf("Expected missing stream error, got: %v", err)
+	}
+}
+
+func TestHTTPAPIError(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		return newResponse(http.StatusBadRequest, `{"code":"STREAM_DOES_NOT_EXIST","msg":"Stream 'nope' does not exist","result":"error"}`), nil
+	})
+
+	transport := newTransport(client, "nope", "alerts")
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	if err == nil || err.Error() != "zulip: API error (status 400): Stream 'nope' does not exist" {
+		t.Errorf("Expected API error, got: %v", err)
+	}
+}
+
+func TestHTTPRateLimit(t *testing.T) {
+	client := newMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := newResponse(http.StatusTooManyRequests, `{"code":"RATE_LIMIT_HIT","msg":"API usage exceeded rate limit","result":"error","retry-after":5}`)
+		resp.Header.Set("Retry-After", "5")
+		return resp, nil
+	})
+
+	transport := newTransport(client, "general", "alerts")
+	_, err := transport.Send(context.Background(), notifier.NewChatMessage("Hi"))
+	var rateLimitErr *notifier.RateLimitError
+	if !errors.As(err, &rateLimitErr) || rateLimitErr.RetryAfter != 5*time.Second {
+		t.Errorf("Expected RateLimitError with 5s, got: %v", err)
+	}
+}
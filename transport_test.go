@@ -0,0 +1,141 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testTransportFactory struct{}
+
+func (f *testTransportFactory) Create(dsn *DSN) (TransportInterface, error) {
+	return &testTransport{key: dsn.GetScheme()}, nil
+}
+
+func (f *testTransportFactory) Supports(dsn *DSN) bool {
+	return dsn.GetScheme() == "test-factory"
+}
+
+func init() {
+	RegisterTransportFactory(&testTransportFactory{})
+}
+
+func TestNewTransportFromDSNMaxConcurrency(t *testing.T) {
+	tests := []struct {
+		dsn         string
+		limited     bool
+		expectedErr string
+	}{
+		{dsn: "test-factory://default"},
+		{dsn: "test-factory://default?max_concurrency=4", limited: true},
+		{dsn: "test-factory://default?max_concurrency=0", expectedErr: `Invalid max_concurrency "0"`},
+		{dsn: "test-factory://default?max_concurrency=many", expectedErr: `Invalid max_concurrency "many"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dsn, func(t *testing.T) {
+			transport, err := NewTransportFromDSN(tt.dsn)
+			if tt.expectedErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectedErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+
+			limited, ok := transport.(*ConcurrencyLimitedTransport)
+			if ok != tt.limited {
+				t.Fatalf("Unexpected transport type %T", transport)
+			}
+			if ok && cap(limited.semaphore) != 4 {
+				t.Errorf("Expected a limit of 4, got %d", cap(limited.semaphore))
+			}
+		})
+	}
+}
+
+func TestConcurrencyLimitedTransport(t *testing.T) {
+	var running, peak atomic.Int32
+	release := make(chan struct{})
+	inner := &testTransport{key: "test", send: func(ctx context.Context, message MessageInterface) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			previous := peak.Load()
+			if current <= previous || peak.CompareAndSwap(previous, current) {
+				break
+			}
+		}
+		<-release
+		return nil
+	}}
+	transport := NewConcurrencyLimitedTransport(inner, 2)
+
+	done := make(chan error, 4)
+	for range 4 {
+		go func() {
+			_, err := transport.Send(context.Background(), NewChatMessage("Disk full"))
+			done <- err
+		}()
+	}
+
+	// Two sends are running, the others wait for a slot
+	for running.Load() < 2 {
+		time.Sleep(time.Millisecond)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := transport.Send(ctx, NewChatMessage("Disk full")); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context error while waiting for a slot, got: %v", err)
+	}
+
+	close(release)
+	for range 4 {
+		if err := <-done; err != nil {
+			t.Errorf("Send failed: %v", err)
+		}
+	}
+	if peak.Load() != 2 {
+		t.Errorf("Expected 2 concurrent sends, got %d", peak.Load())
+	}
+
+	if transport.String() != "test://default" || transport.Unwrap() != inner {
+		t.Errorf("Expected the wrapped transport, got %s", transport)
+	}
+	if err := transport.Close(); err != nil || !inner.closed {
+		t.Errorf("Expected the wrapped transport to be closed (%v)", err)
+	}
+}
+
+func TestNewTransportFromDSNEndpointOptions(t *testing.T) {
+	tests := []struct {
+		dsn         string
+		expectedErr string
+	}{
+		{dsn: "test-factory://gateway.internal?path_prefix=/proxied"},
+		{dsn: "test-factory://localhost:8080?insecure_http=1"},
+		{dsn: "test-factory://default?insecure_http=0"},
+		{dsn: "test-factory://default?path_prefix=/proxied", expectedErr: "invalid DSN: path_prefix requires a host"},
+		{dsn: "test-factory://default?insecure_http=1", expectedErr: "invalid DSN: insecure_http requires a host"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dsn, func(t *testing.T) {
+			_, err := NewTransportFromDSN(tt.dsn)
+			if tt.expectedErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectedErr) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectedErr, err)
+			}
+		})
+	}
+}